@@ -0,0 +1,16 @@
+package main
+
+import (
+	"github.com/gost-dom/code-gen/output"
+	wrappers "github.com/gost-dom/code-gen/script-wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Goja async encoders", func() {
+	It("settle promises, and async iterators, in tasks of the script context", func() {
+		files := output.Memory{}
+		Expect(wrappers.NewGojaWrapperModuleGenerator().GenerateScriptWrappers(files)).To(Succeed())
+		runGenerated("goja-async", files, "async_iterators_generated.go", "promises_generated.go")
+	})
+})
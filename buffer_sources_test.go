@@ -0,0 +1,46 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/gost-dom/code-gen/output"
+	wrappers "github.com/gost-dom/code-gen/script-wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	// The generated goja converters are compiled with the module's goja
+	// version.
+	_ "github.com/dop251/goja"
+)
+
+var _ = Describe("Goja buffer source converters", func() {
+	It("convert between JS buffers and Go bytes", func() {
+		files := output.Memory{}
+		Expect(wrappers.NewGojaWrapperModuleGenerator().GenerateScriptWrappers(files)).To(Succeed())
+		runGenerated("goja-buffers", files, "buffer_sources_generated.go")
+	})
+})
+
+// runGenerated runs the tests in testdata/run/<name> with the generated files
+// with the names, in a temporary package. The package must be in the module to
+// use its dependencies.
+func runGenerated(name string, files output.Memory, names ...string) {
+	GinkgoHelper()
+	dir, err := os.MkdirTemp("testdata", "tmp-"+name+"-")
+	Expect(err).ToNot(HaveOccurred())
+	DeferCleanup(os.RemoveAll, dir)
+	drivers, err := os.ReadDir(filepath.Join("testdata", "run", name))
+	Expect(err).ToNot(HaveOccurred())
+	for _, driver := range drivers {
+		data, err := os.ReadFile(filepath.Join("testdata", "run", name, driver.Name()))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.WriteFile(filepath.Join(dir, driver.Name()), data, 0666)).To(Succeed())
+	}
+	for _, name := range names {
+		Expect(os.WriteFile(filepath.Join(dir, name), files[name], 0666)).To(Succeed())
+	}
+	out, err := exec.Command("go", "test", "./"+filepath.ToSlash(dir)).CombinedOutput()
+	Expect(err).ToNot(HaveOccurred(), string(out))
+}
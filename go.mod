@@ -4,6 +4,7 @@ go 1.23.4
 
 require (
 	github.com/dave/jennifer v1.7.1
+	github.com/dop251/goja v0.0.0-20241024094426-79f3a7efcdbd
 	github.com/gost-dom/generators v0.0.0-20250130162306-db4af89dffce
 	github.com/gost-dom/webref v0.0.0-20250131125308-e677d113c85a
 	github.com/onsi/ginkgo/v2 v2.22.2
@@ -11,10 +12,13 @@ require (
 )
 
 require (
+	github.com/dlclark/regexp2 v1.12.0 // indirect
 	github.com/go-logr/logr v1.4.2 // indirect
+	github.com/go-sourcemap/sourcemap v2.1.4+incompatible // indirect
 	github.com/go-task/slim-sprig/v3 v3.0.0 // indirect
 	github.com/google/go-cmp v0.6.0 // indirect
 	github.com/google/pprof v0.0.0-20250128161936-077ca0a936bf // indirect
+	github.com/grafana/sobek v0.0.0-20260429085637-a66d4790012b // indirect
 	golang.org/x/net v0.34.0 // indirect
 	golang.org/x/sys v0.29.0 // indirect
 	golang.org/x/text v0.21.0 // indirect
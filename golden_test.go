@@ -0,0 +1,120 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"path/filepath"
+
+	htmlelements "github.com/gost-dom/code-gen/html-elements"
+	"github.com/gost-dom/code-gen/output"
+	wrappers "github.com/gost-dom/code-gen/script-wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var update = flag.Bool("update", false, "Update the golden files in testdata/golden")
+
+// goldenGenerator is a generator with its output committed to testdata/golden,
+// in the folder with the name of the generator.
+type goldenGenerator struct {
+	name     string
+	generate func(output.Files) error
+}
+
+// goldenGenerators returns all generators, with the wrappers and TypeScript
+// declarations of every wrapper target.
+func goldenGenerators() []goldenGenerator {
+	var result []goldenGenerator
+	for _, t := range wrapperTargets {
+		result = append(result,
+			goldenGenerator{"wrappers-" + t.name, t.create().GenerateScriptWrappers},
+			goldenGenerator{"dts-" + t.name, t.create().GenerateTypeScriptDeclarations},
+		)
+	}
+	return append(result,
+		goldenGenerator{"wrappers-goja-extra",
+			extraGenerator(wrappers.NewGojaWrapperModuleGenerator()).GenerateScriptWrappers},
+		goldenGenerator{"wrappers-v8-extra",
+			extraGenerator(wrappers.NewScriptWrapperModulesGenerator()).GenerateScriptWrappers},
+		goldenGenerator{"wrappers-goja-generic",
+			genericGenerator(wrappers.NewGojaWrapperModuleGenerator()).GenerateScriptWrappers},
+		goldenGenerator{"wrappers-v8-generic",
+			genericGenerator(wrappers.NewScriptWrapperModulesGenerator()).GenerateScriptWrappers},
+		goldenGenerator{"elements", htmlelements.Generator{}.GenerateHTMLElements},
+		goldenGenerator{"dom", htmlelements.Generator{}.GenerateDOMTypes},
+		goldenGenerator{"tagmap", func(out output.Files) error {
+			var buf bytes.Buffer
+			if err := generateHtmlElements(&buf); err != nil {
+				return err
+			}
+			// Not a .go file, as the output of tagmap isn't formatted
+			return out.WriteFile("html_elements.golden", buf.Bytes())
+		}},
+	)
+}
+
+// extraGenerator configures gen to generate wrappers of interfaces that the
+// script hosts don't wrap, covering code the targets don't generate for the
+// hosts:
+//
+//   - Nullable return types: an enum, NavigationType, and interfaces,
+//     HTMLFormElement and HTMLElement. Only interfaces are checked for nil.
+//   - Mixins, ChildNode and NonDocumentTypeChildNode, included by
+//     CharacterData, which customizes the ChildNode member, remove.
+//   - An async iterable, ReadableStream.
+func extraGenerator(gen wrappers.ScriptWrapperModulesGenerator) wrappers.ScriptWrapperModulesGenerator {
+	gen.Specs = wrappers.NewWrapperGeneratorsSpec()
+	dom := gen.Specs.Module("dom")
+	dom.SetMultipleFiles(true)
+	dom.Type("CharacterData").Method("remove").SetNotImplemented()
+	html := gen.Specs.Module("html")
+	html.SetMultipleFiles(true)
+	html.Type("HTMLLabelElement")
+	html.Type("NavigationCurrentEntryChangeEvent")
+	streams := gen.Specs.Module("streams")
+	streams.SetMultipleFiles(true)
+	streams.Type("ReadableStream")
+	gen.HostClasses = []string{"Event", "HTMLElement", "Node"}
+	return gen
+}
+
+// genericGenerator configures gen to generate wrappers of interfaces with
+// members of the generic types, which the script hosts don't wrap: sequence
+// results of Headers.getSetCookie, URLSearchParams.getAll, and
+// Event.composedPath. The constructors take unions, which aren't generated.
+// Unlike the other extra wrappers, these are type-checked against the stubs.
+func genericGenerator(gen wrappers.ScriptWrapperModulesGenerator) wrappers.ScriptWrapperModulesGenerator {
+	gen.Specs = wrappers.NewWrapperGeneratorsSpec()
+	event := gen.Specs.Module("dom").Type("Event")
+	event.CreateWrapper()
+	event.Method("constructor").SetNotImplemented()
+	headers := gen.Specs.Module("fetch").Type("Headers")
+	headers.CreateWrapper()
+	headers.Method("constructor").SetNotImplemented()
+	params := gen.Specs.Module("url").Type("URLSearchParams")
+	params.CreateWrapper()
+	params.Method("constructor").SetNotImplemented()
+	gen.HostClasses = []string{"EventTarget"}
+	return gen
+}
+
+// The golden files show the full effect of a change to the generators in the
+// diff of a pull request. Run "go test . -update" to update them.
+var _ = Describe("Golden files", func() {
+	for _, gen := range goldenGenerators() {
+		dir := filepath.Join("testdata", "golden", gen.name)
+
+		It("matches the generated files of "+gen.name, func() {
+			if *update {
+				out := output.NewDir(dir, gen.name)
+				Expect(gen.generate(out)).To(Succeed())
+				Expect(out.Close()).To(Succeed())
+				return
+			}
+			check := output.NewCheck(dir, gen.name)
+			Expect(gen.generate(check)).To(Succeed())
+			Expect(check.Close()).To(Succeed())
+			Expect(check.Stale()).To(BeEmpty(), "Run 'go test . -update' to update\n"+check.Diff())
+		})
+	}
+})
@@ -1,6 +1,9 @@
 package htmlelements_test
 
 import (
+	"bytes"
+	"log/slog"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -19,3 +22,20 @@ var _ = Describe("ElementGenerator", func() {
 			`ToJSON() (string, error)`)))
 	})
 })
+
+var _ = Describe("Interface generator", func() {
+	It("skips unnamed operations, logging to the logger of the request", func() {
+		var buf bytes.Buffer
+		gen, err := CreateGenerator(HTMLGeneratorReq{
+			InterfaceName:     "DOMStringMap",
+			SpecName:          "html",
+			GenerateInterface: true,
+			Logger:            slog.New(slog.NewTextHandler(&buf, nil)),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(gen.GenerateInterface()).To(HaveRendered("type DOMStringMap interface{}"))
+		Expect(buf.String()).To(ContainSubstring(
+			`msg="Unnamed operation not supported" interface=DOMStringMap special=getter`,
+		))
+	})
+})
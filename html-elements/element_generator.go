@@ -3,6 +3,8 @@ package htmlelements
 import (
 	"errors"
 	"fmt"
+	"log/slog"
+	"slices"
 	"strings"
 
 	g "github.com/gost-dom/generators"
@@ -31,6 +33,12 @@ type HTMLGeneratorReq struct {
 	GenerateConstructor bool
 	GenerateInterface   bool
 	GenerateAttributes  bool
+	// ExcludedMixins contains the names of included mixins whose members
+	// should not be added to the generated interface.
+	ExcludedMixins []string
+	// Logger logs the messages of the generator. If nil, slog.Default() is
+	// used.
+	Logger *slog.Logger
 }
 
 /* -------- baseGenerator -------- */
@@ -50,22 +58,48 @@ func CreateGenerator(req HTMLGeneratorReq) (baseGenerator, error) {
 	}, err
 }
 
+func (gen baseGenerator) log() *slog.Logger {
+	logger := gen.req.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return logger.With("interface", gen.req.InterfaceName)
+}
+
 func (gen baseGenerator) GenerateInterface() g.Generator {
 	attributes := make([]IdlInterfaceAttribute, 0)
 	operations := make([]IdlInterfaceOperation, 0)
 
-	interfaces := make([]idl.Interface, 1+len(gen.idlType.Includes))
-	interfaces[0] = gen.idlType
-	copy(interfaces[1:], gen.idlType.Includes)
+	interfaces := []idl.Interface{gen.idlType}
+	for _, i := range gen.idlType.Includes {
+		// Mixins defined in other specs are not resolved, and have no name.
+		if i.Name != "" && !slices.Contains(gen.req.ExcludedMixins, i.Name) {
+			interfaces = append(interfaces, i)
+		}
+	}
 
+	names := make(map[string]bool)
 	for _, i := range interfaces {
 		for _, a := range i.Attributes {
+			if names[a.Name] {
+				continue
+			}
+			names[a.Name] = true
 			attributes = append(attributes, IdlInterfaceAttribute{
 				Name:     a.Name,
 				ReadOnly: a.Readonly,
 			})
 		}
 		for _, o := range i.Operations {
+			// Special operations, e.g., named property getters, can be unnamed
+			if o.Name == "" {
+				gen.log().Warn("Unnamed operation not supported", "special", o.InternalSpec.Special)
+				continue
+			}
+			if names[o.Name] {
+				continue
+			}
+			names[o.Name] = true
 			operations = append(operations, IdlInterfaceOperation{o})
 		}
 	}
@@ -186,8 +220,10 @@ var HTMLAnchorElementSpecs = HTMLGeneratorReq{
 	GenerateAttributes: true,
 }
 
-func CreateHTMLElementGenerators() ([]FileGeneratorSpec, error) {
-	generator, error := CreateHTMLElementGenerator(HTMLAnchorElementSpecs)
+func CreateHTMLElementGenerators(logger *slog.Logger) ([]FileGeneratorSpec, error) {
+	req := HTMLAnchorElementSpecs
+	req.Logger = logger
+	generator, error := CreateHTMLElementGenerator(req)
 	return []FileGeneratorSpec{
 		{"html_anchor_element",
 			"github.com/gost-dom/browser/html",
@@ -202,13 +238,14 @@ var URLSpec = HTMLGeneratorReq{
 	GenerateInterface: true,
 }
 
-func CreateDOMGenerators() ([]FileGeneratorSpec, error) {
+func CreateDOMGenerators(logger *slog.Logger) ([]FileGeneratorSpec, error) {
 	// return []FileGeneratorSpec{}, nil
 	generator, error := CreateGenerator(HTMLGeneratorReq{
 		InterfaceName:      "URL",
 		SpecName:           "url",
 		GenerateInterface:  true,
 		GenerateAttributes: true,
+		Logger:             logger,
 	})
 	return []FileGeneratorSpec{{
 		"url",
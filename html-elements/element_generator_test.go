@@ -54,6 +54,14 @@ var _ = Describe("ElementGenerator", func() {
 			Expect(GenerateHtmlAnchor()).ToNot(HaveRendered(ContainSubstring("\tSetOrigin(")))
 		})
 
+		It("Should not have interface for URL properties when the mixin is excluded", func() {
+			req := HTMLAnchorElementSpecs
+			req.ExcludedMixins = []string{"HTMLHyperlinkElementUtils"}
+			gen, err := CreateHTMLElementGenerator(req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(gen.Generator()).ToNot(HaveRendered(ContainSubstring("\n\tHost() string\n")))
+		})
+
 		It("Should not have an implementation for URL properties", func() {
 			Expect(
 				GenerateHtmlAnchor(),
@@ -1,48 +1,54 @@
 package htmlelements
 
 import (
+	"bytes"
 	"fmt"
-	"os"
+	"log/slog"
 
 	"github.com/dave/jennifer/jen"
+	"github.com/gost-dom/code-gen/output"
 )
 
-func writeFile(s FileGeneratorSpec) error {
+func writeFile(out output.Files, s FileGeneratorSpec) error {
 	jf := jen.NewFilePath(s.Package)
 	jf.HeaderComment("This file is generated. Do not edit.")
 	jf.Add(s.Generator.Generate())
 	outputFileName := fmt.Sprintf("%s_generated.go", s.Name)
-	if writer, err := os.Create(outputFileName); err != nil {
+	var buf bytes.Buffer
+	if err := jf.Render(&buf); err != nil {
 		return err
-	} else {
-		defer writer.Close()
-		if err = jf.Render(writer); err != nil {
-			return err
-		}
 	}
-	return nil
+	return out.WriteFile(outputFileName, buf.Bytes())
+}
+
+// Generator generates the Go code of HTML elements and DOM types from the IDL
+// specs.
+type Generator struct {
+	// Logger logs the messages of the generators, with the interface as an
+	// attribute. If nil, slog.Default() is used.
+	Logger *slog.Logger
 }
 
-func GenerateHTMLElements() error {
-	files, err := CreateHTMLElementGenerators()
+func (gen Generator) GenerateHTMLElements(out output.Files) error {
+	files, err := CreateHTMLElementGenerators(gen.Logger)
 	if err != nil {
 		return err
 	}
 	for _, f := range files {
-		if err = writeFile(f); err != nil {
+		if err = writeFile(out, f); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func GenerateDOMTypes() error {
-	files, err := CreateDOMGenerators()
+func (gen Generator) GenerateDOMTypes(out output.Files) error {
+	files, err := CreateDOMGenerators(gen.Logger)
 	if err != nil {
 		return err
 	}
 	for _, f := range files {
-		if err = writeFile(f); err != nil {
+		if err = writeFile(out, f); err != nil {
 			return err
 		}
 	}
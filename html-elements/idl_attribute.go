@@ -2,7 +2,6 @@ package htmlelements
 
 import (
 	"fmt"
-	"log/slog"
 	"unicode"
 
 	"github.com/dave/jennifer/jen"
@@ -10,10 +9,11 @@ import (
 	g "github.com/gost-dom/generators"
 )
 
+// upperCaseFirstLetter returns s with the first letter in upper case. Unnamed
+// members are skipped by the generators, so s is never empty.
 func upperCaseFirstLetter(s string) string {
 	strLen := len(s)
 	if strLen == 0 {
-		slog.Warn("Passing empty string to upperCaseFirstLetter")
 		return ""
 	}
 	buffer := make([]rune, 0, strLen)
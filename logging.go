@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync/atomic"
+)
+
+// logFlags are the flags configuring the log, shared by all commands. Messages
+// are written to stderr, as the generated output can be written to stdout.
+type logFlags struct {
+	verbose bool
+	quiet   bool
+	format  string
+	strict  bool
+
+	// logger is the logger passed to the generators, created by setup.
+	logger *slog.Logger
+	// warnings counts the warnings and errors logged to logger.
+	warnings atomic.Int64
+}
+
+func (f *logFlags) register(flags *flag.FlagSet) {
+	flags.BoolVar(&f.verbose, "v", false, "Log debug messages")
+	flags.BoolVar(&f.quiet, "q", false, "Only log errors")
+	flags.StringVar(&f.format, "log-format", "text", "Log format, text or json")
+	flags.BoolVar(&f.strict, "strict", false, "Fail if the generators log warnings")
+}
+
+// setup creates the logger from the parsed flags, writing to w. The logger is
+// also made the default logger, used by code that isn't passed a logger, but
+// only warnings logged to the logger passed to the generators are counted in
+// strict mode.
+func (f *logFlags) setup(w io.Writer) error {
+	if f.verbose && f.quiet {
+		return usageError{"-v and -q are mutually exclusive"}
+	}
+	options := &slog.HandlerOptions{Level: slog.LevelWarn}
+	switch {
+	case f.verbose:
+		options.Level = slog.LevelDebug
+	case f.quiet:
+		options.Level = slog.LevelError
+	}
+	var handler slog.Handler
+	switch f.format {
+	case "text":
+		handler = slog.NewTextHandler(w, options)
+	case "json":
+		handler = slog.NewJSONHandler(w, options)
+	default:
+		return usageError{fmt.Sprintf("unknown log format %q", f.format)}
+	}
+	slog.SetDefault(slog.New(handler))
+	f.logger = slog.New(warningCounter{handler, &f.warnings})
+	return nil
+}
+
+// strictError returns an error in strict mode if the generators logged
+// warnings.
+func (f *logFlags) strictError() error {
+	if n := f.warnings.Load(); f.strict && n > 0 {
+		return fmt.Errorf("%d warnings logged in strict mode", n)
+	}
+	return nil
+}
+
+// warningCounter is a log handler counting the warnings and errors. These are
+// counted even when not written, e.g., in quiet mode.
+type warningCounter struct {
+	slog.Handler
+	count *atomic.Int64
+}
+
+func (h warningCounter) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= slog.LevelWarn || h.Handler.Enabled(ctx, level)
+}
+
+func (h warningCounter) Handle(ctx context.Context, r slog.Record) error {
+	if r.Level >= slog.LevelWarn {
+		h.count.Add(1)
+	}
+	if !h.Handler.Enabled(ctx, r.Level) {
+		return nil
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h warningCounter) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return warningCounter{h.Handler.WithAttrs(attrs), h.count}
+}
+
+func (h warningCounter) WithGroup(name string) slog.Handler {
+	return warningCounter{h.Handler.WithGroup(name), h.count}
+}
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/gost-dom/code-gen/output"
+	wrappers "github.com/gost-dom/code-gen/script-wrappers"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Log flags", func() {
+	var log logFlags
+	var buf bytes.Buffer
+
+	BeforeEach(func() {
+		log = logFlags{format: "text"}
+		buf.Reset()
+		defaultLogger := slog.Default()
+		DeferCleanup(func() { slog.SetDefault(defaultLogger) })
+	})
+
+	It("fails in strict mode when the generators log warnings", func() {
+		log.strict = true
+		Expect(log.setup(&buf)).To(Succeed())
+		log.logger.Info("Information")
+		Expect(log.strictError()).To(Succeed())
+		log.logger.With("module", "dom").Warn("Warning")
+		Expect(log.strictError()).To(MatchError("1 warnings logged in strict mode"))
+		Expect(buf.String()).To(ContainSubstring(`msg=Warning module=dom`))
+	})
+
+	It("counts warnings that are not written in quiet mode", func() {
+		log.strict = true
+		log.quiet = true
+		Expect(log.setup(&buf)).To(Succeed())
+		log.logger.Warn("Warning")
+		Expect(log.strictError()).To(HaveOccurred())
+		Expect(buf.String()).To(BeEmpty())
+	})
+
+	It("counts the warnings about overloads once for each interface member", func() {
+		log.strict = true
+		Expect(log.setup(&buf)).To(Succeed())
+		gen := wrappers.NewScriptWrapperModulesGenerator()
+		gen.Logger = log.logger
+		Expect(gen.GenerateScriptWrappers(output.Memory{})).To(Succeed())
+		overloads := strings.Count(buf.String(), `msg="Function overloads"`)
+		Expect(overloads).To(BeNumerically(">", 0))
+		Expect(log.strictError()).To(MatchError(
+			fmt.Sprintf("%d warnings logged in strict mode", overloads),
+		))
+		Expect(strings.Count(buf.String(),
+			`msg="Function overloads" module=html interface=WindowOrWorkerGlobalScope member=createImageBitmap`,
+		)).To(Equal(1))
+	})
+})
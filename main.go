@@ -1,82 +1,505 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
+	"text/tabwriter"
+	"time"
 
 	htmlelements "github.com/gost-dom/code-gen/html-elements"
+	"github.com/gost-dom/code-gen/output"
 	wrappers "github.com/gost-dom/code-gen/script-wrappers"
 )
 
-func getWriter(output string) io.Writer {
-	if output == "stdout" {
-		return os.Stdout
+const progName = "codegen"
+
+// command is a subcommand of the code generator, e.g., "codegen elements".
+type command struct {
+	name        string
+	args        string
+	description string
+	run         func(cmd command, args []string) error
+	// log are the log flags of the command, set when the command is run.
+	log *logFlags
+}
+
+// wrapperTargetArgs is the argument of commands taking a wrapper target.
+const wrapperTargetArgs = "<v8|goja|sobek>"
+
+// wrapperTarget is a script engine wrappers can be generated for.
+type wrapperTarget struct {
+	name        string
+	description string
+	create      func() wrappers.ScriptWrapperModulesGenerator
+}
+
+// wrapperTargets are the script engines wrappers can be generated for, used
+// as the argument to the "wrappers", "dts", and "converters" commands.
+var wrapperTargets = []wrapperTarget{
+	{"v8", "V8 script host, using v8go", wrappers.NewScriptWrapperModulesGenerator},
+	{"goja", "goja script host", wrappers.NewGojaWrapperModuleGenerator},
+	{"sobek", "sobek script host, using the goja target", wrappers.NewSobekWrapperModuleGenerator},
+}
+
+// commands are the subcommands of the code generator. They are initialized in
+// init, as the "list" command refers to the commands.
+var commands []command
+
+func init() {
+	commands = []command{
+		{
+			name:        "wrappers",
+			args:        wrapperTargetArgs,
+			description: "Generate JavaScript wrappers of the DOM for a script engine",
+			run:         runWrappers,
+		},
+		{
+			name:        "dts",
+			args:        wrapperTargetArgs,
+			description: "Generate a TypeScript declaration file of the DOM exposed to a script engine",
+			run:         runTypeScriptDeclarations,
+		},
+		{
+			name:        "converters",
+			args:        wrapperTargetArgs,
+			description: "List the converters the generated wrappers call on the script host",
+			run:         runConverters,
+		},
+		{
+			name:        "elements",
+			description: "Generate IDL attributes of HTML elements for the html package",
+			run:         generatorCommand(htmlelements.Generator.GenerateHTMLElements),
+		},
+		{
+			name:        "dom",
+			description: "Generate interfaces of DOM types for the dom package",
+			run:         generatorCommand(htmlelements.Generator.GenerateDOMTypes),
+		},
+		{
+			name:        "tagmap",
+			description: "Generate the map from HTML tag names to element interfaces",
+			run:         runTagMap,
+		},
+		{
+			name:        "report",
+			description: "Report the API coverage of the wrappers per interface, spec, and engine",
+			run:         runReport,
+		},
+		{
+			name:        "list",
+			description: "List the available generators",
+			run:         runList,
+		},
+	}
+}
+
+// usageError is returned for invalid command line arguments, making the
+// command exit with status 2, like the flag package does.
+type usageError struct{ msg string }
+
+func (e usageError) Error() string { return e.msg }
+
+// errStale is returned from check mode when generated files are not up to
+// date.
+var errStale = errors.New("generated files are not up to date")
+
+func main() {
+	os.Exit(run(os.Args[1:]))
+}
+
+// run runs the command in args, and returns the exit status.
+func run(args []string) int {
+	if len(args) == 0 {
+		printUsage(os.Stderr)
+		return 2
+	}
+	switch args[0] {
+	case "-h", "-help", "--help", "help":
+		printUsage(os.Stdout)
+		return 0
+	}
+	cmd, ok := findCommand(args[0])
+	if !ok {
+		fmt.Fprintf(os.Stderr, "%s: unknown command %q\n", progName, args[0])
+		fmt.Fprintf(os.Stderr, "Run '%s help' for usage.\n", progName)
+		return 2
+	}
+	cmd.log = new(logFlags)
+	err := cmd.run(cmd, args[1:])
+	if err == nil {
+		err = cmd.log.strictError()
+	}
+	var usageErr usageError
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.As(err, &usageErr):
+		fmt.Fprintf(os.Stderr, "%s %s: %v\n", progName, cmd.name, err)
+		return 2
+	default:
+		fmt.Fprintf(os.Stderr, "%s %s: %v\n", progName, cmd.name, err)
+		return 1
+	}
+}
+
+func findCommand(name string) (command, bool) {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return command{}, false
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: %s <command> [flags]\n\n", progName)
+	fmt.Fprintln(w, "Generates code for Gost-DOM from web IDL specifications.")
+	fmt.Fprintln(w, "\nCommands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", cmd.name, cmd.description)
+	}
+	fmt.Fprintf(w, "\nRun '%s <command> -h' for help on a command.\n", progName)
+}
+
+// newFlagSet creates the flag set of the command, with the log flags. The flag
+// set doesn't print anything itself; parseFlags prints the usage of the
+// command.
+func newFlagSet(cmd command) *flag.FlagSet {
+	flags := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	flags.Usage = func() {}
+	cmd.log.register(flags)
+	return flags
+}
+
+// parseFlags parses the arguments of the command, and sets up the log. It
+// returns an error if the arguments are invalid, or help was requested; in
+// which case the usage of the command is printed.
+func parseFlags(cmd command, flags *flag.FlagSet, args []string) error {
+	err := flags.Parse(args)
+	if errors.Is(err, flag.ErrHelp) {
+		printCommandUsage(os.Stdout, cmd, flags)
+		return err
 	}
-	file, err := os.Create(output)
 	if err != nil {
-		fmt.Println("Error creating output file")
-		os.Exit(1)
+		printCommandUsage(os.Stderr, cmd, flags)
+		return usageError{err.Error()}
 	}
-	return file
+	return cmd.log.setup(os.Stderr)
 }
 
-var generators = map[string]func(io.Writer) error{
-	"html-elements": generateHtmlElements,
+func printCommandUsage(w io.Writer, cmd command, flags *flag.FlagSet) {
+	usage := strings.TrimSpace(fmt.Sprintf("%s %s %s", progName, cmd.name, cmd.args))
+	fmt.Fprintf(w, "Usage: %s [flags]\n\n%s.\n", usage, cmd.description)
+	if cmd.args == wrapperTargetArgs {
+		fmt.Fprintln(w, "\nTargets:")
+		for _, t := range wrapperTargets {
+			fmt.Fprintf(w, "  %-10s %s\n", t.name, t.description)
+		}
+	}
+	fmt.Fprintln(w, "\nFlags:")
+	flags.SetOutput(w)
+	flags.PrintDefaults()
+	flags.SetOutput(io.Discard)
 }
 
-func main() {
-	debug := flag.Bool("d", false, "Debug")
-	outputFile := flag.String("o", "", "Output file to write")
-	generatorType := flag.String("g", "", "Generator type")
-	flag.Parse()
-	switch *generatorType {
-	case "goja":
-		gen := wrappers.NewGojaWrapperModuleGenerator()
-		exitOnError(gen.GenerateScriptWrappers())
-		os.Exit(0)
-		return
-	case "scripting":
-		gen := wrappers.NewScriptWrapperModulesGenerator()
-		exitOnError(gen.GenerateScriptWrappers())
-		os.Exit(0)
-		return
-	case "htmlelements":
-		exitOnError(htmlelements.GenerateHTMLElements())
-		os.Exit(0)
-		return
-	case "dom":
-		exitOnError(htmlelements.GenerateDOMTypes())
-		os.Exit(0)
-		return
-	}
-
-	if *outputFile == "" || *generatorType == "" {
-		fmt.Println("Internal code generator from IDL definitions")
-		flag.PrintDefaults()
-		os.Exit(1)
-	}
-	if *debug {
-		fmt.Println(strings.Join(os.Args, " "))
-		fmt.Println("--------")
-	}
-
-	file := getWriter(*outputFile)
-
-	generator, ok := generators[*generatorType]
-	if !ok {
-		os.Exit(1)
+// outputFlags are the flags of commands generating files in a directory.
+type outputFlags struct {
+	dir     string
+	check   bool
+	noCache bool
+}
+
+func (f *outputFlags) register(flags *flag.FlagSet) {
+	flags.StringVar(&f.dir, "out", ".", "Directory to write the generated files to")
+	flags.BoolVar(
+		&f.check,
+		"check",
+		false,
+		"Check that the generated files are up to date, without writing them",
+	)
+	flags.BoolVar(
+		&f.noCache,
+		"no-cache",
+		false,
+		"Generate all files, also those generated from unchanged inputs by a previous run",
+	)
+}
+
+// generate runs the generator, writing the files to the output directory, or
+// checking that the files there are up to date. The name identifies the
+// generator in the manifest of generated files.
+func (f outputFlags) generate(name string, generator func(output.Files) error) error {
+	if !f.check {
+		dir := output.NewDir(f.dir, name)
+		dir.Cache = !f.noCache
+		if err := generator(dir); err != nil {
+			return err
+		}
+		return dir.Close()
+	}
+	check := output.NewCheck(f.dir, name)
+	if err := generator(check); err != nil {
+		return err
 	}
-	err := generator(file)
-	exitOnError(err)
+	if err := check.Close(); err != nil {
+		return err
+	}
+	return reportStale(check)
 }
 
-func exitOnError(err error) {
+// reportStale prints the differences to stdout if check mode found generated
+// files that are not up to date.
+func reportStale(check *output.Check) error {
+	if stale := check.Stale(); len(stale) > 0 {
+		fmt.Print(check.Diff())
+		return fmt.Errorf("%w: %s", errStale, strings.Join(stale, ", "))
+	}
+	return nil
+}
+
+// generatorCommand creates the run function of a command without arguments,
+// generating files in the output directory.
+func generatorCommand(
+	generate func(htmlelements.Generator, output.Files) error,
+) func(command, []string) error {
+	return func(cmd command, args []string) error {
+		var out outputFlags
+		flags := newFlagSet(cmd)
+		out.register(flags)
+		if err := parseFlags(cmd, flags, args); err != nil {
+			return err
+		}
+		if flags.NArg() > 0 {
+			return usageError{fmt.Sprintf("unexpected argument %q", flags.Arg(0))}
+		}
+		gen := htmlelements.Generator{Logger: cmd.log.logger}
+		return out.generate(cmd.name, func(files output.Files) error {
+			return generate(gen, files)
+		})
+	}
+}
+
+func runWrappers(cmd command, args []string) error {
+	return runWrapperTarget(cmd, args, wrappers.ScriptWrapperModulesGenerator.GenerateScriptWrappers)
+}
+
+func runTypeScriptDeclarations(cmd command, args []string) error {
+	return runWrapperTarget(
+		cmd,
+		args,
+		wrappers.ScriptWrapperModulesGenerator.GenerateTypeScriptDeclarations,
+	)
+}
+
+// runWrapperTarget runs a command taking a wrapper target as argument, e.g.,
+// "wrappers v8", generating the files for the wrappers of the target.
+func runWrapperTarget(
+	cmd command,
+	args []string,
+	generate func(wrappers.ScriptWrapperModulesGenerator, output.Files) error,
+) error {
+	var out outputFlags
+	flags := newFlagSet(cmd)
+	out.register(flags)
+	timing := flags.Bool("timing", false, "Print the time spent generating each file to stderr")
+	t, err := parseWrapperTarget(cmd, flags, args)
+	if err != nil {
+		return err
+	}
+	gen := t.create()
+	gen.Logger = cmd.log.logger
+	if *timing {
+		start := time.Now()
+		gen.OnFileGenerated = func(name string, elapsed time.Duration) {
+			fmt.Fprintf(os.Stderr, "%10v  %s\n", elapsed.Round(10*time.Microsecond), name)
+		}
+		defer func() {
+			fmt.Fprintf(os.Stderr, "%10v  total\n", time.Since(start).Round(time.Millisecond))
+		}()
+	}
+	return out.generate(cmd.name+"-"+t.name, func(files output.Files) error {
+		return generate(gen, files)
+	})
+}
+
+// parseWrapperTarget parses the arguments of a command taking a wrapper target
+// as the first argument, followed by the flags of the command.
+func parseWrapperTarget(cmd command, flags *flag.FlagSet, args []string) (wrapperTarget, error) {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		if err := parseFlags(cmd, flags, args); err != nil {
+			return wrapperTarget{}, err
+		}
+		printCommandUsage(os.Stderr, cmd, flags)
+		return wrapperTarget{}, usageError{"missing target"}
+	}
+	target, targetArgs := args[0], args[1:]
+	if err := parseFlags(cmd, flags, targetArgs); err != nil {
+		return wrapperTarget{}, err
+	}
+	if flags.NArg() > 0 {
+		return wrapperTarget{}, usageError{fmt.Sprintf("unexpected argument %q", flags.Arg(0))}
+	}
+	for _, t := range wrapperTargets {
+		if t.name == target {
+			return t, nil
+		}
+	}
+	return wrapperTarget{}, usageError{fmt.Sprintf("unknown target %q", target)}
+}
+
+// runConverters writes the manifest of the converters called by the generated
+// wrappers of a target. With a script host directory, the converters missing
+// there are marked, and stubs of these can be written to a file.
+func runConverters(cmd command, args []string) error {
+	flags := newFlagSet(cmd)
+	format := flags.String("format", "text", "Output format, text or json")
+	outputFile := flags.String("o", "", "Output file to write, or stdout if empty")
+	hostDir := flags.String("host", "", "Directory of the script host package, to find missing converters")
+	stubsFile := flags.String("stubs", "", "File to write stubs of the missing converters to; requires -host")
+	t, err := parseWrapperTarget(cmd, flags, args)
+	if err != nil {
+		return err
+	}
+	if *format != "text" && *format != "json" {
+		return usageError{fmt.Sprintf("unknown format %q", *format)}
+	}
+	if *stubsFile != "" && *hostDir == "" {
+		return usageError{"-stubs requires -host"}
+	}
+	gen := t.create()
+	gen.Logger = cmd.log.logger
+	manifest, err := gen.CollectConverters()
+	if err != nil {
+		return err
+	}
+	if *hostDir != "" {
+		declared, err := wrappers.ReadDeclarations(*hostDir)
+		if err != nil {
+			return err
+		}
+		manifest.FindMissing(declared)
+	}
+	if *stubsFile != "" {
+		var buf bytes.Buffer
+		if err := gen.WriteConverterStubs(&buf, manifest); err != nil {
+			return err
+		}
+		if err := os.WriteFile(*stubsFile, buf.Bytes(), 0644); err != nil {
+			return err
+		}
+	}
+	return writeOutput(*outputFile, func(w io.Writer) error {
+		if *format == "json" {
+			encoder := json.NewEncoder(w)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(manifest)
+		}
+		return manifest.WriteText(w)
+	})
+}
+
+// writeOutput calls write with the file created with the name, or stdout if
+// the name is empty. The error closing the file is returned, as a write may
+// fail when the file is closed.
+func writeOutput(name string, write func(io.Writer) error) (err error) {
+	if name == "" {
+		return write(os.Stdout)
+	}
+	file, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errors.Join(err, file.Close()) }()
+	return write(file)
+}
+
+func runTagMap(cmd command, args []string) error {
+	flags := newFlagSet(cmd)
+	outputFile := flags.String("o", "", "Output file to write, or stdout if empty")
+	check := flags.Bool("check", false, "Check that the output file is up to date, without writing it")
+	if err := parseFlags(cmd, flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return usageError{fmt.Sprintf("unexpected argument %q", flags.Arg(0))}
+	}
+	switch {
+	case *check && *outputFile == "":
+		return usageError{"-check requires an output file"}
+	case *check:
+		var buf bytes.Buffer
+		if err := generateHtmlElements(&buf); err != nil {
+			return err
+		}
+		c := output.NewCheck(filepath.Dir(*outputFile), cmd.name)
+		if err := c.WriteFile(filepath.Base(*outputFile), buf.Bytes()); err != nil {
+			return err
+		}
+		return reportStale(c)
+	}
+	return writeOutput(*outputFile, generateHtmlElements)
+}
+
+func runList(cmd command, args []string) error {
+	flags := newFlagSet(cmd)
+	if err := parseFlags(cmd, flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return usageError{fmt.Sprintf("unexpected argument %q", flags.Arg(0))}
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	for _, c := range commands {
+		switch {
+		case c.name == "list":
+		case c.args == wrapperTargetArgs:
+			for _, t := range wrapperTargets {
+				fmt.Fprintf(w, "%s %s\t%s\n", c.name, t.name, t.description)
+			}
+		default:
+			fmt.Fprintf(w, "%s\t%s\n", c.name, c.description)
+		}
+	}
+	return w.Flush()
+}
+
+func runReport(cmd command, args []string) error {
+	flags := newFlagSet(cmd)
+	format := flags.String("format", "markdown", "Output format, markdown or json")
+	outputFile := flags.String("o", "", "Output file to write, or stdout if empty")
+	if err := parseFlags(cmd, flags, args); err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return usageError{fmt.Sprintf("unexpected argument %q", flags.Arg(0))}
+	}
+	if *format != "markdown" && *format != "json" {
+		return usageError{fmt.Sprintf("unknown format %q", *format)}
+	}
+	targets := make([]wrappers.CoverageTarget, len(wrapperTargets))
+	for i, t := range wrapperTargets {
+		specs := t.create().Specs
+		specs.SetLogger(cmd.log.logger.With("target", t.name))
+		targets[i] = wrappers.CoverageTarget{Name: t.name, Specs: specs}
+	}
+	report, err := wrappers.CreateCoverageReport(targets)
 	if err != nil {
-		fmt.Println("Error running generator")
-		fmt.Println(err)
-		os.Exit(1)
+		return err
 	}
+	return writeOutput(*outputFile, func(w io.Writer) error {
+		if *format == "json" {
+			encoder := json.NewEncoder(w)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(report)
+		}
+		return report.WriteMarkdown(w)
+	})
 }
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Generator commands", func() {
+	var dir string
+	var stdout *os.File
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		var err error
+		stdout, err = os.Create(filepath.Join(GinkgoT().TempDir(), "stdout"))
+		Expect(err).ToNot(HaveOccurred())
+		original, originalErr := os.Stdout, os.Stderr
+		os.Stdout, os.Stderr = stdout, stdout
+		DeferCleanup(func() {
+			os.Stdout, os.Stderr = original, originalErr
+			stdout.Close()
+		})
+	})
+
+	output := func() string {
+		content, err := os.ReadFile(stdout.Name())
+		Expect(err).ToNot(HaveOccurred())
+		return string(content)
+	}
+
+	It("writes the files to the directory given by -out", func() {
+		wd, err := os.Getwd()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(run([]string{"elements", "-out", dir})).To(Equal(0))
+		Expect(filepath.Join(dir, "html_anchor_element_generated.go")).To(BeAnExistingFile())
+		Expect(filepath.Join(dir, ".elements.generated")).To(BeAnExistingFile())
+		Expect(filepath.Join(wd, "html_anchor_element_generated.go")).ToNot(BeAnExistingFile())
+	})
+
+	It("exits with status 0 in check mode when the files are up to date", func() {
+		Expect(run([]string{"elements", "-out", dir})).To(Equal(0))
+		Expect(run([]string{"elements", "-check", "-out", dir})).To(Equal(0))
+		Expect(output()).To(BeEmpty())
+	})
+
+	It("prints a diff, and exits with status 1, in check mode when a file is stale", func() {
+		Expect(run([]string{"elements", "-out", dir})).To(Equal(0))
+		file := filepath.Join(dir, "html_anchor_element_generated.go")
+		content, err := os.ReadFile(file)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.WriteFile(file, append(content, "// Edited\n"...), 0666)).To(Succeed())
+
+		Expect(run([]string{"elements", "-check", "-out", dir})).To(Equal(1))
+		Expect(output()).To(ContainSubstring(
+			"--- a/html_anchor_element_generated.go\n+++ b/html_anchor_element_generated.go\n",
+		))
+		Expect(output()).To(ContainSubstring("-// Edited\n"))
+		Expect(output()).To(ContainSubstring(
+			"codegen elements: generated files are not up to date: html_anchor_element_generated.go",
+		))
+		Expect(os.ReadFile(file)).To(HaveSuffix("// Edited\n"))
+	})
+})
@@ -0,0 +1,151 @@
+package output
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Cache is implemented by destinations that can skip generating files from
+// unchanged inputs, e.g., [Dir] with the cache enabled.
+type Cache interface {
+	// Group returns whether the files of the group are up to date, i.e., they
+	// were generated from inputs with the same key, and are unchanged on
+	// disk. If so, the files are kept, and must not be generated again.
+	// Otherwise, the group's files must be written to the returned Files.
+	//
+	// The key identifies all inputs of the group, e.g., a hash of the
+	// configuration and the version of the generator.
+	Group(name, key string) (files Files, upToDate bool)
+}
+
+// groupCache contains the key, and the hash of each file, of the groups
+// generated.
+type groupCache struct {
+	groups map[string]cachedGroup
+}
+
+type cachedGroup struct {
+	key   string
+	files map[string]string
+}
+
+// cacheFileName returns the name of the file containing the cache of the
+// generator with the name.
+func cacheFileName(name string) string {
+	return fmt.Sprintf(".%s.cache", name)
+}
+
+const cacheHeader = "# Inputs of the files written by the %s generator. Delete to regenerate.\n"
+
+// format returns the content of the cache file, with a line per file: the
+// group, the key, the file name, and the hash of the content.
+func (c *groupCache) format(name string) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, cacheHeader, name)
+	for _, group := range slices.Sorted(maps.Keys(c.groups)) {
+		g := c.groups[group]
+		for _, file := range slices.Sorted(maps.Keys(g.files)) {
+			fmt.Fprintf(&b, "%s %s %s %s\n", group, g.key, filepath.ToSlash(file), g.files[file])
+		}
+	}
+	return b.Bytes()
+}
+
+// readCache returns the cache written by a previous run, or an empty cache if
+// there is none. Malformed lines are ignored, regenerating the group.
+func readCache(dir, name string) (*groupCache, error) {
+	result := &groupCache{groups: make(map[string]cachedGroup)}
+	content, err := os.ReadFile(filepath.Join(dir, cacheFileName(name)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 4 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		group, key, file, hash := fields[0], fields[1], filepath.FromSlash(fields[2]), fields[3]
+		if !filepath.IsLocal(file) {
+			continue
+		}
+		g, ok := result.groups[group]
+		if !ok {
+			g = cachedGroup{key: key, files: make(map[string]string)}
+		}
+		if g.key == key {
+			g.files[file] = hash
+		}
+		result.groups[group] = g
+	}
+	return result, nil
+}
+
+func hashContent(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// Group implements [Cache]. If the cache isn't enabled, no group is up to
+// date, and the files are written to the directory.
+func (d *Dir) Group(name, key string) (Files, bool) {
+	if !d.Cache {
+		return d, false
+	}
+	if d.cache == nil {
+		previous, err := readCache(d.Path, d.Name)
+		if err != nil {
+			// Not fatal, all groups are generated again
+			previous = &groupCache{groups: make(map[string]cachedGroup)}
+		}
+		d.previous = previous
+		d.cache = &groupCache{groups: make(map[string]cachedGroup)}
+	}
+	if g, ok := d.previous.groups[name]; ok && d.upToDate(g, key) {
+		for file := range g.files {
+			d.written[file] = true
+		}
+		d.cache.groups[name] = g
+		return nil, true
+	}
+	d.cache.groups[name] = cachedGroup{key: key, files: make(map[string]string)}
+	return groupFiles{d, name}, false
+}
+
+// upToDate returns whether the group was generated with the key, and all its
+// files are unchanged on disk.
+func (d *Dir) upToDate(g cachedGroup, key string) bool {
+	if g.key != key || len(g.files) == 0 {
+		return false
+	}
+	for file, hash := range g.files {
+		content, err := os.ReadFile(filepath.Join(d.Path, file))
+		if err != nil || hashContent(content) != hash {
+			return false
+		}
+	}
+	return true
+}
+
+// groupFiles writes the files of a group to the directory, and records them in
+// the cache.
+type groupFiles struct {
+	dir   *Dir
+	group string
+}
+
+func (f groupFiles) WriteFile(name string, content []byte) error {
+	f.dir.cache.groups[f.group].files[name] = hashContent(content)
+	return f.dir.WriteFile(name, content)
+}
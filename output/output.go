@@ -0,0 +1,247 @@
+// Package output contains the destinations of generated files, writing the
+// files to disk, or checking that the files on disk are up to date.
+package output
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Files is the destination of generated files.
+type Files interface {
+	// WriteFile writes a generated file, where name is relative to the output
+	// directory.
+	WriteFile(name string, content []byte) error
+}
+
+// Dir writes generated files to a directory, and keeps a manifest of the
+// files written. When closed, files written by a previous run that were not
+// written again are deleted, e.g., the files of a type removed from the
+// specs.
+//
+// Files with the same content as the file on disk are not written, keeping the
+// modification time, so build caches and file watchers don't see a change.
+type Dir struct {
+	Path string
+	// Name identifies the generator writing the files, allowing multiple
+	// generators to write to the same directory, each with their own
+	// manifest.
+	Name string
+	// Cache enables [Dir.Group], skipping groups of files generated from
+	// unchanged inputs. The cache is kept next to the manifest.
+	Cache   bool
+	written map[string]bool
+	// cache is the cache of the groups of this run, and previous of the
+	// previous run, read when the first group is written.
+	cache    *groupCache
+	previous *groupCache
+}
+
+func NewDir(path, name string) *Dir {
+	return &Dir{Path: path, Name: name, written: make(map[string]bool)}
+}
+
+func (d *Dir) WriteFile(name string, content []byte) error {
+	d.written[name] = true
+	return writeIfChanged(filepath.Join(d.Path, name), content)
+}
+
+// writeIfChanged writes the file, unless it exists with the same content.
+func writeIfChanged(fileName string, content []byte) error {
+	existing, err := os.ReadFile(fileName)
+	if err == nil && bytes.Equal(existing, content) {
+		return nil
+	}
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(fileName), 0777); err != nil {
+		return err
+	}
+	return os.WriteFile(fileName, content, 0666)
+}
+
+// Close deletes the orphaned files, and writes the manifest.
+func (d *Dir) Close() error {
+	previous, err := readManifest(d.Path, d.Name)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, name := range previous {
+		if d.written[name] {
+			continue
+		}
+		err := os.Remove(filepath.Join(d.Path, name))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			errs = append(errs, err)
+		}
+	}
+	errs = append(errs, writeIfChanged(
+		filepath.Join(d.Path, manifestFileName(d.Name)),
+		formatManifest(d.Name, d.written),
+	))
+	if d.cache != nil {
+		errs = append(errs, writeIfChanged(
+			filepath.Join(d.Path, cacheFileName(d.Name)),
+			d.cache.format(d.Name),
+		))
+	}
+	return errors.Join(errs...)
+}
+
+// Memory keeps generated files in memory, by name, e.g., to type-check the
+// generated code without writing it.
+type Memory map[string][]byte
+
+func (m Memory) WriteFile(name string, content []byte) error {
+	m[name] = bytes.Clone(content)
+	return nil
+}
+
+// Check compares generated files with the files in a directory, without
+// writing anything. Files that differ from the generated content, or don't
+// exist, are stale. When closed, orphaned files in the manifest, and the
+// manifest itself, are checked too.
+type Check struct {
+	Dir     string
+	Name    string
+	written map[string]bool
+	diffs   map[string]string
+}
+
+func NewCheck(dir, name string) *Check {
+	return &Check{
+		Dir:     dir,
+		Name:    name,
+		written: make(map[string]bool),
+		diffs:   make(map[string]string),
+	}
+}
+
+func (c *Check) WriteFile(name string, content []byte) error {
+	c.written[name] = true
+	return c.compare(name, content)
+}
+
+func (c *Check) compare(name string, content []byte) error {
+	existing, err := os.ReadFile(filepath.Join(c.Dir, name))
+	missing := errors.Is(err, fs.ErrNotExist)
+	if err != nil && !missing {
+		return err
+	}
+	if !missing && bytes.Equal(existing, content) {
+		return nil
+	}
+	from := "a/" + name
+	if missing {
+		from = "/dev/null"
+	}
+	c.diffs[name] = UnifiedDiff(from, "b/"+name, string(existing), string(content))
+	return nil
+}
+
+// Close checks for orphaned files, i.e., files in the manifest that are no
+// longer generated, and that the manifest is up to date.
+func (c *Check) Close() error {
+	previous, err := readManifest(c.Dir, c.Name)
+	if err != nil {
+		return err
+	}
+	for _, name := range previous {
+		if c.written[name] {
+			continue
+		}
+		existing, err := os.ReadFile(filepath.Join(c.Dir, name))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		c.diffs[name] = UnifiedDiff("a/"+name, "/dev/null", string(existing), "")
+	}
+	return c.compare(manifestFileName(c.Name), formatManifest(c.Name, c.written))
+}
+
+// Stale returns the names of the stale files, sorted by name.
+func (c *Check) Stale() []string {
+	names := make([]string, 0, len(c.diffs))
+	for name := range c.diffs {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Diff returns a unified diff of all stale files, from the files on disk to
+// the generated files.
+func (c *Check) Diff() string {
+	var b strings.Builder
+	for _, name := range c.Stale() {
+		b.WriteString(c.diffs[name])
+	}
+	return b.String()
+}
+
+// Err returns an error listing the stale files, or nil if all files are up to
+// date.
+func (c *Check) Err() error {
+	if stale := c.Stale(); len(stale) > 0 {
+		return fmt.Errorf("output: generated files are not up to date: %s", strings.Join(stale, ", "))
+	}
+	return nil
+}
+
+// manifestFileName returns the name of the file listing the files written by
+// the generator with the name.
+func manifestFileName(name string) string {
+	return fmt.Sprintf(".%s.generated", name)
+}
+
+const manifestHeader = "# Files written by the %s generator. Do not edit.\n"
+
+func formatManifest(name string, written map[string]bool) []byte {
+	names := make([]string, 0, len(written))
+	for name := range written {
+		names = append(names, filepath.ToSlash(name))
+	}
+	slices.Sort(names)
+	var b bytes.Buffer
+	fmt.Fprintf(&b, manifestHeader, name)
+	for _, name := range names {
+		b.WriteString(name)
+		b.WriteByte('\n')
+	}
+	return b.Bytes()
+}
+
+// readManifest returns the files written by the generator in a previous run,
+// or nil if the generator has not written to the directory before.
+func readManifest(dir, name string) ([]string, error) {
+	content, err := os.ReadFile(filepath.Join(dir, manifestFileName(name)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, line := range strings.Split(string(content), "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		// Never delete files outside the directory, e.g., from an edited
+		// manifest.
+		if name := filepath.FromSlash(line); filepath.IsLocal(name) {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
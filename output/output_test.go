@@ -0,0 +1,129 @@
+package output_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/gost-dom/code-gen/output"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Output", func() {
+	var dir string
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	write := func(name, content string) {
+		Expect(os.WriteFile(filepath.Join(dir, name), []byte(content), 0666)).To(Succeed())
+	}
+	// generate writes the files to the directory, as the generator with the
+	// name.
+	generate := func(name string, files ...string) {
+		out := output.NewDir(dir, name)
+		for _, f := range files {
+			Expect(out.WriteFile(f, []byte("package generated\n"))).To(Succeed())
+		}
+		Expect(out.Close()).To(Succeed())
+	}
+	// generated returns the Go files in the directory.
+	generated := func() []string {
+		names, err := filepath.Glob(filepath.Join(dir, "*.go"))
+		Expect(err).ToNot(HaveOccurred())
+		for i, n := range names {
+			names[i] = filepath.Base(n)
+		}
+		return names
+	}
+
+	Describe("Dir", func() {
+		It("deletes the files of a previous run that are no longer generated", func() {
+			generate("wrappers", "a_generated.go", "b_generated.go")
+			generate("wrappers", "a_generated.go")
+			Expect(generated()).To(ConsistOf("a_generated.go"))
+		})
+
+		It("keeps files that are not in the manifest", func() {
+			write("custom.go", "package generated\n")
+			generate("wrappers", "a_generated.go")
+			generate("wrappers")
+			Expect(generated()).To(ConsistOf("custom.go"))
+		})
+
+		It("keeps the files of another generator writing to the directory", func() {
+			generate("elements", "elements_generated.go")
+			generate("wrappers", "a_generated.go")
+			generate("wrappers")
+			Expect(generated()).To(ConsistOf("elements_generated.go"))
+		})
+
+		It("doesn't delete files outside the directory listed in the manifest", func() {
+			outside := filepath.Join(filepath.Dir(dir), filepath.Base(dir)+"-outside.go")
+			Expect(os.WriteFile(outside, []byte("package outside\n"), 0666)).To(Succeed())
+			DeferCleanup(os.Remove, outside)
+			write(".wrappers.generated", "../"+filepath.Base(outside)+"\n")
+			generate("wrappers")
+			Expect(outside).To(BeAnExistingFile())
+		})
+	})
+
+	Describe("Check", func() {
+		check := func(files map[string]string) *output.Check {
+			c := output.NewCheck(dir, "wrappers")
+			for name, content := range files {
+				Expect(c.WriteFile(name, []byte(content))).To(Succeed())
+			}
+			Expect(c.Close()).To(Succeed())
+			return c
+		}
+
+		BeforeEach(func() {
+			generate("wrappers", "a_generated.go", "b_generated.go")
+		})
+
+		It("reports nothing when the files are up to date", func() {
+			c := check(map[string]string{
+				"a_generated.go": "package generated\n",
+				"b_generated.go": "package generated\n",
+			})
+			Expect(c.Stale()).To(BeEmpty())
+			Expect(c.Err()).ToNot(HaveOccurred())
+		})
+
+		It("reports a changed file with a unified diff", func() {
+			write("a_generated.go", "package generated\n\nvar edited = true\n")
+			c := check(map[string]string{
+				"a_generated.go": "package generated\n",
+				"b_generated.go": "package generated\n",
+			})
+			Expect(c.Stale()).To(Equal([]string{"a_generated.go"}))
+			Expect(c.Diff()).To(Equal(`--- a/a_generated.go
++++ b/a_generated.go
+@@ -1,3 +1 @@
+ package generated
+-
+-var edited = true
+`))
+			Expect(c.Err()).To(MatchError(ContainSubstring("a_generated.go")))
+		})
+
+		It("reports missing and orphaned files", func() {
+			c := check(map[string]string{
+				"a_generated.go": "package generated\n",
+				"c_generated.go": "package generated\n",
+			})
+			Expect(c.Stale()).To(Equal([]string{
+				".wrappers.generated", "b_generated.go", "c_generated.go",
+			}))
+			Expect(c.Diff()).To(ContainSubstring("--- a/b_generated.go\n+++ /dev/null\n"))
+			Expect(c.Diff()).To(ContainSubstring("--- /dev/null\n+++ b/c_generated.go\n"))
+		})
+
+		It("doesn't write or delete files", func() {
+			check(map[string]string{"c_generated.go": "package generated\n"})
+			Expect(generated()).To(ConsistOf("a_generated.go", "b_generated.go"))
+		})
+	})
+})
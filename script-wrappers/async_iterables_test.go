@@ -0,0 +1,35 @@
+package wrappers_test
+
+import (
+	"github.com/gost-dom/code-gen/output"
+	wrappers "github.com/gost-dom/code-gen/script-wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// The IDL data doesn't tell if an iterable without arguments is async, e.g.,
+// NodeList's `iterable<Node>`; the wrapper spec must tell.
+var _ = Describe("Async iterables", func() {
+	generateNodeList := func(asyncIterable bool) string {
+		gen := wrappers.NewScriptWrapperModulesGenerator()
+		gen.Specs = wrappers.NewWrapperGeneratorsSpec()
+		dom := gen.Specs.Module("dom")
+		dom.SetMultipleFiles(true)
+		dom.Type("NodeList").AsyncIterable = asyncIterable
+		files := output.Memory{}
+		Expect(gen.GenerateScriptWrappers(files)).To(Succeed())
+		return string(files["node_list_generated.go"])
+	}
+
+	It("installs values as Symbol.asyncIterator when the spec sets AsyncIterable", func() {
+		nodeList := generateNodeList(true)
+		Expect(nodeList).To(ContainSubstring(
+			`prototypeTmpl.SetSymbol(v8.SymbolAsyncIterator(iso), v8.NewFunctionTemplateWithError(iso, wrapper.values))`,
+		))
+		Expect(nodeList).To(ContainSubstring(`return toAsyncIterator(l.toNode)(ctx, result)`))
+	})
+
+	It("doesn't treat an iterable without arguments as async by default", func() {
+		Expect(generateNodeList(false)).ToNot(ContainSubstring("toAsyncIterator"))
+	})
+})
@@ -0,0 +1,33 @@
+package wrappers
+
+import (
+	"github.com/dave/jennifer/jen"
+)
+
+// createPullQueue generates pullQueue, serializing the pulls from the sequence
+// of an async iterator. The JS thread must not wait for the sequence, so each
+// value is pulled in a new goroutine, but the functions returned from
+// iter.Pull2 must not be called concurrently.
+func createPullQueue() *jen.Statement {
+	q := jen.Id("q")
+	prev := jen.Id("prev")
+	done := jen.Id("done")
+	return jen.Comment("pullQueue runs the functions pulling values from the sequence of an async").
+		Line().Comment("iterator, one at a time, in the order they were added. The queue is only").
+		Line().Comment("used from the JS thread.").
+		Line().Type().Id("pullQueue").Struct(done.Clone().Chan().Struct()).
+		Line().Line().
+		Comment("pull runs f in a new goroutine when the previous function has returned.").
+		Line().Func().Params(q.Clone().Op("*").Id("pullQueue")).Id("pull").Params(
+		jen.Id("f").Func().Params(),
+	).Block(
+		prev.Clone().Op(":=").Add(q.Clone()).Dot("done"),
+		done.Clone().Op(":=").Make(jen.Chan().Struct()),
+		q.Clone().Dot("done").Op("=").Add(done.Clone()),
+		jen.Go().Func().Params().Block(
+			jen.Defer().Close(done.Clone()),
+			jen.If(prev.Clone().Op("!=").Nil()).Block(jen.Op("<-").Add(prev.Clone())),
+			jen.Id("f").Call(),
+		).Call(),
+	)
+}
@@ -0,0 +1,169 @@
+package wrappers
+
+import (
+	"fmt"
+
+	g "github.com/gost-dom/generators"
+)
+
+// BufferSourceKind is one of the kinds of JS values that can hold binary data.
+// Each engine generates a check for each kind.
+type BufferSourceKind string
+
+const (
+	KindArrayBuffer       BufferSourceKind = "ArrayBuffer"
+	KindSharedArrayBuffer BufferSourceKind = "SharedArrayBuffer"
+	KindArrayBufferView   BufferSourceKind = "ArrayBufferView"
+	KindUint8Array        BufferSourceKind = "Uint8Array"
+)
+
+// BufferSourceType describes an IDL buffer type, e.g., BufferSource, that is
+// decoded to a Go []byte. The bytes are copied, so the Go code can't observe
+// later changes made from JavaScript.
+//
+// See also: https://webidl.spec.whatwg.org/#idl-buffer-source-types
+type BufferSourceType struct {
+	// Name is the IDL name of the type, or typedef.
+	Name string
+	// Accepts are the kinds of values accepted, not including shared buffers.
+	Accepts []BufferSourceKind
+	// AlwaysShared is set for typedefs accepting shared buffers without the
+	// [AllowShared] extended attribute, i.e., AllowSharedBufferSource.
+	AlwaysShared bool
+	// Encodable tells that a Go []byte can be returned as this type.
+	Encodable bool
+}
+
+var bufferSourceTypes = []BufferSourceType{
+	{
+		Name:      "ArrayBuffer",
+		Accepts:   []BufferSourceKind{KindArrayBuffer},
+		Encodable: true,
+	},
+	{
+		Name:    "ArrayBufferView",
+		Accepts: []BufferSourceKind{KindArrayBufferView},
+	},
+	{
+		Name:    "BufferSource",
+		Accepts: []BufferSourceKind{KindArrayBuffer, KindArrayBufferView},
+	},
+	{
+		Name:         "AllowSharedBufferSource",
+		Accepts:      []BufferSourceKind{KindArrayBuffer, KindArrayBufferView},
+		AlwaysShared: true,
+	},
+	{
+		Name:      "Uint8Array",
+		Accepts:   []BufferSourceKind{KindUint8Array},
+		Encodable: true,
+	},
+}
+
+// FindBufferSourceType returns the buffer type with the IDL name.
+func FindBufferSourceType(name string) (BufferSourceType, bool) {
+	for _, t := range bufferSourceTypes {
+		if t.Name == name {
+			return t, true
+		}
+	}
+	return BufferSourceType{}, false
+}
+
+// DecoderName returns the name of the generated function decoding a JS value
+// to the type, e.g., "decodeIDLBufferSourceAllowShared".
+func (t BufferSourceType) DecoderName(allowShared bool) string {
+	if allowShared && !t.AlwaysShared {
+		return fmt.Sprintf("decodeIDL%sAllowShared", t.Name)
+	}
+	return fmt.Sprintf("decodeIDL%s", t.Name)
+}
+
+// EncoderName returns the name of the generated function encoding a Go []byte
+// to the type, e.g., "toIDLUint8Array".
+func (t BufferSourceType) EncoderName() string {
+	return fmt.Sprintf("toIDL%s", t.Name)
+}
+
+// BufferSourceDecoder describes one of the generated decoder functions for a
+// buffer type.
+type BufferSourceDecoder struct {
+	Type        BufferSourceType
+	AllowShared bool
+}
+
+func (d BufferSourceDecoder) Name() string { return d.Type.DecoderName(d.AllowShared) }
+
+// Accepts returns the kinds of values accepted by the decoder.
+func (d BufferSourceDecoder) Accepts() []BufferSourceKind {
+	result := d.Type.Accepts
+	if d.AllowShared && result[0] == KindArrayBuffer {
+		result = append([]BufferSourceKind{KindArrayBuffer, KindSharedArrayBuffer}, result[1:]...)
+	}
+	return result
+}
+
+// BufferSourceDecoders returns all the decoders that are generated for each
+// engine; with and without [AllowShared].
+func BufferSourceDecoders() []BufferSourceDecoder {
+	var result []BufferSourceDecoder
+	for _, t := range bufferSourceTypes {
+		if t.AlwaysShared {
+			result = append(result, BufferSourceDecoder{t, true})
+		} else {
+			result = append(result,
+				BufferSourceDecoder{t, false},
+				BufferSourceDecoder{t, true},
+			)
+		}
+	}
+	return result
+}
+
+// EncodableBufferSourceTypes returns the buffer types that Go []byte values
+// can be returned as.
+func EncodableBufferSourceTypes() []BufferSourceType {
+	var result []BufferSourceType
+	for _, t := range bufferSourceTypes {
+		if t.Encodable {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// bufferSourceDecoder returns the generated decoder for a buffer type, or nil
+// if typeName isn't a buffer type. The hostArgs are passed to engines whose
+// decoders need access to the runtime.
+func bufferSourceDecoder(
+	typeName string,
+	allowShared bool,
+	hostArgs ...g.Generator,
+) g.Generator {
+	t, ok := FindBufferSourceType(typeName)
+	if !ok {
+		return nil
+	}
+	return hostFunction(t.DecoderName(allowShared), hostArgs)
+}
+
+// bufferSourceEncoder returns the generated encoder for a buffer type, or nil
+// if typeName isn't a buffer type that can be returned to JavaScript.
+func bufferSourceEncoder(typeName string, hostArgs ...g.Generator) g.Generator {
+	t, ok := FindBufferSourceType(typeName)
+	if !ok || !t.Encodable {
+		return nil
+	}
+	return hostFunction(t.EncoderName(), hostArgs)
+}
+
+// hostFunction returns an expression for one of the generated conversion
+// functions. When the engine needs access to the runtime, the function is
+// called with the hostArgs to create the actual conversion function.
+func hostFunction(name string, hostArgs []g.Generator) g.Generator {
+	f := g.NewValue(name)
+	if len(hostArgs) == 0 {
+		return f
+	}
+	return f.Call(hostArgs...)
+}
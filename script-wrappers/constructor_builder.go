@@ -1,6 +1,8 @@
 package wrappers
 
 import (
+	"fmt"
+
 	g "github.com/gost-dom/generators"
 )
 
@@ -23,6 +25,34 @@ func NewConstructorBuilder() ConstructorBuilder {
 	}
 }
 
+// MixinWrapper returns the variable holding the wrapper for a mixin, e.g.,
+// parentNodeWrapper.
+func (builder ConstructorBuilder) MixinWrapper(mixin string) WrapperInstance {
+	return WrapperInstance{g.NewValue(lowerCaseFirstLetter(mixin) + "Wrapper")}
+}
+
+// WrapperFor returns the wrapper implementing the wrapper function of the
+// operation; the wrapper of a mixin for merged mixin members.
+func (builder ConstructorBuilder) WrapperFor(op ESOperation) WrapperInstance {
+	if op.Mixin != "" {
+		return builder.MixinWrapper(op.Mixin)
+	}
+	return builder.Wrapper
+}
+
+// CreateMixinWrappers creates the wrappers for the mixins with members
+// installed on the prototype.
+func (builder ConstructorBuilder) CreateMixinWrappers(data ESConstructorData) g.Generator {
+	result := g.StatementList()
+	for _, mixin := range data.Mixins {
+		result.Append(g.Assign(
+			builder.MixinWrapper(mixin),
+			g.NewValue(fmt.Sprintf("new%sV8Wrapper", mixin)).Call(scriptHost, g.Lit(data.Name())),
+		))
+	}
+	return result
+}
+
 func (builder ConstructorBuilder) NewFunctionTemplateOfWrappedMethod(name string) g.Generator {
 	return builder.NewFunctionTemplate(builder.Wrapper.Method(name))
 }
@@ -36,7 +66,7 @@ func (builder ConstructorBuilder) InstallFunctionHandlers(
 			generators = append(generators,
 				builder.Proto.Set(
 					op.Name,
-					builder.NewFunctionTemplate(builder.Wrapper.Field(op.WrapperMethodName())),
+					builder.NewFunctionTemplate(builder.WrapperFor(op).Field(op.WrapperMethodName())),
 				),
 			)
 		}
@@ -44,6 +74,33 @@ func (builder ConstructorBuilder) InstallFunctionHandlers(
 	return g.StatementList(generators...)
 }
 
+// InstallStringifier installs the "toString" function on the prototype when
+// the IDL interface has a stringifier, calling the wrapper function of the
+// stringifier attribute or operation.
+func (builder ConstructorBuilder) InstallStringifier(data ESConstructorData) g.Generator {
+	if data.Stringifier == nil {
+		return g.Noop
+	}
+	return builder.Proto.Set(
+		"toString",
+		builder.NewFunctionTemplate(
+			builder.WrapperFor(*data.Stringifier).Field(data.Stringifier.WrapperMethodName()),
+		),
+	)
+}
+
+// InstallAsyncIterator installs the "values" function as Symbol.asyncIterator
+// on the prototype when the IDL interface declares an async iterable.
+func (builder ConstructorBuilder) InstallAsyncIterator(data ESConstructorData) g.Generator {
+	if data.AsyncIterator == nil {
+		return g.Noop
+	}
+	return builder.Proto.SetSymbol(
+		g.NewValuePackage("SymbolAsyncIterator", v8).Call(builder.v8Iso),
+		builder.NewFunctionTemplate(builder.Wrapper.Field(data.AsyncIterator.WrapperMethodName())),
+	)
+}
+
 func (builder ConstructorBuilder) InstallAttributeHandlers(
 	data ESConstructorData,
 ) g.Generator {
@@ -62,16 +119,19 @@ func (builder ConstructorBuilder) InstallAttributeHandlers(
 func (builder ConstructorBuilder) InstallAttributeHandler(
 	op ESAttribute,
 ) g.Generator {
-	wrapper := builder.Wrapper
 	getter := op.Getter
 	setter := op.Setter
 	if getter == nil {
 		return g.Noop
 	}
-	getterFt := builder.NewFunctionTemplate(wrapper.Field(getter.WrapperMethodName()))
+	getterFt := builder.NewFunctionTemplate(
+		builder.WrapperFor(*getter).Field(getter.WrapperMethodName()),
+	)
 	setterFt := g.Nil
 	if setter != nil {
-		setterFt = builder.NewFunctionTemplate(wrapper.Field(setter.WrapperMethodName()))
+		setterFt = builder.NewFunctionTemplate(
+			builder.WrapperFor(*setter).Field(setter.WrapperMethodName()),
+		)
 	}
 	return builder.Proto.SetAccessorProperty(
 		op.Name,
@@ -0,0 +1,369 @@
+package wrappers
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/gost-dom/code-gen/output"
+	g "github.com/gost-dom/generators"
+)
+
+// ConverterKind is the kind of a converter called by the generated wrappers.
+type ConverterKind string
+
+const (
+	// ConverterDecoder converts a JS value to Go, e.g., decodeNode.
+	ConverterDecoder ConverterKind = "decode"
+	// ConverterEncoder converts a Go value to JS, e.g., toNullableDOMString.
+	ConverterEncoder ConverterKind = "to"
+	// ConverterDefault returns the default value of an optional argument, e.g.,
+	// defaultEventInit.
+	ConverterDefault ConverterKind = "default"
+)
+
+// matchConverterName matches the kind of a converter, and the name of the IDL
+// type, e.g., decodeNode, toNullableDOMString, and decodeboolean.
+var matchConverterName = regexp.MustCompile(`^(decode|to|default)(.*)$`)
+
+// lowerCaseConverterTypes are the IDL types in lower case that converters are
+// named by, e.g., decodeboolean in goja.
+var lowerCaseConverterTypes = []string{"any", "bigint", "boolean", "object", "symbol", "undefined"}
+
+// ConverterKindOf returns the kind of converter by the naming convention, and
+// false if the name isn't the name of a converter. A name with the prefix of a
+// kind, but an empty or malformed type name, e.g., "to", has the kind, see
+// [MalformedConverterName].
+func ConverterKindOf(name string) (ConverterKind, bool) {
+	m := matchConverterName.FindStringSubmatch(name)
+	if m == nil {
+		return "", false
+	}
+	if typeName := m[2]; typeName != "" && unicode.IsLower(rune(typeName[0])) &&
+		!slices.Contains(lowerCaseConverterTypes, typeName) {
+		// Not a converter, e.g., "total"
+		return "", false
+	}
+	return ConverterKind(m[1]), true
+}
+
+// MalformedConverterName returns whether the name is the name of a converter
+// without a type name, or with a type name that isn't a Go identifier
+// starting with a letter, e.g., "to". The generator creates these from missing
+// type names, e.g., of unions.
+func MalformedConverterName(name string) bool {
+	if _, ok := ConverterKindOf(name); !ok {
+		return false
+	}
+	typeName := matchConverterName.FindStringSubmatch(name)[2]
+	return typeName == "" || !unicode.IsLetter(rune(typeName[0]))
+}
+
+// WrapperConverters are the converters a wrapper type calls on the receiver of
+// the wrapper methods, and the package-level converters the wrapper methods
+// call, e.g., decodeNullable. These are written by hand in the script host,
+// typically on a type embedded in the wrapper.
+type WrapperConverters struct {
+	Wrapper    string   `json:"wrapper"`
+	Receiver   string   `json:"receiver"`
+	Converters []string `json:"converters"`
+	Functions  []string `json:"functions,omitempty"`
+	// Malformed are the called converters with an empty, or malformed, type
+	// name, e.g., "to". The generated code doesn't compile.
+	Malformed []string `json:"malformed,omitempty"`
+	// Missing are the converters and functions not declared in the script
+	// host package, set by [ConverterManifest.FindMissing].
+	Missing []string `json:"missing,omitempty"`
+}
+
+// ConverterManifest lists the converters called by the generated wrappers, by
+// wrapper type, sorted by name. Wrappers call the decoders, encoders, and
+// default values by naming convention, e.g., decodeNode, toNullableDOMString,
+// and defaultEventInit. A converter missing in the script host only shows as a
+// compile error in the host, and the manifest lists what the host must
+// implement.
+type ConverterManifest []WrapperConverters
+
+// CollectConverters generates the wrappers in memory, and returns the
+// converters the generated code calls.
+func (gen ScriptWrapperModulesGenerator) CollectConverters() (ConverterManifest, error) {
+	files := output.Memory{}
+	if err := gen.GenerateScriptWrappers(files); err != nil {
+		return nil, err
+	}
+	return CollectConverters(files)
+}
+
+// CollectConverters returns the converters called on the receiver of the
+// methods in the generated Go files, and the package-level converters called,
+// by name. Methods and functions declared by the generated code itself aren't
+// converters, even if the name matches the naming convention, e.g.,
+// toSequence.
+func CollectConverters(files map[string][]byte) (ConverterManifest, error) {
+	fset := token.NewFileSet()
+	var parsed []*ast.File
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		if filepath.Ext(name) != ".go" {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, files[name], parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, f)
+	}
+	declared := make(map[string]bool)
+	for _, f := range parsed {
+		for _, decl := range methodDecls(f) {
+			declared[methodKey(receiverTypeName(decl), decl.Name.Name)] = true
+		}
+		for _, decl := range funcDecls(f) {
+			declared[decl.Name.Name] = true
+		}
+	}
+	byWrapper := make(map[string]*WrapperConverters)
+	// add adds the converter called by the wrapper to the list of function,
+	// or method, converters, or of malformed names.
+	add := func(wrapper, receiver, name string, function bool) {
+		w, ok := byWrapper[wrapper]
+		if !ok {
+			w = &WrapperConverters{Wrapper: wrapper, Receiver: receiver}
+			byWrapper[wrapper] = w
+		}
+		list := &w.Converters
+		if MalformedConverterName(name) {
+			list = &w.Malformed
+		} else if function {
+			list = &w.Functions
+		}
+		if !slices.Contains(*list, name) {
+			*list = append(*list, name)
+		}
+	}
+	for _, f := range parsed {
+		for _, decl := range methodDecls(f) {
+			names := decl.Recv.List[0].Names
+			if len(names) == 0 || decl.Body == nil {
+				continue
+			}
+			receiver := names[0].Name
+			wrapper := receiverTypeName(decl)
+			ast.Inspect(decl.Body, func(n ast.Node) bool {
+				switch n := n.(type) {
+				case *ast.SelectorExpr:
+					x, ok := n.X.(*ast.Ident)
+					if !ok || x.Name != receiver {
+						return true
+					}
+					name := n.Sel.Name
+					if _, ok := ConverterKindOf(name); ok && !declared[methodKey(wrapper, name)] {
+						add(wrapper, receiver, name, false)
+					}
+				case *ast.CallExpr:
+					fun, ok := n.Fun.(*ast.Ident)
+					if !ok {
+						return true
+					}
+					if _, ok := ConverterKindOf(fun.Name); ok && !declared[fun.Name] {
+						add(wrapper, receiver, fun.Name, true)
+					}
+				}
+				return true
+			})
+		}
+	}
+	result := make(ConverterManifest, 0, len(byWrapper))
+	for _, wrapper := range slices.Sorted(maps.Keys(byWrapper)) {
+		w := *byWrapper[wrapper]
+		slices.Sort(w.Converters)
+		slices.Sort(w.Functions)
+		slices.Sort(w.Malformed)
+		result = append(result, w)
+	}
+	return result, nil
+}
+
+func methodKey(typeName, method string) string { return typeName + "." + method }
+
+// methodDecls returns the declarations of methods in the file.
+func methodDecls(f *ast.File) []*ast.FuncDecl {
+	var result []*ast.FuncDecl
+	for _, decl := range f.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv != nil && len(fn.Recv.List) == 1 {
+			result = append(result, fn)
+		}
+	}
+	return result
+}
+
+// funcDecls returns the declarations of package-level functions in the file.
+func funcDecls(f *ast.File) []*ast.FuncDecl {
+	var result []*ast.FuncDecl
+	for _, decl := range f.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil {
+			result = append(result, fn)
+		}
+	}
+	return result
+}
+
+// receiverTypeName returns the name of the receiver type of a method, without
+// pointer and type arguments.
+func receiverTypeName(decl *ast.FuncDecl) string {
+	expr := decl.Recv.List[0].Type
+	for {
+		switch e := expr.(type) {
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.IndexExpr:
+			expr = e.X
+		case *ast.IndexListExpr:
+			expr = e.X
+		case *ast.Ident:
+			return e.Name
+		default:
+			return ""
+		}
+	}
+}
+
+// Declarations are the names of the methods, and package-level functions,
+// declared in a Go package.
+type Declarations struct {
+	Methods   map[string]bool
+	Functions map[string]bool
+}
+
+// ReadDeclarations returns the names of the methods and functions declared in
+// the Go package in dir, e.g., the script host the wrappers are generated
+// into. Test files are ignored.
+func ReadDeclarations(dir string) (Declarations, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return Declarations{}, err
+	}
+	fset := token.NewFileSet()
+	result := Declarations{Methods: make(map[string]bool), Functions: make(map[string]bool)}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || filepath.Ext(name) != ".go" || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return Declarations{}, err
+		}
+		for _, decl := range methodDecls(f) {
+			result.Methods[decl.Name.Name] = true
+		}
+		for _, decl := range funcDecls(f) {
+			result.Functions[decl.Name.Name] = true
+		}
+	}
+	return result, nil
+}
+
+// FindMissing sets the converters of each wrapper that are not in the
+// declared methods, and the functions that are not in the declared functions,
+// e.g., returned by [ReadDeclarations]. The check of methods is by name only;
+// a converter declared on any type in the package is assumed to be available
+// to the wrapper, e.g., through an embedded type.
+func (m ConverterManifest) FindMissing(declared Declarations) {
+	for i, w := range m {
+		m[i].Missing = nil
+		for _, name := range w.Converters {
+			if !declared.Methods[name] {
+				m[i].Missing = append(m[i].Missing, name)
+			}
+		}
+		for _, name := range w.Functions {
+			if !declared.Functions[name] {
+				m[i].Missing = append(m[i].Missing, name)
+			}
+		}
+	}
+}
+
+// WriteText writes the manifest with a line per wrapper type, followed by an
+// indented line per converter, and function, with parentheses. Missing, and
+// malformed, converters are marked.
+func (m ConverterManifest) WriteText(w io.Writer) error {
+	var buf bytes.Buffer
+	for _, wrapper := range m {
+		fmt.Fprintln(&buf, wrapper.Wrapper)
+		write := func(name, display string) {
+			if slices.Contains(wrapper.Missing, name) {
+				fmt.Fprintf(&buf, "\t%s (missing)\n", display)
+			} else {
+				fmt.Fprintf(&buf, "\t%s\n", display)
+			}
+		}
+		for _, name := range wrapper.Converters {
+			write(name, name)
+		}
+		for _, name := range wrapper.Functions {
+			write(name, name+"()")
+		}
+		for _, name := range wrapper.Malformed {
+			fmt.Fprintf(&buf, "\t%s (malformed)\n", name)
+		}
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// WriteConverterStubs writes a Go file with stubs of the missing converters of
+// the manifest, panicking when called. Missing functions aren't stubbed, as
+// their signatures depend on the function. The stubs are declared on the wrapper
+// types, using the signatures of the target, but with Go types of the values
+// as any. The file is a starting point for implementing the converters by
+// hand, and isn't regenerated.
+func (gen ScriptWrapperModulesGenerator) WriteConverterStubs(
+	w io.Writer,
+	m ConverterManifest,
+) error {
+	return writeGenerator(
+		w,
+		gen.PackagePath,
+		"Stubs of missing converters; implement these with the Go types of the values.",
+		gen.TargetGenerators.CreateConverterStubs(m),
+	)
+}
+
+// ConverterSignature is the parameters and results of a converter of a kind.
+type ConverterSignature func(kind ConverterKind) (params []jen.Code, results []jen.Code)
+
+// createConverterStubs creates the stubs of the missing converters in the
+// manifest, with the signatures of an engine.
+func createConverterStubs(m ConverterManifest, signature ConverterSignature) g.Generator {
+	list := g.StatementList()
+	for _, wrapper := range m {
+		for _, name := range wrapper.Missing {
+			if !slices.Contains(wrapper.Converters, name) {
+				continue
+			}
+			kind, _ := ConverterKindOf(name)
+			params, results := signature(kind)
+			list.Append(g.Line, g.Raw(
+				jen.Func().
+					Params(jen.Id(wrapper.Receiver).Id(wrapper.Wrapper)).
+					Id(name).
+					Params(params...).
+					Params(results...).
+					Block(jen.Panic(jen.Lit("TODO"))),
+			))
+		}
+	}
+	return list
+}
@@ -0,0 +1,54 @@
+package wrappers_test
+
+import (
+	wrappers "github.com/gost-dom/code-gen/script-wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Converter manifest", func() {
+	generated := map[string][]byte{"node_generated.go": []byte(`package host
+
+func (w nodeWrapper) parentNode(v any) any {
+	node := w.decodeNode(v)
+	nodes := decodeSequence(w.decodeNode)(v)
+	total := toTotal(w.toNode(node))
+	return decodeNullable(v, w.decodeboolean, w.to, nodes, total)
+}
+
+func (w nodeWrapper) decodeGenerated(v any) any { return v }
+
+func decodeSequence(decode func(any) any) func(any) []any { return nil }
+`)}
+
+	It("lists the converters, and package-level converters, the wrappers call", func() {
+		manifest, err := wrappers.CollectConverters(generated)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manifest).To(Equal(wrappers.ConverterManifest{{
+			Wrapper:    "nodeWrapper",
+			Receiver:   "w",
+			Converters: []string{"decodeNode", "decodeboolean", "toNode"},
+			Functions:  []string{"decodeNullable", "toTotal"},
+			Malformed:  []string{"to"},
+		}}))
+	})
+
+	It("reports the converters and functions not declared in the script host", func() {
+		manifest, err := wrappers.CollectConverters(generated)
+		Expect(err).ToNot(HaveOccurred())
+		manifest.FindMissing(wrappers.Declarations{
+			Methods:   map[string]bool{"decodeNode": true, "toNode": true, "decodeNullable": true},
+			Functions: map[string]bool{"toTotal": true},
+		})
+		Expect(manifest[0].Missing).To(Equal([]string{"decodeboolean", "decodeNullable"}))
+	})
+
+	It("doesn't take a name starting with a prefix for a converter", func() {
+		_, ok := wrappers.ConverterKindOf("total")
+		Expect(ok).To(BeFalse())
+		Expect(wrappers.MalformedConverterName("total")).To(BeFalse())
+		Expect(wrappers.MalformedConverterName("decode")).To(BeTrue())
+		Expect(wrappers.MalformedConverterName("to_Node")).To(BeTrue())
+		Expect(wrappers.MalformedConverterName("toNullable")).To(BeFalse())
+	})
+})
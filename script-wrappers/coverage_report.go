@@ -0,0 +1,266 @@
+package wrappers
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/gost-dom/webref/idl"
+)
+
+// MemberStatus describes how a member of an IDL interface is exposed to
+// JavaScript by the generated wrappers.
+type MemberStatus string
+
+const (
+	// StatusGenerated is a member with a generated wrapper function.
+	StatusGenerated MemberStatus = "generated"
+	// StatusCustom is a member with a wrapper function implemented by hand.
+	StatusCustom MemberStatus = "custom"
+	// StatusNotImplemented is a member installed on the prototype, throwing
+	// an error when called.
+	StatusNotImplemented MemberStatus = "not-implemented"
+	// StatusIgnored is a member that isn't installed on the prototype.
+	StatusIgnored MemberStatus = "ignored"
+)
+
+var memberStatuses = []MemberStatus{
+	StatusGenerated,
+	StatusCustom,
+	StatusNotImplemented,
+	StatusIgnored,
+}
+
+// CoverageTarget is a script engine, and the specs of the wrappers generated
+// for it.
+type CoverageTarget struct {
+	Name  string
+	Specs WrapperGeneratorsSpec
+}
+
+// CoverageReport describes which members of the wrapped IDL interfaces are
+// exposed to JavaScript, per interface, per spec, and per engine.
+type CoverageReport struct {
+	Engines    []string            `json:"engines"`
+	Specs      []SpecCoverage      `json:"specs"`
+	Interfaces []InterfaceCoverage `json:"interfaces"`
+}
+
+// SpecCoverage counts the members defined by an IDL spec by status, for each
+// engine.
+type SpecCoverage struct {
+	Name    string                          `json:"name"`
+	Engines map[string]map[MemberStatus]int `json:"engines"`
+}
+
+// InterfaceCoverage is the status of each member of a wrapped interface,
+// including the members of included mixins.
+type InterfaceCoverage struct {
+	Name    string           `json:"name"`
+	Module  string           `json:"module"`
+	Members []MemberCoverage `json:"members"`
+}
+
+// MemberCoverage is the status of an interface member for each engine. The
+// status is missing for engines not wrapping the interface. For attributes,
+// SetterStatus is the status of the setter, when it differs from the getter.
+type MemberCoverage struct {
+	Name         string                  `json:"name"`
+	Kind         string                  `json:"kind"`
+	Spec         string                  `json:"spec"`
+	Mixin        string                  `json:"mixin,omitempty"`
+	Status       map[string]MemberStatus `json:"status"`
+	SetterStatus map[string]MemberStatus `json:"setterStatus,omitempty"`
+}
+
+func operationStatus(op ESOperation) MemberStatus {
+	switch {
+	case op.MethodCustomization.Ignored:
+		return StatusIgnored
+	case op.NotImplemented:
+		return StatusNotImplemented
+	case op.CustomImplementation:
+		return StatusCustom
+	default:
+		return StatusGenerated
+	}
+}
+
+// CreateCoverageReport loads the IDL specs of all modules of the targets, and
+// creates the report from the customizations of the wrapped interfaces.
+func CreateCoverageReport(targets []CoverageTarget) (CoverageReport, error) {
+	report := CoverageReport{}
+	interfaces := make(map[string]*InterfaceCoverage)
+	for _, target := range targets {
+		report.Engines = append(report.Engines, target.Name)
+		modules, err := target.Specs.LoadModules()
+		if err != nil {
+			return report, err
+		}
+		for _, module := range modules {
+			for _, d := range module.Types {
+				if d.Mixin {
+					continue
+				}
+				intf, ok := interfaces[d.Name()]
+				if !ok {
+					intf = &InterfaceCoverage{Name: d.Name(), Module: module.Spec.Name}
+					interfaces[d.Name()] = intf
+				}
+				intf.addMembers(target.Name, module.IDL, d)
+			}
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(interfaces)) {
+		intf := interfaces[name]
+		slices.SortFunc(intf.Members, func(x, y MemberCoverage) int {
+			return cmp.Compare(x.Name, y.Name)
+		})
+		report.Interfaces = append(report.Interfaces, *intf)
+	}
+	report.Specs = countSpecCoverage(report)
+	return report, nil
+}
+
+func (intf *InterfaceCoverage) member(name, kind, spec, mixin string) *MemberCoverage {
+	for i, m := range intf.Members {
+		if m.Name == name {
+			return &intf.Members[i]
+		}
+	}
+	intf.Members = append(intf.Members, MemberCoverage{
+		Name:   name,
+		Kind:   kind,
+		Spec:   spec,
+		Mixin:  mixin,
+		Status: make(map[string]MemberStatus),
+	})
+	return &intf.Members[len(intf.Members)-1]
+}
+
+// addMembers adds the status of the members of the wrapped interface for the
+// engine. Members of the IDL interface, or included mixins, that are not
+// installed on the prototype are added as ignored.
+func (intf *InterfaceCoverage) addMembers(engine string, spec idl.Spec, data ESConstructorData) {
+	if c := data.Constructor; c != nil {
+		intf.member(c.Name, "constructor", c.Spec, "").Status[engine] = operationStatus(*c)
+	}
+	for _, op := range data.Operations {
+		m := intf.member(op.Name, "operation", op.Spec, op.Mixin)
+		if _, ok := m.Status[engine]; !ok {
+			m.Status[engine] = operationStatus(op)
+		}
+	}
+	for _, a := range data.Attributes {
+		m := intf.member(a.Name, "attribute", a.Getter.Spec, a.Getter.Mixin)
+		m.Status[engine] = operationStatus(*a.Getter)
+		if a.Setter != nil {
+			if status := operationStatus(*a.Setter); status != m.Status[engine] {
+				if m.SetterStatus == nil {
+					m.SetterStatus = make(map[string]MemberStatus)
+				}
+				m.SetterStatus[engine] = status
+			}
+		}
+	}
+	idlInterface := spec.Interfaces[data.Spec.TypeName]
+	addIgnored := func(n idl.Name, mixin string) {
+		for _, member := range n.Members {
+			kind := member.Type
+			if member.Name == "" || (kind != "operation" && kind != "attribute") {
+				continue
+			}
+			m := intf.member(member.Name, kind, data.Spec.DomSpec.SpecOf(n.Name, member.Name), mixin)
+			if _, ok := m.Status[engine]; !ok {
+				m.Status[engine] = StatusIgnored
+			}
+		}
+	}
+	addIgnored(idlInterface.InternalSpec, "")
+	for mixin := range IncludedMixins(idlInterface, nil) {
+		addIgnored(mixin.InternalSpec, mixin.Name)
+	}
+}
+
+func countSpecCoverage(report CoverageReport) []SpecCoverage {
+	specs := make(map[string]SpecCoverage)
+	for _, intf := range report.Interfaces {
+		for _, m := range intf.Members {
+			spec, ok := specs[m.Spec]
+			if !ok {
+				spec = SpecCoverage{m.Spec, make(map[string]map[MemberStatus]int)}
+				specs[m.Spec] = spec
+			}
+			for engine, status := range m.Status {
+				if spec.Engines[engine] == nil {
+					spec.Engines[engine] = make(map[MemberStatus]int)
+				}
+				spec.Engines[engine][status]++
+			}
+		}
+	}
+	result := make([]SpecCoverage, 0, len(specs))
+	for _, name := range slices.Sorted(maps.Keys(specs)) {
+		result = append(result, specs[name])
+	}
+	return result
+}
+
+// WriteMarkdown writes the report as Markdown tables; a summary per spec and
+// engine, and the status of each member per interface.
+func (r CoverageReport) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("# API coverage\n\n")
+	b.WriteString("## Specs\n\n")
+	b.WriteString("| Spec | Engine |")
+	for _, s := range memberStatuses {
+		fmt.Fprintf(&b, " %s |", s)
+	}
+	b.WriteString("\n|---|---|" + strings.Repeat("---:|", len(memberStatuses)) + "\n")
+	for _, spec := range r.Specs {
+		for _, engine := range r.Engines {
+			counts, ok := spec.Engines[engine]
+			if !ok {
+				continue
+			}
+			fmt.Fprintf(&b, "| %s | %s |", spec.Name, engine)
+			for _, s := range memberStatuses {
+				fmt.Fprintf(&b, " %d |", counts[s])
+			}
+			b.WriteString("\n")
+		}
+	}
+	for _, intf := range r.Interfaces {
+		fmt.Fprintf(&b, "\n## %s\n\n", intf.Name)
+		fmt.Fprintf(&b, "Module: %s\n\n", intf.Module)
+		b.WriteString("| Member | Kind | Spec |")
+		for _, engine := range r.Engines {
+			fmt.Fprintf(&b, " %s |", engine)
+		}
+		b.WriteString("\n|---|---|---|" + strings.Repeat("---|", len(r.Engines)) + "\n")
+		for _, m := range intf.Members {
+			spec := m.Spec
+			if m.Mixin != "" {
+				spec = fmt.Sprintf("%s (%s)", m.Spec, m.Mixin)
+			}
+			fmt.Fprintf(&b, "| %s | %s | %s |", m.Name, m.Kind, spec)
+			for _, engine := range r.Engines {
+				status, ok := m.Status[engine]
+				switch {
+				case !ok:
+					b.WriteString(" - |")
+				case m.SetterStatus[engine] != "":
+					fmt.Fprintf(&b, " %s (setter: %s) |", status, m.SetterStatus[engine])
+				default:
+					fmt.Fprintf(&b, " %s |", status)
+				}
+			}
+			b.WriteString("\n")
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
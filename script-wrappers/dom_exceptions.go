@@ -0,0 +1,134 @@
+package wrappers
+
+import (
+	"github.com/dave/jennifer/jen"
+	g "github.com/gost-dom/generators"
+)
+
+// ErrorMapping describes how an error returned from the Go implementation is
+// converted to a DOMException in JavaScript.
+//
+// The error is identified either by a sentinel error value, matched using
+// [errors.Is], or by an error type, matched using [errors.As].
+type ErrorMapping struct {
+	// Package is the fully qualified name of the Go package declaring the
+	// error.
+	Package string
+	// Name is the name of the sentinel error, or the error type.
+	Name string
+	// IsType tells that Name is an error type, not a sentinel error value.
+	IsType bool
+	// IsPointer tells that a pointer to the error type, not the type itself,
+	// implements the error interface, e.g., when Error has a pointer receiver.
+	IsPointer bool
+	// ExceptionName is the name of the DOMException, e.g., "NotFoundError".
+	ExceptionName string
+}
+
+// MapSentinelError creates an ErrorMapping converting errors matching the
+// sentinel error value pkg.name to a DOMException with the name exceptionName.
+func MapSentinelError(pkg string, name string, exceptionName string) ErrorMapping {
+	return ErrorMapping{Package: pkg, Name: name, ExceptionName: exceptionName}
+}
+
+// MapErrorType creates an ErrorMapping converting errors of the type pkg.name
+// to a DOMException with the name exceptionName. The type itself must
+// implement the error interface; use [MapPointerErrorType] if only a pointer
+// to it does.
+func MapErrorType(pkg string, name string, exceptionName string) ErrorMapping {
+	return ErrorMapping{Package: pkg, Name: name, IsType: true, ExceptionName: exceptionName}
+}
+
+// MapPointerErrorType creates an ErrorMapping converting errors of the type
+// *pkg.name to a DOMException with the name exceptionName.
+func MapPointerErrorType(pkg string, name string, exceptionName string) ErrorMapping {
+	m := MapErrorType(pkg, name, exceptionName)
+	m.IsPointer = true
+	return m
+}
+
+// Code returns the legacy error code of the DOMException, or 0 if the name
+// doesn't have a legacy code.
+//
+// See also: https://webidl.spec.whatwg.org/#dfn-error-names-table
+func (m ErrorMapping) Code() int {
+	return domExceptionCodes[m.ExceptionName]
+}
+
+var domExceptionCodes = map[string]int{
+	"IndexSizeError":             1,
+	"HierarchyRequestError":      3,
+	"WrongDocumentError":         4,
+	"InvalidCharacterError":      5,
+	"NoModificationAllowedError": 7,
+	"NotFoundError":              8,
+	"NotSupportedError":          9,
+	"InUseAttributeError":        10,
+	"InvalidStateError":          11,
+	"SyntaxError":                12,
+	"InvalidModificationError":   13,
+	"NamespaceError":             14,
+	"InvalidAccessError":         15,
+	"TypeMismatchError":          17,
+	"SecurityError":              18,
+	"NetworkError":               19,
+	"AbortError":                 20,
+	"URLMismatchError":           21,
+	"QuotaExceededError":         22,
+	"TimeoutError":               23,
+	"InvalidNodeTypeError":       24,
+	"DataCloneError":             25,
+}
+
+// DefaultErrorMappings contains the mappings of errors from the dom and html
+// packages that apply to all wrapped methods. Mappings on a method, see
+// [ESMethodWrapper.MapError], take precedence.
+var DefaultErrorMappings = []ErrorMapping{
+	MapSentinelError(dom, "ErrHierarchyRequest", "HierarchyRequestError"),
+	MapSentinelError(dom, "ErrNotFound", "NotFoundError"),
+	MapErrorType(dom, "SyntaxError", "SyntaxError"),
+	MapSentinelError(html, "ErrInvalidState", "InvalidStateError"),
+}
+
+// ErrorMappingChecks generates a check for each error mapping, testing if the
+// error err matches the mapping. The body of the check is created by the
+// onMatch function, which would normally create and throw the DOMException.
+func ErrorMappingChecks(
+	mappings []ErrorMapping,
+	err g.Generator,
+	onMatch func(ErrorMapping) g.Generator,
+) g.Generator {
+	checks := g.StatementList()
+	for _, m := range mappings {
+		var condition g.Generator
+		if m.IsType {
+			// errors.As panics unless the target is a pointer to a type
+			// implementing error
+			target := jen.Id("target")
+			errorType := jen.Qual(m.Package, m.Name)
+			if m.IsPointer {
+				errorType = jen.Op("*").Add(errorType)
+			}
+			condition = g.Raw(
+				jen.Add(target).Op(":=").New(errorType).Op(";").
+					Qual("errors", "As").Call(err.Generate(), target),
+			)
+		} else {
+			condition = g.Raw(
+				jen.Qual("errors", "Is").Call(err.Generate(), jen.Qual(m.Package, m.Name)),
+			)
+		}
+		checks.Append(g.IfStmt{Condition: condition, Block: onMatch(m)})
+	}
+	return checks
+}
+
+// NewDOMExceptionArgs returns the arguments to pass to the host's
+// newDOMException function, the error message, the name, and the code.
+func NewDOMExceptionArgs(err g.Generator, m ErrorMapping) []g.Generator {
+	return g.List(
+		g.ValueOf(err).Method("Error").Call(),
+		g.Lit(m.ExceptionName),
+		g.Lit(m.Code()),
+	)
+}
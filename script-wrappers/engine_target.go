@@ -0,0 +1,227 @@
+package wrappers
+
+import (
+	"github.com/dave/jennifer/jen"
+	g "github.com/gost-dom/generators"
+)
+
+// EngineTarget is a script engine where the wrapper of a JS function is a Go
+// method receiving the arguments of the call, and returning the result, like
+// goja. [EngineTargetGenerators] generates the wrappers from the engine
+// specific parts:
+//
+//   - [NamingStrategy] names the generated types and functions of a class.
+//   - [ArgumentDecoder] reads the "this" value and arguments of a call.
+//   - [ResultEncoder] converts the return value to a JS value.
+//   - [PrototypeInstaller] creates the prototype, or namespace object, and
+//     registers classes with the script host.
+//   - [ErrorRaiser] throws errors as JS exceptions.
+//
+// The code shared by all wrappers in a package, e.g., numeric decoders, is
+// generated by the [PackageGenerators].
+//
+// [GojaTarget] is the only implementation. The sobek target is the goja target
+// with another engine package, as sobek has the same API as goja, so it doesn't
+// show that the interface fits an engine with a different API. V8 doesn't use
+// it, see [V8TargetGenerators].
+type EngineTarget interface {
+	// Naming returns the naming strategy of the wrapper of the class.
+	Naming(data ESConstructorData) NamingStrategy
+	ArgumentDecoder
+	ResultEncoder
+	PrototypeInstaller
+	ErrorRaiser
+	PackageGenerators
+}
+
+// NamingStrategy names the Go types and functions generated for a class.
+type NamingStrategy interface {
+	// PrototypeWrapperTypeName is the name of the struct implementing the
+	// wrapper methods.
+	PrototypeWrapperTypeName() string
+	// PrototypeWrapperConstructorName is the name of the function creating an
+	// instance of the wrapper struct.
+	PrototypeWrapperConstructorName() string
+	// ReceiverName is the receiver of the wrapper methods.
+	ReceiverName() string
+}
+
+// ArgumentDecoder generates code reading the values passed from JavaScript to a
+// wrapper method.
+type ArgumentDecoder interface {
+	// CallArgument is the argument of the wrapper methods, giving access to
+	// the "this" value, and the arguments of the call.
+	CallArgument() g.FunctionArgument
+	// DecodeThis assigns the Go value wrapped by the "this" object of the call
+	// to instance, raising a TypeError if the value doesn't implement the
+	// interface.
+	DecodeThis(data ESConstructorData, op ESOperation, instance g.Generator) g.Generator
+	// DecodeArgument returns the expression converting the JS argument at
+	// index to the Go value passed to the implementation. For a variadic
+	// argument, the expression returns a slice of the remaining arguments.
+	DecodeArgument(data ESConstructorData, arg ESOperationArgument, index int) g.Generator
+}
+
+// ResultEncoder generates code converting values returned from Go to JS
+// values.
+type ResultEncoder interface {
+	// ResultType is the return type of the wrapper methods.
+	ResultType() g.Generator
+	// EncodeResult returns the expression converting the result of the
+	// operation to a JS value.
+	EncodeResult(data ESConstructorData, op ESOperation, result g.Generator) g.Generator
+	// EncodeNull returns the JS null value, returned when an operation
+	// returns a nil interface value.
+	EncodeNull(data ESConstructorData) g.Generator
+	// EncodeUndefined returns the JS undefined value, returned by operations
+	// without a result.
+	EncodeUndefined(data ESConstructorData) g.Generator
+	// CreateDefaultToJSONBody creates the body of a default toJSON operation,
+	// returning an object with the values of all JSON attributes.
+	//
+	// See also: https://webidl.spec.whatwg.org/#default-tojson-steps
+	CreateDefaultToJSONBody(data ESConstructorData) g.Generator
+}
+
+// PrototypeInstaller generates the wrapper struct of a class, and the code
+// installing the wrapper methods on the JS objects.
+type PrototypeInstaller interface {
+	// CreateWrapperStruct generates the wrapper struct, and the function
+	// creating it.
+	CreateWrapperStruct(data ESConstructorData) g.Generator
+	// CreatePrototypeInitializer generates the method installing the
+	// operations and attributes on the prototype of the class.
+	CreatePrototypeInitializer(data ESConstructorData) g.Generator
+	// CreateNamespaceInitializer generates the wrapper struct of a namespace,
+	// and the code installing the namespace object, e.g., console.
+	CreateNamespaceInitializer(data ESConstructorData) g.Generator
+}
+
+// ErrorRaiser generates the code throwing a JS exception from a wrapper method.
+type ErrorRaiser interface {
+	// RaiseError throws err if it is not nil, converted to a DOMException if
+	// the operation, or the package, has a mapping for the error.
+	RaiseError(data ESConstructorData, op ESOperation, err g.Generator) g.Generator
+	// RaiseNotImplemented creates the body of an operation that is not
+	// implemented, throwing the error returned by notImplemented.
+	RaiseNotImplemented(data ESConstructorData, op ESOperation) g.Generator
+}
+
+// EngineTargetGenerators generates wrappers for an [EngineTarget].
+type EngineTargetGenerators struct {
+	EngineTarget
+}
+
+func (gen EngineTargetGenerators) CreateJSConstructorGenerator(data ESConstructorData) g.Generator {
+	if data.Namespace {
+		return g.StatementList(
+			gen.CreateNamespaceInitializer(data),
+			gen.CreateWrapperMethods(data),
+		)
+	}
+	if data.Mixin {
+		return g.StatementList(
+			gen.CreateWrapperStruct(data),
+			gen.CreateWrapperMethods(data),
+		)
+	}
+	return g.StatementList(
+		gen.CreateWrapperStruct(data),
+		gen.CreatePrototypeInitializer(data),
+		gen.CreateWrapperMethods(data),
+	)
+}
+
+func (gen EngineTargetGenerators) CreateWrapperMethods(data ESConstructorData) g.Generator {
+	list := g.StatementList()
+	for op := range data.WrapperFunctionsToGenerate() {
+		list.Append(gen.CreateWrapperMethod(data, op))
+	}
+	return list
+}
+
+func (gen EngineTargetGenerators) CreateWrapperMethod(
+	data ESConstructorData,
+	op ESOperation,
+) g.Generator {
+	naming := gen.Naming(data)
+	return g.StatementList(
+		g.Line,
+		g.FunctionDefinition{
+			Receiver: g.FunctionArgument{
+				Name: g.Id(naming.ReceiverName()),
+				Type: g.Id(naming.PrototypeWrapperTypeName()),
+			},
+			Name:     op.WrapperMethodName(),
+			Args:     g.FunctionArgumentList{gen.CallArgument()},
+			RtnTypes: g.List(gen.ResultType()),
+			Body:     gen.CreateWrapperMethodBody(data, op),
+		})
+}
+
+// CreateWrapperMethodBody creates the body of a wrapper method, reading the
+// arguments, calling the Go implementation, and converting the result.
+// Namespace operations call package-level functions in the Go package
+// implementing the namespace.
+func (gen EngineTargetGenerators) CreateWrapperMethodBody(
+	data ESConstructorData,
+	op ESOperation,
+) g.Generator {
+	if op.NotImplemented {
+		return gen.RaiseNotImplemented(data, op)
+	}
+	if op.DefaultToJSON {
+		return gen.CreateDefaultToJSONBody(data)
+	}
+	instance := g.NewValue("instance")
+	getInstance := gen.DecodeThis(data, op, instance)
+	callee := instance.Field(upperCaseFirstLetter(op.Name))
+	if data.Namespace {
+		callee = g.NewValuePackage(upperCaseFirstLetter(op.Name), data.GetInternalPackage())
+		getInstance = g.Noop
+	}
+	readArgs := g.StatementList()
+	argNames := make([]g.Generator, len(op.Arguments))
+	for i, a := range op.Arguments {
+		name := sanitizeVarName(a.Name)
+		argNames[i] = g.Id(name)
+		readArgs.Append(g.Assign(argNames[i], gen.DecodeArgument(data, a, i)))
+		if a.Variadic {
+			argNames[i] = g.Raw(jen.Id(name).Op("..."))
+		}
+	}
+	list := g.StatementList(
+		getInstance,
+		readArgs,
+	)
+	err := g.Id("err")
+	if !op.HasResult() {
+		if op.GetHasError() {
+			list.Append(
+				g.Assign(err, callee.Call(argNames...)),
+				gen.RaiseError(data, op, err),
+			)
+		} else {
+			list.Append(callee.Call(argNames...))
+		}
+		list.Append(g.Return(gen.EncodeUndefined(data)))
+		return list
+	}
+	result := g.Id("result")
+	if op.GetHasError() {
+		list.Append(
+			g.AssignMany(g.List(result, err), callee.Call(argNames...)),
+			gen.RaiseError(data, op, err),
+		)
+	} else {
+		list.Append(g.Assign(result, callee.Call(argNames...)))
+	}
+	if op.ReturnsNullableInterface() {
+		list.Append(g.IfStmt{
+			Condition: g.Eq{Lhs: result, Rhs: g.Nil},
+			Block:     g.Return(gen.EncodeNull(data)),
+		})
+	}
+	list.Append(g.Return(gen.EncodeResult(data, op, result)))
+	return list
+}
@@ -0,0 +1,39 @@
+package wrappers_test
+
+import (
+	"github.com/gost-dom/code-gen/output"
+	wrappers "github.com/gost-dom/code-gen/script-wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Error mappings", func() {
+	generate := func(mappings ...wrappers.ErrorMapping) output.Memory {
+		gen := wrappers.NewScriptWrapperModulesGenerator()
+		gen.ErrorMappings = mappings
+		files := output.Memory{}
+		Expect(gen.GenerateScriptWrappers(files)).To(Succeed())
+		return files
+	}
+
+	// errors.As panics if the target isn't a pointer to a type implementing
+	// error.
+	It("passes a pointer to the type implementing error to errors.As", func() {
+		files := generate(
+			wrappers.MapErrorType("example.com/errs", "ValueError", "SyntaxError"),
+			wrappers.MapPointerErrorType("example.com/errs", "PointerError", "NotFoundError"),
+		)
+		mapper := string(files["dom_exceptions_generated.go"])
+		Expect(mapper).To(ContainSubstring("if target := new(errs.ValueError); errors.As(err, target) {"))
+		Expect(mapper).To(ContainSubstring("if target := new(*errs.PointerError); errors.As(err, target) {"))
+	})
+
+	It("maps the error of a void operation only if it isn't nil", func() {
+		form := string(generate()["html_form_element_generated.go"])
+		Expect(form).To(ContainSubstring(`callErr := instance.RequestSubmit(submitter)
+		if callErr != nil {
+			return nil, mapError(e.scriptHost, callErr)
+		}
+		return nil, nil`))
+	})
+})
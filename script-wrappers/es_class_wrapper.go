@@ -1,5 +1,11 @@
 package wrappers
 
+import (
+	"log/slog"
+	"maps"
+	"slices"
+)
+
 type TypeCustomization []string
 
 type ESMethodArgument struct {
@@ -46,8 +52,15 @@ type ESClassWrapper struct {
 	RunCustomCode             bool
 	WrapperStruct             bool
 	SkipPrototypeRegistration bool
-	IncludeIncludes           bool
-	Customization             map[string]*ESMethodWrapper
+	// ExcludedMixins contains the names of included mixins that should not be
+	// merged into the wrapper, e.g., if the script host installs the members.
+	ExcludedMixins []string
+	// AsyncIterable tells that an `iterable` declaration in the IDL is an
+	// `async iterable`. The IDL data doesn't tell if an iterable is async, so
+	// this must be set for async iterables without arguments, see
+	// [CreateAsyncIterator].
+	AsyncIterable bool
+	Customization map[string]*ESMethodWrapper
 }
 
 func (w *ESClassWrapper) ensureMap() {
@@ -69,6 +82,39 @@ func (w *ESClassWrapper) MarkMembersAsIgnored(names ...string) {
 	}
 }
 
+// ExcludeMixins prevents the members of the mixins from being merged into the
+// wrapper.
+func (w *ESClassWrapper) ExcludeMixins(names ...string) {
+	w.ExcludedMixins = append(w.ExcludedMixins, names...)
+}
+
+// IncludesMixin returns whether members of the mixin should be merged into the
+// wrapper.
+func (w *ESClassWrapper) IncludesMixin(name string) bool {
+	return !slices.Contains(w.ExcludedMixins, name)
+}
+
+// customizedBy returns a copy of the wrapper of a mixin, where the
+// customizations of the including interface override the customizations of
+// the mixin.
+func (w *ESClassWrapper) customizedBy(includer *ESClassWrapper) *ESClassWrapper {
+	result := *w
+	result.Customization = maps.Clone(w.Customization)
+	result.ensureMap()
+	maps.Copy(result.Customization, includer.Customization)
+	return &result
+}
+
+// log returns the logger of the module, adding the name of the interface to
+// the messages.
+func (w *ESClassWrapper) log() *slog.Logger {
+	logger := slog.Default()
+	if w.DomSpec != nil {
+		logger = w.DomSpec.log()
+	}
+	return logger.With("interface", w.TypeName)
+}
+
 func (w *ESClassWrapper) GetMethodCustomization(name string) (result ESMethodWrapper) {
 	if val, ok := w.Customization[name]; ok {
 		result = *val
@@ -102,6 +148,9 @@ type ESMethodWrapper struct {
 	Arguments            map[string]*ESMethodArgument
 	// Name of the method that will convert the result to JS
 	Encoder string
+	// ErrorMappings converts errors returned from the method to DOMExceptions.
+	// These are checked before the global mappings.
+	ErrorMappings []ErrorMapping
 }
 
 func (w *ESMethodWrapper) SetEncoder(e string) *ESMethodWrapper {
@@ -128,6 +177,13 @@ func (w *ESMethodWrapper) SetNoError() *ESMethodWrapper {
 	return w
 }
 
+// MapError adds a mapping from an error returned by the Go method to a
+// DOMException, which takes precedence over the global mappings.
+func (w *ESMethodWrapper) MapError(m ErrorMapping) *ESMethodWrapper {
+	w.ErrorMappings = append(w.ErrorMappings, m)
+	return w
+}
+
 func (w *ESMethodWrapper) SetCustomImplementation() *ESMethodWrapper {
 	w.CustomImplementation = true
 	return w
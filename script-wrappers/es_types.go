@@ -0,0 +1,178 @@
+package wrappers
+
+import (
+	"fmt"
+	"slices"
+
+	g "github.com/gost-dom/generators"
+	"github.com/gost-dom/webref/idl"
+)
+
+// ESType describes a generic IDL type used for arguments and return values,
+// i.e., `sequence<T>`, `FrozenArray<T>`, and `record<K, V>`, and for return
+// values, `Promise<T>`. The type parameters can themselves be generic types.
+//
+// Values of generic types are converted by composing the converters of the
+// type parameters, e.g., a `sequence<DOMString>` argument is decoded by
+//
+//	decodeSequence(w.decodeDOMString)
+//
+// and returned by
+//
+//	toSequence(w.toDOMString)
+//
+// The generic helpers, decodeSequence, decodeRecord, toSequence,
+// toFrozenArray, and toRecord, are generated for each engine, see
+// [PackageGenerators]. So is the helper for async iterables,
+// toAsyncIterator, see [CreateAsyncIterator].
+//
+// A promise is returned from Go as a function computing the result, which the
+// encoder calls in a new goroutine, i.e., `func() (T, error)`, or
+// `func() error` for `Promise<undefined>`. The promise is encoded by
+//
+//	toPromise(w.toDOMString)
+//
+// and settled in the script context when the function returns.
+type ESType struct {
+	// Name is the name of the IDL type, e.g., "DOMString", or the name of the
+	// generic type, e.g., "sequence".
+	Name       string
+	Nullable   bool
+	TypeParams []ESType
+	// IntegerConversion is the conversion mode of an integer type parameter,
+	// e.g., `sequence<[EnforceRange] long>`.
+	IntegerConversion IntegerConversion
+	// AllowShared is set for a buffer type parameter with the [AllowShared]
+	// extended attribute.
+	AllowShared bool
+}
+
+// NewGenericType returns an ESType for the IDL type t, if t is one of the
+// supported generic types. Otherwise nil is returned.
+func NewGenericType(t *idl.IdlType) *ESType {
+	if t == nil {
+		return nil
+	}
+	switch t.Generic {
+	case "sequence", "FrozenArray", "ObservableArray", "record":
+		result := newESType(*t)
+		return &result
+	}
+	return nil
+}
+
+// NewReturnType returns an ESType for the IDL return type t, if t is one of
+// the supported generic types, or a promise. Otherwise nil is returned.
+func NewReturnType(t *idl.IdlType) *ESType {
+	if t != nil && t.Generic == "Promise" {
+		result := newESType(*t)
+		return &result
+	}
+	return NewGenericType(t)
+}
+
+func newESType(t idl.IdlType) ESType {
+	if t.Generic == "" {
+		return ESType{
+			Name:              t.IType.TypeName,
+			Nullable:          t.Nullable,
+			IntegerConversion: NewIntegerConversion(t.ExtAttrs),
+			AllowShared:       hasExtAttr(t.ExtAttrs, "AllowShared"),
+		}
+	}
+	result := ESType{Name: t.Generic, Nullable: t.Nullable}
+	params := t.IType.Types
+	if len(params) == 0 && t.IType.IdlType != nil {
+		params = []idl.IdlType{*t.IType.IdlType}
+	}
+	for _, p := range params {
+		result.TypeParams = append(result.TypeParams, newESType(p))
+	}
+	return result
+}
+
+// HostArgs are the arguments passed to the generated conversion functions of
+// engines where they create the function converting the value, like goja. V8
+// passes none, as its conversion functions receive the script context with
+// the value.
+type HostArgs struct {
+	// Runtime is passed to the functions converting values, e.g.,
+	// decodeSequence.
+	Runtime []g.Generator
+	// Context is passed to the functions settling values later in the script
+	// context, i.e., toPromise, toVoidPromise, and toAsyncIterator.
+	Context []g.Generator
+}
+
+// Decoder returns an expression for the function converting a JS value to Go.
+// The receiver is the wrapper, having the decoders for non-generic types, and
+// host are passed as the first arguments to the generic helpers.
+func (t ESType) Decoder(receiver g.Value, host HostArgs) g.Generator {
+	switch t.Name {
+	case "sequence", "FrozenArray", "ObservableArray":
+		return g.NewValue("decodeSequence").Call(slices.Concat(host.Runtime, g.List(
+			t.TypeParams[0].Decoder(receiver, host),
+		))...)
+	case "record":
+		return g.NewValue("decodeRecord").Call(slices.Concat(host.Runtime, g.List(
+			t.TypeParams[0].Decoder(receiver, host),
+			t.TypeParams[1].Decoder(receiver, host),
+		))...)
+	}
+	if decoder := generatedDecoder(t.Name, t.IntegerConversion, t.AllowShared, host.Runtime...); decoder != nil {
+		return decoder
+	}
+	return receiver.Field(fmt.Sprintf("decode%s", idlNameToGoName(t.Name)))
+}
+
+// Encoder returns an expression for the function converting a Go value to JS.
+// The receiver is the wrapper, having the encoders for non-generic types, and
+// host are passed as the first arguments to the generic helpers.
+//
+// A FrozenArray is converted to a frozen JS array.
+func (t ESType) Encoder(receiver g.Value, host HostArgs) g.Generator {
+	switch t.Name {
+	case "sequence", "ObservableArray":
+		return g.NewValue("toSequence").Call(slices.Concat(host.Runtime, g.List(
+			t.TypeParams[0].Encoder(receiver, host),
+		))...)
+	case "FrozenArray":
+		return g.NewValue("toFrozenArray").Call(slices.Concat(host.Runtime, g.List(
+			t.TypeParams[0].Encoder(receiver, host),
+		))...)
+	case "async iterable":
+		return g.NewValue("toAsyncIterator").Call(slices.Concat(host.Context, g.List(
+			t.TypeParams[0].Encoder(receiver, host),
+		))...)
+	case "Promise":
+		if t.TypeParams[0].Name == "undefined" {
+			return hostFunction("toVoidPromise", host.Context)
+		}
+		return g.NewValue("toPromise").Call(slices.Concat(host.Context, g.List(
+			t.TypeParams[0].Encoder(receiver, host),
+		))...)
+	case "record":
+		return g.NewValue("toRecord").Call(slices.Concat(host.Runtime, g.List(
+			t.TypeParams[1].Encoder(receiver, host),
+		))...)
+	}
+	if encoder := bufferSourceEncoder(t.Name, host.Runtime...); encoder != nil {
+		return encoder
+	}
+	return receiver.Field(fmt.Sprintf("to%s", idlNameToGoName(t.Name)))
+}
+
+// generatedDecoder returns the decoder for types where the conversion is
+// generated for each engine, i.e., numeric and buffer types. Returns nil for
+// other types.
+func generatedDecoder(
+	typeName string,
+	c IntegerConversion,
+	allowShared bool,
+	hostArgs ...g.Generator,
+) g.Generator {
+	if decoder := numericDecoder(typeName, c, hostArgs...); decoder != nil {
+		return decoder
+	}
+	return bufferSourceDecoder(typeName, allowShared, hostArgs...)
+}
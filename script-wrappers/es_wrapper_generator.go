@@ -3,7 +3,8 @@ package wrappers
 import (
 	"fmt"
 	"iter"
-	"log/slog"
+	"maps"
+	"slices"
 	"strings"
 	"unicode"
 
@@ -16,6 +17,7 @@ import (
 var (
 	v8FunctionTemplatePtr     = g.NewTypePackage("FunctionTemplate", v8).Pointer()
 	v8FunctionCallbackInfoPtr = g.NewTypePackage("FunctionCallbackInfo", v8).Pointer()
+	v8ObjectTemplatePtr       = g.NewTypePackage("ObjectTemplate", v8).Pointer()
 	v8Value                   = g.NewTypePackage("Value", v8).Pointer()
 	v8ReadOnly                = g.Raw(jen.Qual(v8, "ReadOnly"))
 	v8None                    = g.Raw(jen.Qual(v8, "None"))
@@ -23,13 +25,16 @@ var (
 )
 
 const (
-	dom      = BASE_PKG + "/dom"
-	html     = BASE_PKG + "/html"
-	v8host   = BASE_PKG + "/scripting/v8host"
-	gojahost = BASE_PKG + "/scripting/gojahost"
-	log      = BASE_PKG + "/internal/log"
-	v8       = "github.com/tommie/v8go"
-	gojaSrc  = "github.com/dop251/goja"
+	dom       = BASE_PKG + "/dom"
+	html      = BASE_PKG + "/html"
+	v8host    = BASE_PKG + "/scripting/v8host"
+	gojahost  = BASE_PKG + "/scripting/gojahost"
+	sobekhost = BASE_PKG + "/scripting/sobekhost"
+	console   = BASE_PKG + "/console"
+	log       = BASE_PKG + "/internal/log"
+	v8        = "github.com/tommie/v8go"
+	gojaSrc   = "github.com/dop251/goja"
+	sobekSrc  = "github.com/grafana/sobek"
 )
 
 func createData(spec idl.Spec, dataData WrapperTypeSpec) ESConstructorData {
@@ -45,6 +50,29 @@ func createData(spec idl.Spec, dataData WrapperTypeSpec) ESConstructorData {
 	if wrapperTypeBaseName == "" {
 		wrapperTypeBaseName = fmt.Sprintf("%sV8Wrapper", wrappedTypeName)
 	}
+	operations := CreateInstanceMethods(dataData, idlName)
+	attributes := CreateAttributes(dataData, idlName)
+	mixin := idlName.IdlInterface.InternalSpec.Type == "interface mixin"
+	var mixins, includers []string
+	nodeMixin := false
+	if mixin {
+		includers = MixinIncluders(spec, dataData)
+		nodeMixin = len(includers) > 0 && !slices.ContainsFunc(includers, func(name string) bool {
+			return !isNodeInterface(spec, name)
+		})
+	} else {
+		mixins, operations, attributes = MergeMixins(spec, dataData, idlName, operations, attributes)
+	}
+	stringifier, operations := CreateStringifier(dataData, idlName, operations, attributes)
+	asyncIterator, operations := CreateAsyncIterator(dataData, idlName, operations)
+	for i := range operations {
+		operations[i].RetTypeIsInterface = dataData.DomSpec.isInterfaceType(
+			spec, operations[i].RetType.TypeName)
+	}
+	for _, a := range attributes {
+		a.Getter.RetTypeIsInterface = dataData.DomSpec.isInterfaceType(
+			spec, a.Getter.RetType.TypeName)
+	}
 	return ESConstructorData{
 		Spec:                dataData,
 		InnerTypeName:       wrappedTypeName,
@@ -53,9 +81,17 @@ func createData(spec idl.Spec, dataData WrapperTypeSpec) ESConstructorData {
 		Receiver:            dataData.Receiver,
 		RunCustomCode:       dataData.RunCustomCode,
 		Inheritance:         idlName.Inheritance(),
+		Namespace:           idlName.IdlInterface.InternalSpec.Type == "namespace",
+		Mixin:               mixin,
+		Mixins:              mixins,
+		Includers:           includers,
+		NodeMixin:           nodeMixin,
 		Constructor:         CreateConstructor(dataData, idlName),
-		Operations:          CreateInstanceMethods(dataData, idlName),
-		Attributes:          CreateAttributes(dataData, idlName),
+		Operations:          operations,
+		Attributes:          attributes,
+		Stringifier:         stringifier,
+		AsyncIterator:       asyncIterator,
+		JSONAttributes:      DefaultJSONAttributes(spec, dataData.TypeName),
 	}
 }
 
@@ -63,7 +99,7 @@ func CreateConstructor(
 	dataData WrapperTypeSpec,
 	idlName idl.TypeSpec) *ESOperation {
 	if c, ok := idlName.Constructor(); ok {
-		fmt.Printf("Create constructor %s '%s'\n", dataData.TypeName, c.Name)
+		dataData.log().Debug("Create constructor")
 		c.Name = "constructor"
 		result := createOperation(dataData, c)
 		return &result
@@ -72,21 +108,386 @@ func CreateConstructor(
 	}
 }
 
+// CreateInstanceMethods creates the operations of the instance methods of the
+// IDL interface. Overloads aren't supported; the first declaration of a method
+// is wrapped, and a warning is logged for each other declaration.
 func CreateInstanceMethods(
 	dataData WrapperTypeSpec,
-	idlName idl.TypeSpec) (result []ESOperation) {
-	for instanceMethod := range idlName.InstanceMethods() {
-		op := createOperation(dataData, instanceMethod)
-		result = append(result, op)
+	idlName idl.TypeSpec) []ESOperation {
+	return createInstanceMethods(dataData, idlName, true)
+}
+
+// createInstanceMethods creates the operations of the instance methods, like
+// [CreateInstanceMethods]. Overloads are only logged if logOverloads is set,
+// so the warnings for the members of a mixin are logged once, when the data of
+// the mixin is created, and not for each including interface.
+func createInstanceMethods(
+	dataData WrapperTypeSpec,
+	idlName idl.TypeSpec,
+	logOverloads bool,
+) (result []ESOperation) {
+	declarations := idlName.IdlInterface.InternalSpec.Members
+	for i, member := range declarations {
+		// An operation without a name is a special operation, e.g., a getter.
+		if member.Special == "static" || member.Type != "operation" || member.Name == "" {
+			continue
+		}
+		if slices.IndexFunc(declarations, func(m idl.NameMember) bool {
+			return m.Name == member.Name
+		}) < i {
+			if logOverloads {
+				dataData.log().Warn("Function overloads", "member", member.Name)
+			}
+			continue
+		}
+		result = append(result, createOperation(dataData, idl.MemberSpec{NameMember: member}))
 	}
 	return
 }
 
+// CreateStringifier finds the operation that should be installed as the
+// "toString" method on the prototype if the IDL interface declares a
+// stringifier.
+//
+// A stringifier attribute, e.g., `stringifier attribute USVString href`, or a
+// named stringifier operation reuses the wrapper function generated for the
+// member. An anonymous `stringifier;` declaration adds a new "toString"
+// operation to the list of operations, which is returned.
+//
+// See also: https://webidl.spec.whatwg.org/#idl-stringifiers
+func CreateStringifier(
+	dataData WrapperTypeSpec,
+	idlName idl.TypeSpec,
+	operations []ESOperation,
+	attributes []ESAttribute,
+) (*ESOperation, []ESOperation) {
+	for member := range members(idlName.IdlInterface, dataData) {
+		if member.Special != "stringifier" {
+			continue
+		}
+		if member.Type == "attribute" {
+			for _, a := range attributes {
+				if a.Name == member.Name {
+					return a.Getter, operations
+				}
+			}
+			return nil, operations
+		}
+		if member.Name != "" {
+			for _, op := range operations {
+				if op.Name == member.Name && !op.MethodCustomization.Ignored {
+					return &op, operations
+				}
+			}
+			return nil, operations
+		}
+		methodCustomization := dataData.GetMethodCustomization("toString")
+		if methodCustomization.Ignored {
+			return nil, operations
+		}
+		return nil, append(operations, ESOperation{
+			Name:                 "toString",
+			NotImplemented:       methodCustomization.NotImplemented,
+			CustomImplementation: methodCustomization.CustomImplementation,
+			RetType:              idl.RetType{TypeName: "DOMString"},
+			MethodCustomization:  methodCustomization,
+			HasError:             false,
+			Arguments:            []ESOperationArgument{},
+			Spec:                 dataData.DomSpec.SpecOf(dataData.TypeName, "toString"),
+		})
+	}
+	return nil, operations
+}
+
+// CreateAsyncIterator adds a "values" operation to the list of operations if
+// the IDL interface declares a value async iterable, e.g., `async
+// iterable<any>`. The operation is also returned, to be installed as
+// Symbol.asyncIterator on the prototype.
+//
+// The Go method, e.g., Values, receives the arguments of the declaration, and
+// returns an iter.Seq2[T, error]. The script engine pulls a value from the
+// sequence each time "next" is called on the iterator, and stops the sequence
+// when "return" is called. A non-nil error rejects the promise returned by
+// "next".
+//
+// The IDL data doesn't tell if an iterable is async. As only async iterables
+// can have arguments, an iterable with arguments is async. Otherwise, the
+// wrapper spec must set [ESClassWrapper.AsyncIterable]. Pair async iterables,
+// e.g., `async iterable<USVString, FileSystemHandle>`, are not supported.
+//
+// See also: https://webidl.spec.whatwg.org/#idl-async-iterable
+func CreateAsyncIterator(
+	dataData WrapperTypeSpec,
+	idlName idl.TypeSpec,
+	operations []ESOperation,
+) (*ESOperation, []ESOperation) {
+	for member := range members(idlName.IdlInterface, dataData) {
+		if member.Type != "iterable" {
+			continue
+		}
+		if len(member.Arguments) == 0 && !dataData.AsyncIterable {
+			return nil, operations
+		}
+		if len(member.IdlType.Types) != 1 {
+			dataData.log().Warn("Pair async iterables not supported")
+			return nil, operations
+		}
+		member.Name = "values"
+		op := createOperation(dataData, idl.MemberSpec{NameMember: member})
+		if op.MethodCustomization.Ignored {
+			return nil, operations
+		}
+		op.RetType = idl.RetType{TypeName: "async iterable"}
+		op.GenericReturnType = &ESType{
+			Name:       "async iterable",
+			TypeParams: []ESType{newESType(member.IdlType.Types[0])},
+		}
+		return &op, append(operations, op)
+	}
+	return nil, operations
+}
+
+// DefaultJSONAttributes returns the names of the attributes that a default
+// toJSON operation must include in the result, following the steps to
+// "collect attribute values of an inheritance stack". Attributes from base
+// interfaces come first. Base interfaces not found in the spec are skipped.
+//
+// Only attributes of JSON types are included, see [isJSONType].
+//
+// See also: https://webidl.spec.whatwg.org/#default-tojson-steps
+func DefaultJSONAttributes(spec idl.Spec, typeName string) (res []string) {
+	intf, ok := spec.Interfaces[typeName]
+	if !ok {
+		return nil
+	}
+	if parent := intf.InternalSpec.Inheritance; parent != "" {
+		res = DefaultJSONAttributes(spec, parent)
+	}
+	if !hasDefaultToJSON(intf) {
+		return
+	}
+	for member := range members(intf, nil) {
+		if member.Type != "attribute" || member.Special == "static" {
+			continue
+		}
+		if t, ok := idl.FindIdlTypeValue(member.IdlType, "attribute-type"); ok &&
+			isJSONType(spec, t) {
+			res = append(res, member.Name)
+		}
+	}
+	return
+}
+
+func hasDefaultToJSON(intf idl.Interface) bool {
+	for member := range members(intf, nil) {
+		if member.Type == "operation" && member.Name == "toJSON" &&
+			hasExtAttr(member.ExtAttrs, "Default") {
+			return true
+		}
+	}
+	return false
+}
+
+// typeExtAttrs returns the extended attributes placed on the type itself, e.g.,
+// [EnforceRange] in `attribute [EnforceRange] long value`.
+func typeExtAttrs(t idl.IdlTypes) []idl.ExtAttr {
+	if t.IdlType == nil {
+		return nil
+	}
+	return t.IdlType.ExtAttrs
+}
+
+func hasExtAttr(attrs []idl.ExtAttr, name string) bool {
+	return slices.ContainsFunc(attrs, func(a idl.ExtAttr) bool { return a.Name == name })
+}
+
+// isPrimitiveType returns whether the IDL type is a primitive or string type,
+// i.e., not an interface, dictionary, etc.
+func isPrimitiveType(typeName string) bool {
+	switch typeName {
+	case "boolean",
+		"byte", "octet", "short", "unsigned short",
+		"long", "unsigned long", "long long", "unsigned long long",
+		"float", "unrestricted float", "double", "unrestricted double",
+		"DOMString", "ByteString", "USVString":
+		return true
+	}
+	return false
+}
+
+// isInterfaceType returns whether the IDL type is an interface, i.e., not a
+// primitive, enum, dictionary, etc. A Go value of an interface type can be nil.
+// A type not defined by the IDL of the module, data, e.g., Element in html, is
+// looked up in the IDL specs of the other modules.
+func (spec *WrapperGeneratorFileSpec) isInterfaceType(data idl.Spec, typeName string) bool {
+	if n, ok := data.IdlNames[typeName]; ok || spec == nil {
+		return n.Type == "interface"
+	}
+	for _, name := range slices.Sorted(maps.Keys(spec.modules)) {
+		mod := spec.modules[name]
+		for _, specName := range append([]string{mod.Name}, mod.ExtraSpecs...) {
+			if other, err := loadSpec(specName); err == nil {
+				if n, ok := other.IdlNames[typeName]; ok {
+					return n.Type == "interface"
+				}
+			}
+		}
+	}
+	return false
+}
+
+// members iterates over all members in the IDL interface, including members of
+// the mixins included by the wrapper. If dataData is nil, members of all mixins
+// are included.
+func members(intf idl.Interface, dataData WrapperTypeSpec) iter.Seq[idl.NameMember] {
+	return func(yield func(idl.NameMember) bool) {
+		for _, m := range intf.InternalSpec.Members {
+			if !yield(m) {
+				return
+			}
+		}
+		for i := range IncludedMixins(intf, dataData) {
+			for _, m := range i.InternalSpec.Members {
+				if !yield(m) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// IncludedMixins iterates over the mixins included by the IDL interface, e.g.,
+// ParentNode for Element, except mixins excluded by the wrapper. All mixins are
+// included if dataData is nil. Mixins not defined in the loaded specs are not
+// available, and are skipped.
+func IncludedMixins(intf idl.Interface, dataData WrapperTypeSpec) iter.Seq[idl.Interface] {
+	return func(yield func(idl.Interface) bool) {
+		for _, i := range intf.Includes {
+			if i.Name == "" || (dataData != nil && !dataData.IncludesMixin(i.Name)) {
+				continue
+			}
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// MixinIncluders returns the names of the wrapped interfaces of the module
+// including the mixin, sorted by name.
+func MixinIncluders(spec idl.Spec, mixin WrapperTypeSpec) []string {
+	var result []string
+	if mixin.DomSpec == nil {
+		return nil
+	}
+	for _, t := range mixin.DomSpec.GetTypesSorted() {
+		intf, ok := spec.Interfaces[t.TypeName]
+		if !ok {
+			continue
+		}
+		for i := range IncludedMixins(intf, t) {
+			if i.Name == mixin.TypeName {
+				result = append(result, t.TypeName)
+			}
+		}
+	}
+	return result
+}
+
+// isNodeInterface returns whether the interface is a Node type, by the
+// inheritance in the spec, or by the name for interfaces of other specs, see
+// [IsNodeType].
+func isNodeInterface(spec idl.Spec, name string) bool {
+	for name != "" {
+		if name == "Node" || IsNodeType(name) {
+			return true
+		}
+		intf, ok := spec.Interfaces[name]
+		if !ok {
+			return false
+		}
+		name = intf.InternalSpec.Inheritance
+	}
+	return false
+}
+
+// MergeMixins adds the operations and attributes of the mixins included by the
+// interface to the operations and attributes of the interface. The wrapper
+// functions for the members of a mixin are generated once, on the wrapper for
+// the mixin, and installed on every including prototype. The merged members
+// have [ESOperation.Mixin] set to the name of the mixin. The wrapper for the
+// mixin calls the Go interface with the name of the mixin, e.g.,
+// dom.ParentNode.
+//
+// A member with the same name as an existing member is skipped, i.e., the
+// interface's own members take precedence over the members of mixins.
+//
+// The customizations of a member are looked up on the including interface
+// first, and then on the mixin. A member customized by the including
+// interface, e.g., Element.append, has its wrapper function generated on the
+// wrapper of the including interface.
+//
+// Returns the names of the mixins contributing members, as well as the new
+// operations and attributes.
+func MergeMixins(
+	spec idl.Spec,
+	dataData WrapperTypeSpec,
+	idlName idl.TypeSpec,
+	operations []ESOperation,
+	attributes []ESAttribute,
+) ([]string, []ESOperation, []ESAttribute) {
+	names := make(map[string]bool)
+	for _, op := range operations {
+		names[op.Name] = true
+	}
+	for _, a := range attributes {
+		names[a.Name] = true
+	}
+	var mixins []string
+	for i := range IncludedMixins(idlName.IdlInterface, dataData) {
+		merged := false
+		mixinSpec := dataData.DomSpec.lookupType(i.Name).customizedBy(dataData)
+		mixinType, _ := spec.GetType(i.Name)
+		setMixin := func(op *ESOperation) {
+			if _, ok := dataData.Customization[op.Name]; !ok {
+				op.Mixin = i.Name
+				merged = true
+			}
+		}
+		for _, op := range createInstanceMethods(mixinSpec, mixinType, false) {
+			if names[op.Name] {
+				dataData.log().Warn("Duplicate mixin member", "member", op.Name, "mixin", i.Name)
+				continue
+			}
+			names[op.Name] = true
+			setMixin(&op)
+			operations = append(operations, op)
+		}
+		for _, a := range CreateAttributes(mixinSpec, mixinType) {
+			if names[a.Name] {
+				dataData.log().Warn("Duplicate mixin member", "member", a.Name, "mixin", i.Name)
+				continue
+			}
+			names[a.Name] = true
+			for _, op := range []*ESOperation{a.Getter, a.Setter} {
+				if op != nil {
+					setMixin(op)
+				}
+			}
+			attributes = append(attributes, a)
+		}
+		if merged {
+			mixins = append(mixins, i.Name)
+		}
+	}
+	return mixins, operations, attributes
+}
+
 func CreateAttributes(
 	dataData WrapperTypeSpec,
 	idlName idl.TypeSpec,
 ) (res []ESAttribute) {
-	for attribute := range idlName.IdlInterface.AllAttributes(dataData.IncludeIncludes) {
+	for attribute := range idlName.IdlInterface.AllAttributes(false) {
 		methodCustomization := dataData.GetMethodCustomization(attribute.Name)
 		if methodCustomization.Ignored || attribute.Type.Name == "EventHandler" {
 			continue
@@ -106,6 +507,10 @@ func CreateAttributes(
 				Nullable: attribute.Type.Nullable,
 			},
 			MethodCustomization: methodCustomization,
+			Spec:                dataData.DomSpec.SpecOf(dataData.TypeName, attribute.Name),
+		}
+		if t, ok := idl.FindIdlTypeValue(attribute.InternalSpec.IdlType, "attribute-type"); ok {
+			getter.GenericReturnType = NewReturnType(&t)
 		}
 		if !attribute.Readonly {
 			setter = new(ESOperation)
@@ -118,10 +523,19 @@ func CreateAttributes(
 			setter.RetType = idl.NewRetTypeUndefined()
 			setter.Arguments = []ESOperationArgument{{
 				Name:     "val",
-				Type:     idlNameToGoName(attribute.Type.Name),
+				Type:     attribute.Type.Name,
 				Optional: false,
 				Variadic: false,
+				Nullable: attribute.Type.Nullable,
+				IntegerConversion: NewIntegerConversion(
+					attribute.InternalSpec.ExtAttrs,
+					typeExtAttrs(attribute.InternalSpec.IdlType),
+				),
+				AllowShared: hasExtAttr(attribute.InternalSpec.ExtAttrs, "AllowShared") ||
+					hasExtAttr(typeExtAttrs(attribute.InternalSpec.IdlType), "AllowShared"),
 			}}
+			setter.Arguments[0].GenericType = getter.GenericReturnType
+			setter.GenericReturnType = nil
 		}
 		getterCustomization := dataData.GetMethodCustomization(getter.Name)
 		getter.NotImplemented = getterCustomization.NotImplemented || getter.NotImplemented
@@ -141,7 +555,13 @@ func createOperation(typeSpec WrapperTypeSpec, member idl.MemberSpec) ESOperatio
 		RetType:              member.ReturnType(),
 		MethodCustomization:  methodCustomization,
 		HasError:             !methodCustomization.HasNoError,
-		Arguments:            []ESOperationArgument{},
+		DefaultToJSON: member.Name == "toJSON" &&
+			hasExtAttr(member.ExtAttrs, "Default"),
+		Arguments: []ESOperationArgument{},
+		Spec:      typeSpec.DomSpec.SpecOf(typeSpec.TypeName, member.Name),
+	}
+	if t, ok := idl.FindIdlTypeValue(member.IdlType, "return-type"); ok {
+		op.GenericReturnType = NewReturnType(&t)
 	}
 	for _, arg := range member.Arguments {
 		var esArgumentSpec ESMethodArgument
@@ -149,20 +569,23 @@ func createOperation(typeSpec WrapperTypeSpec, member idl.MemberSpec) ESOperatio
 			esArgumentSpec = *arg
 		}
 		esArg := ESOperationArgument{
-			Name:         arg.Name,
-			Optional:     arg.Optional && !esArgumentSpec.required,
+			Name:        arg.Name,
+			Optional:    arg.Optional && !esArgumentSpec.required,
+			Variadic:    arg.Variadic,
+			Nullable:    arg.IdlType.IdlType != nil && arg.IdlType.IdlType.Nullable,
+			GenericType: NewGenericType(arg.IdlType.IdlType),
+			IntegerConversion: NewIntegerConversion(
+				arg.ExtAttrs,
+				typeExtAttrs(arg.IdlType),
+			),
+			AllowShared: hasExtAttr(arg.ExtAttrs, "AllowShared") ||
+				hasExtAttr(typeExtAttrs(arg.IdlType), "AllowShared"),
 			IdlType:      arg.IdlType,
 			ArgumentSpec: esArgumentSpec,
 			Ignore:       esArgumentSpec.ignored,
 		}
 		if len(arg.IdlType.Types) > 0 {
-			slog.Warn(
-				"Multiple argument types",
-				"Operation",
-				member.Name,
-				"Argument",
-				arg.Name,
-			)
+			typeSpec.log().Warn("Multiple argument types", "member", member.Name, "argument", arg.Name)
 		}
 		if arg.IdlType.IdlType != nil {
 			esArg.Type = arg.IdlType.IdlType.IType.TypeName
@@ -173,18 +596,38 @@ func createOperation(typeSpec WrapperTypeSpec, member idl.MemberSpec) ESOperatio
 }
 
 type ESOperationArgument struct {
-	Name         string
-	Type         string
-	Optional     bool
-	Variadic     bool
+	Name     string
+	Type     string
+	Optional bool
+	Variadic bool
+	Nullable bool
+	// GenericType is set when the argument is a sequence, FrozenArray, or
+	// record type.
+	GenericType *ESType
+	// IntegerConversion is set when an integer argument has the
+	// [EnforceRange] or [Clamp] extended attribute.
+	IntegerConversion IntegerConversion
+	// AllowShared is set when a buffer type argument has the [AllowShared]
+	// extended attribute.
+	AllowShared  bool
 	IdlType      idl.IdlTypes
 	ArgumentSpec ESMethodArgument
 	Ignore       bool
 }
 
+// OptionalInGo returns whether the argument can be omitted when calling the Go
+// function. A variadic argument is always optional, as it can receive zero
+// values.
 func (a ESOperationArgument) OptionalInGo() bool {
 	hasDefault := a.ArgumentSpec.hasDefault
-	return a.Optional && !hasDefault
+	return (a.Optional || a.Variadic) && !hasDefault
+}
+
+// GeneratedDecoder returns the decoder for argument types where the
+// conversion is generated for each engine, i.e., numeric and buffer types.
+// Returns nil for other types.
+func (a ESOperationArgument) GeneratedDecoder(hostArgs ...g.Generator) g.Generator {
+	return generatedDecoder(a.Type, a.IntegerConversion, a.AllowShared, hostArgs...)
 }
 
 func (a ESOperationArgument) DefaultValueInGo() (name string, ok bool) {
@@ -205,6 +648,22 @@ type ESOperation struct {
 	CustomImplementation bool
 	MethodCustomization  ESMethodWrapper
 	Arguments            []ESOperationArgument
+	// GenericReturnType is set when the return type is a sequence,
+	// FrozenArray, record, or promise type.
+	GenericReturnType *ESType
+	// Mixin is the name of the mixin defining the operation, when merged
+	// into an including interface. The wrapper function is generated on the
+	// wrapper for the mixin.
+	Mixin string
+	// Spec is the name of the IDL spec defining the operation, e.g., "dom".
+	Spec string
+	// RetTypeIsInterface is set when the return type is an IDL interface, e.g.,
+	// Node, and not a primitive, enum, or dictionary type.
+	RetTypeIsInterface bool
+	// DefaultToJSON is set for a `[Default] object toJSON()` operation. The
+	// wrapper function will create the JSON object from the attributes in
+	// [ESConstructorData.JSONAttributes], rather than call the Go object.
+	DefaultToJSON bool
 }
 
 func (o ESOperation) WrapperMethodName() string {
@@ -219,18 +678,39 @@ func (op ESOperation) HasResult() bool {
 	return op.RetType.IsDefined()
 }
 
+// ReturnsNullableInterface returns whether the operation returns a nullable
+// interface type, e.g., `Node?`. The wrapper must return JS null when the Go
+// method returns nil, before calling the encoder.
+func (o ESOperation) ReturnsNullableInterface() bool {
+	return o.HasResult() && o.RetType.Nullable && o.RetTypeIsInterface
+}
+
+// Encoder returns the name of the function converting the result to JS. Nullable
+// types use the "toNullable" variant, e.g., toNullableDOMString, except
+// interface types, which use the non-nullable encoder, as the generated code
+// handles nil values, see [ESOperation.ReturnsNullableInterface]
 func (o ESOperation) Encoder() string {
 	if e := o.MethodCustomization.Encoder; e != "" {
 		return e
 	}
 	converter := "to"
-	if o.RetType.Nullable {
+	if o.RetType.Nullable && !o.RetTypeIsInterface {
 		converter += "Nullable"
 	}
 	converter += idlNameToGoName(o.RetType.TypeName)
 	return converter
 }
 
+// GeneratedEncoder returns the encoder for return types where the conversion is
+// generated for each engine, i.e., buffer types. Returns nil for other types, or
+// if the method has a custom encoder.
+func (o ESOperation) GeneratedEncoder(hostArgs ...g.Generator) g.Generator {
+	if o.MethodCustomization.Encoder != "" {
+		return nil
+	}
+	return bufferSourceEncoder(o.RetType.TypeName, hostArgs...)
+}
+
 type ESAttribute struct {
 	Name   string
 	Getter *ESOperation
@@ -248,6 +728,33 @@ type ESConstructorData struct {
 	Attributes          []ESAttribute
 	Constructor         *ESOperation
 	RunCustomCode       bool
+	// Namespace is set when the IDL defines a namespace, e.g., `console`, rather
+	// than an interface. The namespace is a plain object with the operations as
+	// function properties, bound to package-level functions in Go.
+	Namespace bool
+	// Mixin is set when the IDL defines an interface mixin, e.g., ParentNode.
+	// Only the wrapper functions are generated for a mixin; these are
+	// installed on the prototypes of the including interfaces.
+	Mixin bool
+	// Mixins are the names of the mixins with members merged into the
+	// operations and attributes, see [MergeMixins].
+	Mixins []string
+	// Includers are the names of the wrapped interfaces including a mixin,
+	// see [MixinIncluders].
+	Includers []string
+	// NodeMixin is set when all the including interfaces are Node types, e.g.,
+	// for ParentNode, but not for WindowOrWorkerGlobalScope.
+	NodeMixin bool
+	// Stringifier is the operation to install as "toString" on the prototype
+	// when the operation is declared as a stringifier.
+	Stringifier *ESOperation
+	// AsyncIterator is the "values" operation to install as
+	// Symbol.asyncIterator on the prototype when the interface declares an
+	// async iterable.
+	AsyncIterator *ESOperation
+	// JSONAttributes contain the names of the attributes to include in the
+	// result of a default toJSON operation.
+	JSONAttributes []string
 }
 
 func (d ESConstructorData) GetInternalPackage() string {
@@ -256,6 +763,8 @@ func (d ESConstructorData) GetInternalPackage() string {
 		return dom
 	case "html":
 		return html
+	case "console":
+		return console
 	default:
 		return html
 	}
@@ -284,15 +793,15 @@ func (d ESConstructorData) AttributesToInstall() iter.Seq[ESAttribute] {
 func (d ESConstructorData) WrapperFunctionsToGenerate() iter.Seq[ESOperation] {
 	return func(yield func(ESOperation) bool) {
 		for op := range d.WrapperFunctionsToInstall() {
-			if !op.MethodCustomization.CustomImplementation && !yield(op) {
+			if op.Mixin == "" && !op.MethodCustomization.CustomImplementation && !yield(op) {
 				return
 			}
 		}
 		for _, a := range d.Attributes {
-			if a.Getter != nil && !a.Getter.CustomImplementation {
+			if a.Getter != nil && a.Getter.Mixin == "" && !a.Getter.CustomImplementation {
 				yield(*a.Getter)
 			}
-			if a.Setter != nil && !a.Setter.CustomImplementation {
+			if a.Setter != nil && a.Setter.Mixin == "" && !a.Setter.CustomImplementation {
 				yield(*a.Setter)
 			}
 		}
@@ -301,6 +810,72 @@ func (d ESConstructorData) WrapperFunctionsToGenerate() iter.Seq[ESOperation] {
 
 func (d ESConstructorData) Name() string { return d.Spec.TypeName }
 
+// IllegalInvocationMessage returns the error message for the TypeError thrown
+// when a wrapper function is called with a "this" object that doesn't implement
+// the interface.
+//
+// The wrapper of a mixin is created for each including interface, with the name
+// of the interface, so the message names the interface of the prototype, e.g.,
+// "HTMLElement.onerror", rather than the mixin, GlobalEventHandlers.
+func IllegalInvocationMessage(receiver g.Value, data ESConstructorData, op ESOperation) g.Generator {
+	if data.Mixin {
+		return g.Raw(receiver.Field(mixinInterfaceNameField).Generate().
+			Op("+").Lit(fmt.Sprintf(".%s: Illegal invocation", op.Name)))
+	}
+	return g.Lit(fmt.Sprintf("%s.%s: Illegal invocation", data.Name(), op.Name))
+}
+
+// includerCheckMethod is the name of the method of the wrapper of a mixin,
+// checking the interface including the mixin, see [CreateIncluderCheck].
+const includerCheckMethod = "isIncluder"
+
+// BrandCheckFailed returns the condition of a failed brand check of the Go
+// value wrapped by "this", after asserting the interface of the wrapper to
+// ok. The Go value of every including interface implements a mixin, so the
+// brand check of a mixin member also checks the interface of the prototype,
+// e.g., Element.prototype.append can't be called with a Document.
+func BrandCheckFailed(receiver g.Value, data ESConstructorData, instance g.Generator, ok g.Generator) g.Generator {
+	failed := jen.Op("!").Add(ok.Generate())
+	if data.Mixin {
+		failed = failed.Op("||").Op("!").Add(
+			receiver.Method(includerCheckMethod).Call(instance).Generate())
+	}
+	return g.Raw(failed)
+}
+
+// CreateIncluderCheck generates the method of the wrapper of a mixin returning
+// whether a value implements the Go interface of the including interface,
+// named by the interfaceName of the wrapper.
+func CreateIncluderCheck(data ESConstructorData, receiver string, wrapperType string) g.Generator {
+	value := jen.Id("value")
+	ok := jen.Id("ok")
+	cases := make([]jen.Code, 0, len(data.Includers))
+	for _, name := range data.Includers {
+		goName := name
+		if t, found := data.Spec.DomSpec.Types[name]; found && t.InnerTypeName != "" {
+			goName = t.InnerTypeName
+		}
+		cases = append(cases, jen.Case(jen.Lit(name)).Block(
+			jen.List(jen.Id("_"), ok.Clone()).Op(":=").Add(value.Clone()).
+				Assert(jen.Qual(data.GetInternalPackage(), goName)),
+			jen.Return(ok.Clone()),
+		))
+	}
+	return g.Raw(jen.Comment(fmt.Sprintf(
+		"%s returns whether the value implements the interface including",
+		includerCheckMethod,
+	)).Line().Comment("the mixin, named by interfaceName.").
+		Line().Func().Params(jen.Id(receiver).Id(wrapperType)).Id(includerCheckMethod).
+		Params(value.Clone().Any()).Bool().Block(
+		jen.Switch(jen.Id(receiver).Dot(mixinInterfaceNameField)).Block(cases...),
+		jen.Return(jen.False()),
+	).Line())
+}
+
+// mixinInterfaceNameField is the field of the wrapper of a mixin holding the
+// name of the including interface.
+const mixinInterfaceNameField = "interfaceName"
+
 func ReturnOnAnyError(errNames []g.Generator) g.Generator {
 	if len(errNames) == 0 {
 		return g.Noop
@@ -365,10 +940,12 @@ func idlNameToUnexportedGoName(s string) string {
 	return lowerCaseFirstLetter(idlNameToGoName(s))
 }
 
+// lowerCaseFirstLetter returns s with the first letter in lower case, or an
+// empty string if s is empty. Unnamed members, e.g., named property getters,
+// are skipped before names are converted, see [idl.TypeSpec.InstanceMethods].
 func lowerCaseFirstLetter(s string) string {
 	strLen := len(s)
 	if strLen == 0 {
-		slog.Warn("Passing empty string to upperCaseFirstLetter")
 		return ""
 	}
 	buffer := make([]rune, 0, strLen)
@@ -376,10 +953,12 @@ func lowerCaseFirstLetter(s string) string {
 	buffer = append(buffer, []rune(s)[1:]...)
 	return string(buffer)
 }
+
+// upperCaseFirstLetter returns s with the first letter in upper case, or an
+// empty string if s is empty.
 func upperCaseFirstLetter(s string) string {
 	strLen := len(s)
 	if strLen == 0 {
-		slog.Warn("Passing empty string to upperCaseFirstLetter")
 		return ""
 	}
 	buffer := make([]rune, 0, strLen)
@@ -0,0 +1,83 @@
+package wrappers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/gost-dom/code-gen/output"
+	g "github.com/gost-dom/generators"
+)
+
+// generatedFile is a Go file of the wrapper package, and the function creating
+// the code of the file. The file is written to out, if set, e.g., to record
+// the files of a module in a cache.
+type generatedFile struct {
+	name   string
+	create func() g.Generator
+	out    output.Files
+}
+
+// writeFiles generates the files, and writes them to out in the order given.
+// The code of the files is created in order, as creating the code logs
+// warnings about the IDL, keeping the log output the same for every run. The
+// files are then rendered, i.e., formatted, in parallel by a worker per CPU.
+//
+// An error rendering a file doesn't prevent writing the other files; errors
+// are returned in the order of the files.
+func (gen ScriptWrapperModulesGenerator) writeFiles(
+	out output.Files,
+	files []generatedFile,
+) error {
+	code := make([]jen.Code, len(files))
+	elapsed := make([]time.Duration, len(files))
+	for i, f := range files {
+		start := time.Now()
+		code[i] = f.create().Generate()
+		elapsed[i] = time.Since(start)
+	}
+
+	contents := make([][]byte, len(files))
+	errs := make([]error, len(files))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range min(runtime.GOMAXPROCS(0), len(files)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				start := time.Now()
+				var buf bytes.Buffer
+				if err := renderFile(&buf, gen.PackagePath, generatedHeader, code[i]); err != nil {
+					errs[i] = fmt.Errorf("%s: %w", files[i].name, err)
+				}
+				contents[i] = buf.Bytes()
+				elapsed[i] += time.Since(start)
+			}
+		}()
+	}
+	for i := range files {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	for i, f := range files {
+		if errs[i] != nil {
+			continue
+		}
+		fileOut := out
+		if f.out != nil {
+			fileOut = f.out
+		}
+		errs[i] = fileOut.WriteFile(f.name, contents[i])
+		if gen.OnFileGenerated != nil {
+			gen.OnFileGenerated(f.name, elapsed[i])
+		}
+	}
+	return errors.Join(errs...)
+}
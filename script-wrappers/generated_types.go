@@ -27,6 +27,10 @@ func (proto v8PrototypeTemplate) Set(name string, handler g.Generator) g.Generat
 	return proto.Value.Method("Set").Call(g.Lit(name), handler)
 }
 
+func (proto v8PrototypeTemplate) SetSymbol(symbol g.Generator, handler g.Generator) g.Generator {
+	return proto.Value.Method("SetSymbol").Call(symbol, handler)
+}
+
 type v8InstanceTemplate struct{ g.Value }
 
 func (tmpl v8InstanceTemplate) SetInternalFieldCount(val int) g.Generator {
@@ -0,0 +1,112 @@
+package wrappers
+
+import (
+	"github.com/dave/jennifer/jen"
+	g "github.com/gost-dom/generators"
+)
+
+// CreateAsyncIterators generates toAsyncIterator, the encoder converting the
+// iter.Seq2 returned from Go to a JS async iterator. Each call to "next"
+// returns a promise, and pulls a value from the sequence in a new goroutine,
+// see pullQueue. The promise is settled with the result in a task queued in
+// the script context, and "return" stops the sequence. The iterator returns
+// itself from Symbol.asyncIterator, if the host defines the symbol, see
+// [GojaTarget.installAsyncIterator].
+//
+// An error from the sequence rejects the promise with a GoError.
+func (t GojaTarget) CreateAsyncIterators() g.Generator {
+	return g.Raw(jen.Add(
+		createPullQueue(),
+		jen.Line().Line(),
+		t.toAsyncIterator(),
+		jen.Line().Line(),
+		t.asyncIteratorResult(),
+	))
+}
+
+func (t GojaTarget) toAsyncIterator() *jen.Statement {
+	value := t.qual("Value")
+	seqType := jen.Qual("iter", "Seq2").Index(jen.List(jen.Id("T"), jen.Error()))
+	vm := jen.Id("vm")
+	ctx := jen.Id("ctx")
+	pulls := jen.Id("pulls")
+	newFunction := func(body ...jen.Code) *jen.Statement {
+		return jen.Func().Params(jen.Id("c").Add(t.qual("FunctionCall"))).Add(value.Clone()).Block(body...)
+	}
+	result := func(v jen.Code, done bool) *jen.Statement {
+		return jen.Id("asyncIteratorResult").Call(vm.Clone(), v, jen.Lit(done))
+	}
+	symbol := vm.Clone().Dot("Get").Call(jen.Lit("Symbol")).
+		Dot("ToObject").Call(vm.Clone()).
+		Dot("Get").Call(jen.Lit("asyncIterator"))
+	return jen.Comment("toAsyncIterator returns an encoder converting a sequence to a JS async iterator,").
+		Line().Comment("encoding each value of the sequence using encode.").
+		Line().Func().Id("toAsyncIterator").Types(jen.Id("T").Any()).Params(
+		ctx.Clone().Add(t.context().Generate()),
+		jen.Id("encode").Func().Params(jen.Id("T")).Add(value.Clone()),
+	).Func().Params(seqType.Clone()).Add(value.Clone()).Block(
+		jen.Return(jen.Func().Params(jen.Id("seq").Add(seqType.Clone())).Add(value.Clone()).Block(
+			vm.Clone().Op(":=").Add(ctx.Clone()).Dot("vm"),
+			jen.List(jen.Id("next"), jen.Id("stop")).Op(":=").Qual("iter", "Pull2").Call(jen.Id("seq")),
+			jen.Var().Add(pulls.Clone()).Id("pullQueue"),
+			jen.Id("iterator").Op(":=").Add(vm.Clone()).Dot("NewObject").Call(),
+			jen.Id("iterator").Dot("Set").Call(jen.Lit("next"), newFunction(
+				jen.List(jen.Id("promise"), jen.Id("resolve"), jen.Id("reject")).Op(":=").
+					Add(vm.Clone()).Dot("NewPromise").Call(),
+				pulls.Clone().Dot("pull").Call(jen.Func().Params().Block(
+					jen.List(jen.Id("v"), jen.Err(), jen.Id("ok")).Op(":=").Id("next").Call(),
+					ctx.Clone().Dot("queueTask").Call(jen.Func().Params().Block(
+						jen.Switch().Block(
+							jen.Case(jen.Op("!").Id("ok")).Block(
+								jen.Id("resolve").Call(result(t.qual("Undefined").Call(), true)),
+							),
+							jen.Case(jen.Err().Op("!=").Nil()).Block(
+								pulls.Clone().Dot("pull").Call(jen.Id("stop")),
+								jen.Id("reject").Call(vm.Clone().Dot("NewGoError").Call(jen.Err())),
+							),
+							jen.Default().Block(
+								jen.Id("settlePromise").Call(
+									vm.Clone(), jen.Id("resolve"), jen.Id("reject"),
+									jen.Func().Params().Add(value.Clone()).Block(
+										jen.Return(result(jen.Id("encode").Call(jen.Id("v")), false)),
+									),
+								),
+							),
+						),
+					)),
+				)),
+				jen.Return(vm.Clone().Dot("ToValue").Call(jen.Id("promise"))),
+			)),
+			jen.Id("iterator").Dot("Set").Call(jen.Lit("return"), newFunction(
+				pulls.Clone().Dot("pull").Call(jen.Id("stop")),
+				jen.List(jen.Id("promise"), jen.Id("resolve"), jen.Id("_")).Op(":=").
+					Add(vm.Clone()).Dot("NewPromise").Call(),
+				jen.Id("resolve").Call(result(jen.Id("c").Dot("Argument").Call(jen.Lit(0)), true)),
+				jen.Return(vm.Clone().Dot("ToValue").Call(jen.Id("promise"))),
+			)),
+			jen.If(
+				jen.List(jen.Id("sym"), jen.Id("ok")).Op(":=").Add(symbol).Assert(jen.Op("*").Add(t.qual("Symbol"))),
+				jen.Id("ok"),
+			).Block(
+				jen.Id("iterator").Dot("SetSymbol").Call(jen.Id("sym"), newFunction(
+					jen.Return(jen.Id("c").Dot("This")),
+				)),
+			),
+			jen.Return(jen.Id("iterator")),
+		)),
+	)
+}
+
+func (t GojaTarget) asyncIteratorResult() *jen.Statement {
+	return jen.Comment("asyncIteratorResult creates an iterator result object.").
+		Line().Func().Id("asyncIteratorResult").Params(
+		jen.Id("vm").Op("*").Add(t.qual("Runtime")),
+		jen.Id("value").Add(t.qual("Value")),
+		jen.Id("done").Bool(),
+	).Op("*").Add(t.qual("Object")).Block(
+		jen.Id("result").Op(":=").Id("vm").Dot("NewObject").Call(),
+		jen.Id("result").Dot("Set").Call(jen.Lit("value"), jen.Id("value")),
+		jen.Id("result").Dot("Set").Call(jen.Lit("done"), jen.Id("done")),
+		jen.Return(jen.Id("result")),
+	)
+}
@@ -0,0 +1,189 @@
+package wrappers
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	g "github.com/gost-dom/generators"
+)
+
+// CreateBufferSourceConverters generates the decoders and encoders for buffer
+// types. As conversion errors must be thrown as a TypeError, a converter takes
+// the runtime, and returns the function converting the value.
+//
+// Goja doesn't support SharedArrayBuffer, so [AllowShared] has no effect.
+func (t GojaTarget) CreateBufferSourceConverters() g.Generator {
+	result := g.StatementList(
+		t.bufferSourcePredicates(),
+		g.Line,
+		t.bufferSourceBytes(),
+	)
+	for _, d := range BufferSourceDecoders() {
+		result.Append(g.Line, t.createBufferSourceDecoder(d))
+	}
+	for _, bufferType := range EncodableBufferSourceTypes() {
+		result.Append(g.Line, t.createBufferSourceEncoder(bufferType))
+	}
+	return result
+}
+
+func (t GojaTarget) createBufferSourceDecoder(d BufferSourceDecoder) g.Generator {
+	vm := g.NewValue("vm")
+	v := g.NewValue("v")
+	checks := []jen.Code{}
+	for _, k := range d.Accepts() {
+		switch k {
+		case KindArrayBuffer:
+			checks = append(checks, g.NewValue("isArrayBuffer").Call(v).Generate())
+		case KindArrayBufferView:
+			checks = append(checks, g.NewValue("isArrayBufferView").Call(vm, v).Generate())
+		case KindUint8Array:
+			checks = append(checks, g.NewValue("isUint8Array").Call(vm, v).Generate())
+		}
+	}
+	return g.FunctionDefinition{
+		Name:     d.Name(),
+		Args:     g.Arg(vm, t.runtime()),
+		RtnTypes: g.List(g.Raw(jen.Func().Params(t.value().Generate()).Index().Byte())),
+		Body: g.Return(g.Raw(
+			jen.Func().Params(v.Generate().Add(t.value().Generate())).Index().Byte().Block(
+				jen.If(notAny(checks)).Block(
+					jen.Panic(vm.Method("NewTypeError").Call(
+						g.Lit(fmt.Sprintf("Value is not of type '%s'", d.Type.Name)),
+					).Generate()),
+				),
+				jen.Return(g.NewValue("bufferSourceBytes").Call(vm, v).Generate()),
+			),
+		)),
+	}
+}
+
+func (t GojaTarget) createBufferSourceEncoder(bufferType BufferSourceType) g.Generator {
+	vm := g.NewValue("vm")
+	data := g.NewValue("data")
+	buffer := vm.Method("ToValue").Call(vm.Method("NewArrayBuffer").Call(
+		g.NewValuePackage("Clone", "slices").Call(data),
+	))
+	var body jen.Code = jen.Return(buffer.Generate())
+	if bufferType.Name == "Uint8Array" {
+		body = jen.Add(
+			jen.List(jen.Id("array"), jen.Err()).Op(":=").Add(
+				vm.Method("New").Call(vm.Method("Get").Call(g.Lit("Uint8Array")), buffer).Generate(),
+			),
+			jen.Line(),
+			jen.If(jen.Err().Op("!=").Nil()).Block(jen.Panic(jen.Err())),
+			jen.Line(),
+			jen.Return(jen.Id("array")),
+		)
+	}
+	return g.FunctionDefinition{
+		Name:     bufferType.EncoderName(),
+		Args:     g.Arg(vm, t.runtime()),
+		RtnTypes: g.List(g.Raw(jen.Func().Params(jen.Index().Byte()).Add(t.value().Generate()))),
+		Body: g.Return(g.Raw(
+			jen.Func().Params(data.Generate().Index().Byte()).Add(t.value().Generate()).Block(body),
+		)),
+	}
+}
+
+func (t GojaTarget) bufferSourcePredicates() g.Generator {
+	vm := g.NewValue("vm")
+	v := g.NewValue("v")
+	isView := g.NewValue("isView")
+	result := g.NewValue("result")
+	err := g.Id("err")
+	return g.StatementList(
+		g.FunctionDefinition{
+			Name:     "isArrayBuffer",
+			Args:     g.Arg(v, t.value()),
+			RtnTypes: g.List(g.Id("bool")),
+			Body: g.StatementList(
+				g.AssignMany(g.List(g.Id("_"), g.Id("ok")),
+					g.Raw(v.Method("Export").Call().Generate().Assert(t.arrayBuffer().Generate()))),
+				g.Return(g.Id("ok")),
+			),
+		},
+		g.Line,
+		g.FunctionDefinition{
+			Name:     "isArrayBufferView",
+			Args:     g.Arg(vm, t.runtime()).Arg(v, t.value()),
+			RtnTypes: g.List(g.Id("bool")),
+			Body: g.StatementList(
+				g.AssignMany(g.List(isView, g.Id("_")), g.NewValuePackage("AssertFunction", t.Package).Call(
+					vm.Method("Get").Call(g.Lit("ArrayBuffer")).
+						Method("ToObject").Call(vm).
+						Method("Get").Call(g.Lit("isView")),
+				)),
+				g.AssignMany(g.List(result, err), isView.Call(g.Nil, v)),
+				g.Return(g.Raw(
+					jen.Add(err.Generate()).Op("==").Nil().Op("&&").
+						Add(result.Method("ToBoolean").Call().Generate()),
+				)),
+			),
+		},
+		g.Line,
+		g.FunctionDefinition{
+			Name:     "isUint8Array",
+			Args:     g.Arg(vm, t.runtime()).Arg(v, t.value()),
+			RtnTypes: g.List(g.Id("bool")),
+			Body: g.Return(vm.Method("InstanceOf").Call(
+				v, vm.Method("Get").Call(g.Lit("Uint8Array")).Method("ToObject").Call(vm),
+			)),
+		},
+	)
+}
+
+// bufferSourceBytes generates the function copying the bytes of an
+// ArrayBuffer, or of the range of the buffer viewed by an ArrayBufferView,
+// e.g., a Float32Array, or a DataView. The value must already have been
+// checked to be one of these.
+func (t GojaTarget) bufferSourceBytes() g.Generator {
+	vm := g.NewValue("vm")
+	v := g.NewValue("v")
+	obj := g.NewValue("obj")
+	buffer := g.NewValue("buffer")
+	offset := g.NewValue("offset")
+	length := g.NewValue("length")
+	ok := g.Id("ok")
+	detached := g.IfStmt{
+		Condition: buffer.Method("Detached").Call(),
+		Block: g.Raw(jen.Panic(
+			vm.Method("NewTypeError").Call(g.Lit("The ArrayBuffer is detached")).Generate(),
+		)),
+	}
+	clone := g.NewValuePackage("Clone", "slices")
+	return g.StatementList(
+		g.Raw(jen.Comment("bufferSourceBytes returns a copy of the bytes of an ArrayBuffer, or of the").Line().
+			Comment("range of the buffer viewed by an ArrayBufferView.")),
+		g.FunctionDefinition{
+			Name:     "bufferSourceBytes",
+			Args:     g.Arg(vm, t.runtime()).Arg(v, t.value()),
+			RtnTypes: g.List(byteSlice),
+			Body: g.StatementList(
+				g.IfStmt{
+					Condition: g.Raw(
+						jen.List(buffer.Generate(), ok.Generate()).Op(":=").
+							Add(v.Method("Export").Call().Generate().Assert(t.arrayBuffer().Generate())).
+							Op(";").Add(ok.Generate()),
+					),
+					Block: g.StatementList(
+						detached,
+						g.Return(clone.Call(buffer.Method("Bytes").Call())),
+					),
+				},
+				g.Assign(obj, v.Method("ToObject").Call(vm)),
+				g.AssignMany(g.List(buffer, g.Id("_")), g.Raw(
+					obj.Method("Get").Call(g.Lit("buffer")).
+						Method("Export").Call().Generate().Assert(t.arrayBuffer().Generate()),
+				)),
+				detached,
+				g.Assign(offset, obj.Method("Get").Call(g.Lit("byteOffset")).Method("ToInteger").Call()),
+				g.Assign(length, obj.Method("Get").Call(g.Lit("byteLength")).Method("ToInteger").Call()),
+				g.Return(clone.Call(g.Raw(
+					buffer.Method("Bytes").Call().Generate().
+						Index(offset.Generate().Op(":").Add(offset.Generate()).Op("+").Add(length.Generate())),
+				))),
+			),
+		},
+	)
+}
@@ -7,14 +7,6 @@ import (
 	g "github.com/gost-dom/generators"
 )
 
-var (
-	gojaFc      = g.Raw(jen.Qual(gojaSrc, "FunctionCall"))
-	gojaValue   = g.Raw(jen.Qual(gojaSrc, "Value"))
-	gojaObj     = g.Raw(jen.Op("*").Qual(gojaSrc, "Object"))
-	gojaRuntime = g.Raw(jen.Op("*").Qual(gojaSrc, "Runtime"))
-	flagTrue    = g.Raw(jen.Qual(gojaSrc, "FLAG_TRUE"))
-)
-
 type GojaNamingStrategy struct {
 	ESConstructorData
 }
@@ -28,67 +20,174 @@ func (s GojaNamingStrategy) PrototypeWrapperTypeName() string {
 }
 
 func (s GojaNamingStrategy) PrototypeWrapperConstructorName() string {
-	return fmt.Sprintf("new%s", s.PrototypeWrapperBaseName())
+	return fmt.Sprintf("new%s", upperCaseFirstLetter(s.PrototypeWrapperBaseName()))
 }
 
 func (s GojaNamingStrategy) ReceiverName() string {
 	return "w" // data.Receiver
 }
 
-type GojaTargetGenerators struct{}
+// GojaTarget is the [EngineTarget] for goja, and forks of goja with the same
+// API, e.g., sobek. Wrapper methods panic with the JS value to throw, which
+// the engine converts to an exception.
+type GojaTarget struct {
+	// Package is the import path of the engine, e.g., "github.com/dop251/goja".
+	Package string
+	// ContextType is the name of the type in the script host package wrapping
+	// the runtime of a browsing context, e.g., "GojaContext".
+	ContextType string
+}
 
-func (gen GojaTargetGenerators) CreateJSConstructorGenerator(data ESConstructorData) g.Generator {
-	generator := g.StatementList()
+// GojaEngine is the target of the goja script host.
+var GojaEngine = GojaTarget{Package: gojaSrc, ContextType: "GojaContext"}
 
-	generator = g.StatementList(
-		gen.CreateInitFunction(data),
-		gen.CreateWrapperStruct(data),
-		gen.CreatePrototypeInitializer(data),
-		gen.CreateWrapperMethods(data),
-		generator,
-	)
-	return generator
+func (t GojaTarget) qual(name string) *jen.Statement { return jen.Qual(t.Package, name) }
+
+func (t GojaTarget) functionCall() g.Generator { return g.Raw(t.qual("FunctionCall")) }
+func (t GojaTarget) value() g.Generator        { return g.Raw(t.qual("Value")) }
+func (t GojaTarget) object() g.Generator       { return g.Raw(jen.Op("*").Add(t.qual("Object"))) }
+func (t GojaTarget) runtime() g.Generator      { return g.Raw(jen.Op("*").Add(t.qual("Runtime"))) }
+func (t GojaTarget) flagTrue() g.Generator     { return g.Raw(t.qual("FLAG_TRUE")) }
+func (t GojaTarget) arrayBuffer() g.Type       { return g.NewTypePackage("ArrayBuffer", t.Package) }
+func (t GojaTarget) context() g.Generator      { return g.NewType(t.ContextType).Pointer() }
+
+func (t GojaTarget) Naming(data ESConstructorData) NamingStrategy {
+	return GojaNamingStrategy{data}
+}
+
+// vm returns the runtime, from the context of the wrapper.
+func (t GojaTarget) vm(data ESConstructorData) g.Value {
+	return g.NewValue(GojaNamingStrategy{data}.ReceiverName()).Field("ctx").Field("vm")
+}
+
+// hostArgs returns the arguments creating the generic converters in the
+// wrapper methods, the runtime, and the script context of the wrapper.
+func (t GojaTarget) hostArgs(data ESConstructorData) HostArgs {
+	ctx := g.NewValue(GojaNamingStrategy{data}.ReceiverName()).Field("ctx")
+	return HostArgs{Runtime: g.List(ctx.Field("vm")), Context: g.List(ctx)}
+}
+
+// CreateJSClassRegistrations generates the init function installing all
+// classes in the script host, in the order returned by [SortJSClasses].
+func (t GojaTarget) CreateJSClassRegistrations(classes []JSClass) g.Generator {
+	body := g.StatementList()
+	for _, c := range classes {
+		naming := GojaNamingStrategy{c.Data}
+		body.Append(g.NewValue("installClass").Call(
+			g.Lit(c.Data.Name()),
+			g.Lit(c.Inheritance()),
+			g.Id(naming.PrototypeWrapperConstructorName()),
+		))
+	}
+	return g.FunctionDefinition{Name: "init", Body: body}
+}
+
+// CreateConverterStubs generates stubs of the missing converters, with the
+// signatures used by the goja wrappers.
+func (t GojaTarget) CreateConverterStubs(m ConverterManifest) g.Generator {
+	return createConverterStubs(m, func(kind ConverterKind) ([]jen.Code, []jen.Code) {
+		switch kind {
+		case ConverterDecoder:
+			return []jen.Code{jen.Id("v").Add(t.qual("Value"))}, []jen.Code{jen.Any()}
+		case ConverterEncoder:
+			return []jen.Code{jen.Id("v").Any()}, []jen.Code{t.qual("Value")}
+		default:
+			return nil, []jen.Code{jen.Any()}
+		}
+	})
 }
 
-func (gen GojaTargetGenerators) CreateInitFunction(data ESConstructorData) g.Generator {
+// CreateNamespaceInitializer generates the code for an IDL namespace, e.g.,
+// `console`. The namespace is a plain object with the operations as function
+// properties.
+func (t GojaTarget) CreateNamespaceInitializer(data ESConstructorData) g.Generator {
 	naming := GojaNamingStrategy{data}
-	return g.FunctionDefinition{
-		Name: "init",
-		Body: g.NewValue("installClass").
-			Call(g.Lit(data.Name()), g.Lit(data.Inheritance), g.Id(naming.PrototypeWrapperConstructorName())),
+	typeName := g.Id(naming.PrototypeWrapperTypeName())
+	constructorName := naming.PrototypeWrapperConstructorName()
+	receiver := g.NewValue(naming.ReceiverName())
+	namespace := g.NewValue("namespace")
+
+	wrapperStruct := g.NewStruct(typeName)
+	wrapperStruct.Embed(g.Id("baseNamespaceWrapper"))
 
-		//g.Lit(data.Name), g.Lit(data.Inheritance), g.Id(naming.PrototypeWrapperConstructorName())),
+	body := g.StatementList()
+	for op := range data.WrapperFunctionsToInstall() {
+		body.Append(namespace.Field("Set").Call(g.Lit(op.Name), receiver.Field(op.WrapperMethodName())))
 	}
+
+	return g.StatementList(
+		g.FunctionDefinition{
+			Name: "init",
+			Body: g.NewValue("installNamespace").
+				Call(g.Lit(data.Name()), g.Id(constructorName)),
+		},
+		wrapperStruct,
+		g.FunctionDefinition{
+			Name:     constructorName,
+			Args:     g.Arg(g.Id("instance"), t.context()),
+			RtnTypes: g.List(g.NewType("namespaceWrapper")),
+			Body: g.Return(g.InstantiateStruct(typeName,
+				g.NewValue("newBaseNamespaceWrapper").Call(g.Id("instance")),
+			)),
+		},
+		g.FunctionDefinition{
+			Receiver: g.FunctionArgument{Name: receiver, Type: typeName},
+			Name:     "initializeNamespace",
+			Args:     g.Arg(namespace, t.object()).Arg(g.Id("vm"), t.runtime()),
+			Body:     body,
+		},
+	)
 }
 
 // CreatePrototypeInitializer creates the "initializePrototype" method, which
 // sets all the properties on the prototypes on this class.
-func (gen GojaTargetGenerators) CreatePrototypeInitializer(data ESConstructorData) g.Generator {
+func (t GojaTarget) CreatePrototypeInitializer(data ESConstructorData) g.Generator {
 	naming := GojaNamingStrategy{data}
 	receiver := g.NewValue(naming.ReceiverName())
 	vm := receiver.Field("ctx").Field("vm")
 	prototype := g.NewValue("prototype")
 
+	wrapperFor := func(op ESOperation) g.Value {
+		if op.Mixin != "" {
+			return g.NewValue(lowerCaseFirstLetter(op.Mixin) + "Wrapper")
+		}
+		return receiver
+	}
+
 	body := g.StatementList()
+	for _, mixin := range data.Mixins {
+		body.Append(g.Assign(
+			g.NewValue(lowerCaseFirstLetter(mixin)+"Wrapper"),
+			g.NewValue(fmt.Sprintf("new%sWrapper", mixin)).Call(
+				receiver.Field("ctx"), g.Lit(data.Name()),
+			),
+		))
+	}
 	for op := range data.WrapperFunctionsToInstall() {
-		body.Append(prototype.Field("Set").Call(g.Lit(op.Name), receiver.Field(op.Name)))
+		body.Append(prototype.Field("Set").Call(g.Lit(op.Name), wrapperFor(op).Field(op.WrapperMethodName())))
+	}
+	if s := data.Stringifier; s != nil {
+		body.Append(prototype.Field("Set").Call(g.Lit("toString"), wrapperFor(*s).Field(s.WrapperMethodName())))
+	}
+	if data.AsyncIterator != nil {
+		body.Append(t.installAsyncIterator(vm, prototype))
 	}
 
 	for a := range data.AttributesToInstall() {
 		var getter, setter g.Generator
 		if a.Getter != nil {
-			getter = vm.Field("ToValue").Call(receiver.Field(a.Getter.Name))
+			getter = vm.Field("ToValue").Call(wrapperFor(*a.Getter).Field(a.Getter.WrapperMethodName()))
 		} else {
 			getter = g.Nil
 		}
 		if a.Setter != nil {
-			setter = vm.Field("ToValue").Call(receiver.Field(a.Setter.Name))
+			setter = vm.Field("ToValue").Call(wrapperFor(*a.Setter).Field(a.Setter.WrapperMethodName()))
 		} else {
 			setter = g.Nil
 		}
 		body.Append(
 			prototype.Field("DefineAccessorProperty").
-				Call(g.Lit(a.Name), getter, setter, flagTrue, flagTrue),
+				Call(g.Lit(a.Name), getter, setter, t.flagTrue(), t.flagTrue()),
 		)
 	}
 
@@ -98,122 +197,282 @@ func (gen GojaTargetGenerators) CreatePrototypeInitializer(data ESConstructorDat
 			Type: g.Id(naming.PrototypeWrapperTypeName()),
 		},
 		Name: "initializePrototype",
-		Args: g.Arg(prototype, gojaObj).Arg(g.Id("vm"), gojaRuntime),
+		Args: g.Arg(prototype, t.object()).Arg(g.Id("vm"), t.runtime()),
 		Body: body,
 	}
 }
 
-func (gen GojaTargetGenerators) CreateWrapperStruct(data ESConstructorData) g.Generator {
+// installAsyncIterator installs the "values" function as Symbol.asyncIterator
+// on the prototype. Goja doesn't define Symbol.asyncIterator, so the symbol is
+// looked up at runtime, and nothing is installed if the host doesn't provide
+// it.
+func (t GojaTarget) installAsyncIterator(vm g.Value, prototype g.Value) g.Generator {
+	sym := g.Id("sym")
+	ok := g.Id("ok")
+	lookup := vm.Field("Get").Call(g.Lit("Symbol")).
+		Field("ToObject").Call(vm).
+		Field("Get").Call(g.Lit("asyncIterator"))
+	return g.IfStmt{
+		Condition: g.Raw(
+			jen.List(sym.Generate(), ok.Generate()).Op(":=").
+				Add(lookup.Generate()).Assert(jen.Op("*").Add(t.qual("Symbol"))).
+				Op(";").Add(ok.Generate()),
+		),
+		Block: prototype.Field("SetSymbol").Call(sym, prototype.Field("Get").Call(g.Lit("values"))),
+	}
+}
+
+func (t GojaTarget) CreateWrapperStruct(data ESConstructorData) g.Generator {
 	naming := GojaNamingStrategy{data}
 	typeName := g.Id(naming.PrototypeWrapperTypeName())
 	constructorName := naming.PrototypeWrapperConstructorName()
-	innerType := g.Raw(jen.Qual(dom, data.Name()))
+	innerType := t.innerType(data)
 
 	wrapperStruct := g.NewStruct(typeName)
-	wrapperStruct.Embed(g.Raw(jen.Id("baseInstanceWrapper").Index(innerType)))
+	wrapperStruct.Embed(g.Raw(jen.Id("baseInstanceWrapper").Index(innerType.Generate())))
 
+	args := g.Arg(g.Id("instance"), t.context())
+	values := g.List(
+		g.NewValue("newBaseInstanceWrapper").TypeParam(innerType).Call(g.Id("instance")),
+	)
+	rtnType := g.Generator(g.NewType("wrapper"))
+	if data.Mixin {
+		// The wrapper of a mixin isn't installed as a class, but created by
+		// each including interface, see [IllegalInvocationMessage]
+		interfaceName := g.Id(mixinInterfaceNameField)
+		wrapperStruct.Field(interfaceName, g.Id("string"))
+		args = args.Arg(interfaceName, g.Id("string"))
+		values = append(values, interfaceName)
+		rtnType = typeName
+	}
 	wrapperConstructor := g.FunctionDefinition{
 		Name:     constructorName,
-		Args:     g.Arg(g.Id("instance"), g.NewType("GojaContext").Pointer()),
-		RtnTypes: g.List(g.NewType("wrapper")),
-		Body: g.Return(g.InstantiateStruct(typeName,
-			g.NewValue("newBaseInstanceWrapper").TypeParam(innerType).Call(g.Id("instance")),
-		)),
+		Args:     args,
+		RtnTypes: g.List(rtnType),
+		Body:     g.Return(g.InstantiateStruct(typeName, values...)),
 	}
 
+	if data.Mixin {
+		return g.StatementList(
+			wrapperStruct,
+			wrapperConstructor,
+			g.Line,
+			CreateIncluderCheck(data, naming.ReceiverName(), naming.PrototypeWrapperTypeName()),
+		)
+	}
 	return g.StatementList(wrapperStruct, wrapperConstructor)
 }
 
-func (gen GojaTargetGenerators) CreateWrapperMethods(data ESConstructorData) g.Generator {
-	list := g.StatementList()
-	for op := range data.WrapperFunctionsToGenerate() {
-		list.Append(gen.CreateWrapperMethod(data, op))
+func (t GojaTarget) callArgument() g.Value { return g.NewValue("c") }
+
+func (t GojaTarget) CallArgument() g.FunctionArgument {
+	return g.FunctionArgument{Name: t.callArgument(), Type: t.functionCall()}
+}
+
+// DecodeArgument returns the expression decoding the argument. Numeric and
+// buffer types, and generic types, are decoded by generated decoders, other
+// types by the decoder method of the wrapper, e.g., decodeNode. A missing
+// argument with a default value is decoded as the default, like
+// tryParseArgWithDefault in the V8 host.
+func (t GojaTarget) DecodeArgument(
+	data ESConstructorData,
+	arg ESOperationArgument,
+	index int,
+) g.Generator {
+	receiver := g.NewValue(GojaNamingStrategy{data}.ReceiverName())
+	vm := t.vm(data)
+	converter := g.Generator(receiver.Field(fmt.Sprintf("decode%s", arg.Type)))
+	if arg.GenericType != nil {
+		converter = arg.GenericType.Decoder(receiver, t.hostArgs(data))
+	} else if decoder := arg.GeneratedDecoder(vm); decoder != nil {
+		converter = decoder
+	}
+	args := t.callArgument().Field("Arguments")
+	if arg.Variadic {
+		return g.NewValue("decodeVariadicArgs").Call(args, g.Lit(index), converter)
+	}
+	if defaultName, ok := arg.DefaultValueInGo(); ok {
+		return g.NewValue("decodeArgWithDefault").Call(
+			args, g.Lit(index), receiver.Field(defaultName), converter,
+		)
+	}
+	var value g.Generator = g.Raw(t.callArgument().Generate().Dot("Arguments").Index(jen.Lit(index)))
+	if arg.Optional {
+		// Argument returns undefined for a missing argument
+		value = t.callArgument().Field("Argument").Call(g.Lit(index))
 	}
-	return list
+	if arg.Nullable {
+		return g.NewValue("decodeNullable").Call(value, converter)
+	}
+	return g.ValueOf(converter).Call(value)
 }
 
-func (gen GojaTargetGenerators) CreateWrapperMethod(
+func (t GojaTarget) ResultType() g.Generator { return t.value() }
+
+// EncodeResult returns the expression encoding the result. Buffer types, and
+// generic types, are encoded by generated encoders, other types by the encoder
+// method of the wrapper, e.g., toNode.
+func (t GojaTarget) EncodeResult(
 	data ESConstructorData,
 	op ESOperation,
+	result g.Generator,
 ) g.Generator {
-	naming := GojaNamingStrategy{data}
-	callArgument := g.Id("c")
+	receiver := g.NewValue(GojaNamingStrategy{data}.ReceiverName())
+	vm := t.vm(data)
+	converter := g.Generator(receiver.Field(op.Encoder()))
+	if rt := op.GenericReturnType; rt != nil {
+		converter = rt.Encoder(receiver, t.hostArgs(data))
+	} else if e := op.GeneratedEncoder(vm); e != nil {
+		converter = e
+	}
+	return g.ValueOf(converter).Call(result)
+}
+
+func (t GojaTarget) EncodeNull(ESConstructorData) g.Generator {
+	return g.NewValuePackage("Null", t.Package).Call()
+}
+
+func (t GojaTarget) EncodeUndefined(ESConstructorData) g.Generator { return g.Nil }
+
+// CreateDefaultToJSONBody creates the body of a default toJSON operation,
+// creating a new object with the values of all JSON attributes of the "this"
+// object.
+//
+// See also: https://webidl.spec.whatwg.org/#default-tojson-steps
+func (t GojaTarget) CreateDefaultToJSONBody(data ESConstructorData) g.Generator {
+	vm := t.vm(data)
+	this := g.NewValue("this")
+	result := g.NewValue("result")
+	name := g.NewValue("name")
+	names := make([]jen.Code, len(data.JSONAttributes))
+	for i, n := range data.JSONAttributes {
+		names[i] = jen.Lit(n)
+	}
 	return g.StatementList(
-		g.Line,
-		g.FunctionDefinition{
-			Receiver: g.FunctionArgument{
-				Name: g.Id(naming.ReceiverName()),
-				Type: g.Id(naming.PrototypeWrapperTypeName()),
-			},
-			Name:     op.Name,
-			Args:     g.Arg(callArgument, gojaFc),
-			RtnTypes: g.List(gojaValue),
-			Body:     gen.CreateWrapperMethodBody(data, op, callArgument),
-		})
+		g.Assign(this, t.callArgument().Field("This").Method("ToObject").Call(vm)),
+		g.Assign(result, vm.Method("NewObject").Call()),
+		g.Raw(jen.For(
+			jen.List(jen.Id("_"), name.Generate()).
+				Op(":=").Range().Index().String().Values(names...),
+		).Block(
+			result.Method("Set").Call(name, this.Method("Get").Call(name)).Generate(),
+		)),
+		g.Return(result),
+	)
+}
+
+func (t GojaTarget) innerType(data ESConstructorData) g.Type {
+	return g.NewTypePackage(data.Name(), data.GetInternalPackage())
 }
 
-func (gen GojaTargetGenerators) CreateWrapperMethodBody(
+// DecodeThis generates the brand check, retrieving the Go value wrapped by the
+// JS "this" object, and throwing a TypeError if it doesn't wrap a Go value
+// implementing the interface of the wrapper. Go interfaces embed the interfaces
+// they inherit from, so a value of a derived type passes the check.
+func (t GojaTarget) DecodeThis(
 	data ESConstructorData,
 	op ESOperation,
-	callArgument g.Generator,
+	instance g.Generator,
 ) g.Generator {
-	if op.NotImplemented {
-		msg := fmt.Sprintf(
-			"%s.%s: Not implemented. Create an issue: %s", data.Name(), op.Name, ISSUE_URL,
-		)
-		return g.Raw(jen.Panic(jen.Lit(msg)))
-	}
-	naming := GojaNamingStrategy{data}
-	receiver := g.NewValue(naming.ReceiverName())
-	instance := g.NewValue("instance")
-	readArgs := g.StatementList()
-	argNames := make([]g.Generator, len(op.Arguments))
-	for i, a := range op.Arguments {
-		argNames[i] = g.Id(a.Name)
-		value := g.Raw(callArgument.Generate().Dot("Arguments").Index(jen.Lit(i)))
-		converter := fmt.Sprintf("decode%s", a.Type)
-		readArgs.Append(g.Assign(argNames[i], receiver.Field(converter).Call(value)))
-	}
-	list := g.StatementList(
-		g.Assign(instance, receiver.Field("getInstance").Call(callArgument)),
-		readArgs,
+	vm := t.vm(data)
+	ok := g.Id("ok")
+	receiver := g.NewValue(GojaNamingStrategy{data}.ReceiverName())
+	return g.StatementList(
+		g.AssignMany(g.List(instance, ok), g.Raw(
+			t.callArgument().Field("This").Method("Export").Call().
+				Generate().Assert(t.innerType(data).Generate()),
+		)),
+		g.IfStmt{
+			Condition: BrandCheckFailed(receiver, data, instance, ok),
+			Block: g.Raw(jen.Panic(
+				vm.Method("NewTypeError").Call(IllegalInvocationMessage(receiver, data, op)).Generate(),
+			)),
+		},
 	)
-	if op.HasResult() {
-		converter := fmt.Sprintf("to%s", idlNameToGoName(op.RetType.TypeName))
-		if op.GetHasError() {
-			list.Append(
-				g.AssignMany(g.List(
-					g.Id("result"), g.Id("err")),
-					instance.Field(upperCaseFirstLetter(op.Name)).Call(argNames...),
-				),
-				panicOnNotNil(g.Id("err")),
-			)
-		} else {
-			list.Append(
-				g.Assign(
-					g.Id("result"),
-					instance.Field(upperCaseFirstLetter(op.Name)).Call(argNames...),
-				),
-			)
-		}
-		list.Append(g.Return(receiver.Field(converter).Call(g.Id("result"))))
-	} else {
-		if op.GetHasError() {
-			list.Append(
-				g.Assign(g.Id("err"), instance.Field(upperCaseFirstLetter(op.Name)).Call(argNames...)),
-				panicOnNotNil(g.Id("err")),
-			)
+}
 
-		} else {
-			list.Append(instance.Field(upperCaseFirstLetter(op.Name)).Call(argNames...))
-		}
+// RaiseError generates code that panics if err is not nil, which goja converts
+// to a JavaScript exception. The error is mapped to a DOMException if a mapping
+// exists.
+func (t GojaTarget) RaiseError(
+	data ESConstructorData,
+	op ESOperation,
+	err g.Generator,
+) g.Generator {
+	ctx := g.NewValue(GojaNamingStrategy{data}.ReceiverName()).Field("ctx")
+	return g.IfStmt{
+		Condition: g.Neq{Lhs: err, Rhs: g.Nil},
+		Block: g.StatementList(
+			ErrorMappingChecks(op.MethodCustomization.ErrorMappings, err,
+				func(m ErrorMapping) g.Generator {
+					return g.Raw(jen.Panic(gojaNewDOMException(ctx, err, m).Generate()))
+				}),
+			g.Raw(jen.Panic(g.NewValue("mapError").Call(ctx, err).Generate())),
+		),
 	}
-	return list
 }
 
-func panicOnNotNil(lhs g.Generator) g.Generator {
-	return g.IfStmt{
-		Condition: g.Neq{Lhs: lhs, Rhs: g.Nil},
-		Block:     g.Raw(jen.Panic(jen.Id("err"))),
+// RaiseNotImplemented panics with the error returned by notImplemented.
+func (t GojaTarget) RaiseNotImplemented(data ESConstructorData, op ESOperation) g.Generator {
+	return g.Raw(jen.Panic(jen.Id("notImplemented").Call(jen.Lit(data.Name()), jen.Lit(op.Name))))
+}
+
+// CreateErrorMapper generates the mapError function, converting errors
+// returned from Go to DOMExceptions using the host's newDOMException function.
+// Errors without a mapping are returned unchanged.
+func (t GojaTarget) CreateErrorMapper(mappings []ErrorMapping) g.Generator {
+	err := g.Id("err")
+	ctx := g.Id("ctx")
+	return g.StatementList(
+		g.Raw(jen.Comment("mapError converts an error returned from Go code to a DOMException if")),
+		g.Raw(jen.Comment("the error has a known mapping. Other errors are returned unchanged.")),
+		g.FunctionDefinition{
+			Name:     "mapError",
+			Args:     g.Arg(ctx, t.context()).Arg(err, g.Id("error")),
+			RtnTypes: g.List(g.Id("any")),
+			Body: g.StatementList(
+				ErrorMappingChecks(mappings, err, func(m ErrorMapping) g.Generator {
+					return g.Return(gojaNewDOMException(ctx, err, m))
+				}),
+				g.Return(err),
+			),
+		},
+	)
+}
+
+// CreateNumericDecoders generates the decoders for all numeric types. As a
+// conversion error must be thrown as a TypeError, a decoder takes the runtime,
+// and returns the function decoding the value.
+func (t GojaTarget) CreateNumericDecoders() g.Generator {
+	vm := g.NewValue("vm")
+	v := g.NewValue("v")
+	x := g.Id("x")
+	err := g.NewValue("err")
+	result := g.StatementList(CreateNumericConversions())
+	for _, d := range NumericDecoders() {
+		goType := g.Id(d.Type.GoType)
+		result.Append(g.Line, g.FunctionDefinition{
+			Name:     d.Name(),
+			Args:     g.Arg(vm, t.runtime()),
+			RtnTypes: g.List(g.Raw(jen.Func().Params(t.value().Generate()).Add(goType.Generate()))),
+			Body: g.Return(g.Raw(jen.Func().Params(
+				v.Generate().Add(t.value().Generate()),
+			).Add(goType.Generate()).Block(
+				g.AssignMany(g.List(x, err), d.Convert(v.Method("ToFloat").Call())).Generate(),
+				g.IfStmt{
+					Condition: g.Neq{Lhs: err, Rhs: g.Nil},
+					Block: g.Raw(jen.Panic(
+						vm.Method("NewTypeError").Call(err.Method("Error").Call()).Generate(),
+					)),
+				}.Generate(),
+				g.Return(g.NewValue(d.Type.GoType).Call(x)).Generate(),
+			))),
+		})
 	}
+	return result
+}
+
+func gojaNewDOMException(ctx g.Generator, err g.Generator, m ErrorMapping) g.Generator {
+	args := append(g.List(ctx), NewDOMExceptionArgs(err, m)...)
+	return g.NewValue("newDOMException").Call(args...)
 }
@@ -0,0 +1,108 @@
+package wrappers
+
+import (
+	"github.com/dave/jennifer/jen"
+	g "github.com/gost-dom/generators"
+)
+
+// CreatePromises generates toPromise, and toVoidPromise, the encoders
+// converting the functions returned from Go for `Promise<T>`, and
+// `Promise<undefined>`, to JS promises, see [ESType]. The function is called
+// in a new goroutine, and the promise is settled in a task queued in the
+// script context when it returns.
+//
+// An error from the function rejects the promise with a GoError, and an
+// exception thrown by the encoder rejects it with the exception.
+func (t GojaTarget) CreatePromises() g.Generator {
+	return g.Raw(jen.Add(
+		t.toPromise(),
+		jen.Line().Line(),
+		t.toVoidPromise(),
+		jen.Line().Line(),
+		t.settlePromise(),
+	))
+}
+
+func (t GojaTarget) toPromise() *jen.Statement {
+	value := t.qual("Value")
+	vm := jen.Id("vm")
+	ctx := jen.Id("ctx")
+	funcType := jen.Func().Params().Params(jen.Id("T"), jen.Error())
+	return jen.Comment("toPromise returns an encoder converting a function to a JS promise, settled").
+		Line().Comment("with the result of the function encoded using encode.").
+		Line().Func().Id("toPromise").Types(jen.Id("T").Any()).Params(
+		ctx.Clone().Add(t.context().Generate()),
+		jen.Id("encode").Func().Params(jen.Id("T")).Add(value.Clone()),
+	).Func().Params(funcType.Clone()).Add(value.Clone()).Block(
+		jen.Return(jen.Func().Params(jen.Id("f").Add(funcType.Clone())).Add(value.Clone()).Block(
+			vm.Clone().Op(":=").Add(ctx.Clone()).Dot("vm"),
+			jen.List(jen.Id("promise"), jen.Id("resolve"), jen.Id("reject")).Op(":=").
+				Add(vm.Clone()).Dot("NewPromise").Call(),
+			jen.Go().Func().Params().Block(
+				jen.List(jen.Id("v"), jen.Err()).Op(":=").Id("f").Call(),
+				ctx.Clone().Dot("queueTask").Call(jen.Func().Params().Block(
+					jen.If(jen.Err().Op("!=").Nil()).Block(
+						jen.Id("reject").Call(vm.Clone().Dot("NewGoError").Call(jen.Err())),
+						jen.Return(),
+					),
+					jen.Id("settlePromise").Call(
+						vm.Clone(), jen.Id("resolve"), jen.Id("reject"),
+						jen.Func().Params().Add(value.Clone()).Block(
+							jen.Return(jen.Id("encode").Call(jen.Id("v"))),
+						),
+					),
+				)),
+			).Call(),
+			jen.Return(vm.Clone().Dot("ToValue").Call(jen.Id("promise"))),
+		)),
+	)
+}
+
+func (t GojaTarget) toVoidPromise() *jen.Statement {
+	value := t.qual("Value")
+	ctx := jen.Id("ctx")
+	funcType := jen.Func().Params().Error()
+	return jen.Comment("toVoidPromise returns an encoder converting a function without a result to a").
+		Line().Comment("JS promise, resolved with undefined.").
+		Line().Func().Id("toVoidPromise").Params(
+		ctx.Clone().Add(t.context().Generate()),
+	).Func().Params(funcType.Clone()).Add(value.Clone()).Block(
+		jen.Id("encode").Op(":=").Id("toPromise").Call(
+			ctx.Clone(),
+			jen.Func().Params(jen.Struct()).Add(value.Clone()).Block(
+				jen.Return(t.qual("Undefined").Call()),
+			),
+		),
+		jen.Return(jen.Func().Params(jen.Id("f").Add(funcType.Clone())).Add(value.Clone()).Block(
+			jen.Return(jen.Id("encode").Call(
+				jen.Func().Params().Params(jen.Struct(), jen.Error()).Block(
+					jen.Return(jen.Struct().Values(), jen.Id("f").Call()),
+				),
+			)),
+		)),
+	)
+}
+
+func (t GojaTarget) settlePromise() *jen.Statement {
+	value := t.qual("Value")
+	settle := jen.Func().Params(jen.Any()).Error()
+	return jen.Comment("settlePromise resolves the promise with the value returned from f, or rejects").
+		Line().Comment("it with the exception thrown by f.").
+		Line().Func().Id("settlePromise").Params(
+		jen.Id("vm").Op("*").Add(t.qual("Runtime")),
+		jen.List(jen.Id("resolve"), jen.Id("reject")).Add(settle),
+		jen.Id("f").Func().Params().Add(value.Clone()),
+	).Block(
+		jen.Var().Id("v").Add(value.Clone()),
+		jen.If(
+			jen.Id("ex").Op(":=").Id("vm").Dot("Try").Call(jen.Func().Params().Block(
+				jen.Id("v").Op("=").Id("f").Call(),
+			)),
+			jen.Id("ex").Op("!=").Nil(),
+		).Block(
+			jen.Id("reject").Call(jen.Id("ex").Dot("Value").Call()),
+			jen.Return(),
+		),
+		jen.Id("resolve").Call(jen.Id("v")),
+	)
+}
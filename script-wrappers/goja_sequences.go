@@ -0,0 +1,177 @@
+package wrappers
+
+import (
+	"github.com/dave/jennifer/jen"
+	g "github.com/gost-dom/generators"
+)
+
+// CreateSequenceConverters generates the converters of the generic types, see
+// [ESType]. A sequence is decoded from any JS iterable, by Array.from, and a
+// record from the own enumerable properties of an object. The elements, keys,
+// and values are converted by the converters of the type parameters. Like the
+// other goja converters, the decoders panic with a TypeError for a value of
+// the wrong type.
+//
+// A FrozenArray is encoded as a frozen JS array, and the properties of a
+// record are created in the order of the sorted keys, as Go maps don't keep the
+// insertion order.
+func (t GojaTarget) CreateSequenceConverters() g.Generator {
+	return g.Raw(jen.Add(
+		t.decodeSequence(),
+		jen.Line().Line(),
+		t.decodeRecord(),
+		jen.Line().Line(),
+		t.toSequence(),
+		jen.Line().Line(),
+		t.toFrozenArray(),
+		jen.Line().Line(),
+		t.toRecord(),
+	))
+}
+
+// builtin returns the expression getting the function with the name of the
+// global JS object, e.g., Array.from.
+func (t GojaTarget) builtin(object string, name string) *jen.Statement {
+	return t.qual("AssertFunction").Call(
+		jen.Id("vm").Dot("Get").Call(jen.Lit(object)).
+			Dot("ToObject").Call(jen.Id("vm")).
+			Dot("Get").Call(jen.Lit(name)),
+	)
+}
+
+// gojaTypeError returns the statement panicking with a TypeError, which goja
+// throws in JS.
+func gojaTypeError(msg string) *jen.Statement {
+	return jen.Panic(jen.Id("vm").Dot("NewTypeError").Call(jen.Lit(msg)))
+}
+
+// gojaConverter returns the type of a converter, e.g., "func(g.Value) T".
+func gojaConverter(param jen.Code, result jen.Code) *jen.Statement {
+	return jen.Func().Params(param).Add(result)
+}
+
+func (t GojaTarget) decodeSequence() *jen.Statement {
+	value := t.qual("Value")
+	sliceType := jen.Index().Id("T")
+	panicOnErr := jen.If(jen.Err().Op("!=").Nil()).Block(jen.Panic(jen.Err()))
+	return jen.Comment("decodeSequence returns a decoder converting a JS iterable to a slice,").
+		Line().Comment("decoding each value using decode.").
+		Line().Func().Id("decodeSequence").Types(jen.Id("T").Any()).Params(
+		jen.Id("vm").Op("*").Add(t.qual("Runtime")),
+		jen.Id("decode").Add(gojaConverter(value.Clone(), jen.Id("T"))),
+	).Add(gojaConverter(value.Clone(), sliceType.Clone())).Block(
+		jen.Return(jen.Func().Params(jen.Id("v").Add(value.Clone())).Add(sliceType.Clone()).Block(
+			jen.List(jen.Id("obj"), jen.Id("ok")).Op(":=").Id("v").Assert(jen.Op("*").Add(t.qual("Object"))),
+			jen.If(jen.Op("!").Id("ok")).Block(gojaTypeError("The value is not iterable")),
+			jen.If(
+				jen.List(jen.Id("_"), jen.Id("ok")).Op(":=").Add(t.qual("AssertFunction")).Call(
+					jen.Id("obj").Dot("GetSymbol").Call(t.qual("SymIterator")),
+				),
+				jen.Op("!").Id("ok"),
+			).Block(gojaTypeError("The value is not iterable")),
+			jen.Comment("Array.from iterates the value using Symbol.iterator"),
+			jen.List(jen.Id("from"), jen.Id("_")).Op(":=").Add(t.builtin("Array", "from")),
+			jen.List(jen.Id("array"), jen.Err()).Op(":=").Id("from").Call(jen.Nil(), jen.Id("v")),
+			panicOnErr,
+			jen.Id("items").Op(":=").Id("array").Dot("ToObject").Call(jen.Id("vm")),
+			jen.Id("result").Op(":=").Make(
+				sliceType.Clone(),
+				jen.Id("items").Dot("Get").Call(jen.Lit("length")).Dot("ToInteger").Call(),
+			),
+			jen.For(jen.Id("i").Op(":=").Range().Id("result")).Block(
+				jen.Id("result").Index(jen.Id("i")).Op("=").Id("decode").Call(
+					jen.Id("items").Dot("Get").Call(jen.Qual("strconv", "Itoa").Call(jen.Id("i"))),
+				),
+			),
+			jen.Return(jen.Id("result")),
+		)),
+	)
+}
+
+func (t GojaTarget) decodeRecord() *jen.Statement {
+	value := t.qual("Value")
+	mapType := jen.Map(jen.Id("K")).Id("V")
+	return jen.Comment("decodeRecord returns a decoder converting the own enumerable properties of a").
+		Line().Comment("JS object to a map, decoding the keys and values using decodeKey and").
+		Line().Comment("decodeValue.").
+		Line().Func().Id("decodeRecord").Types(jen.Id("K").Comparable(), jen.Id("V").Any()).Params(
+		jen.Id("vm").Op("*").Add(t.qual("Runtime")),
+		jen.Id("decodeKey").Add(gojaConverter(value.Clone(), jen.Id("K"))),
+		jen.Id("decodeValue").Add(gojaConverter(value.Clone(), jen.Id("V"))),
+	).Add(gojaConverter(value.Clone(), mapType.Clone())).Block(
+		jen.Return(jen.Func().Params(jen.Id("v").Add(value.Clone())).Add(mapType.Clone()).Block(
+			jen.List(jen.Id("obj"), jen.Id("ok")).Op(":=").Id("v").Assert(jen.Op("*").Add(t.qual("Object"))),
+			jen.If(jen.Op("!").Id("ok")).Block(gojaTypeError("The value is not an object")),
+			jen.Id("result").Op(":=").Make(mapType.Clone()),
+			jen.Comment("Keys returns the names of the own enumerable properties"),
+			jen.For(jen.List(jen.Id("_"), jen.Id("name")).Op(":=").Range().Id("obj").Dot("Keys").Call()).Block(
+				jen.Id("key").Op(":=").Id("decodeKey").Call(jen.Id("vm").Dot("ToValue").Call(jen.Id("name"))),
+				jen.Id("result").Index(jen.Id("key")).Op("=").Id("decodeValue").Call(
+					jen.Id("obj").Dot("Get").Call(jen.Id("name")),
+				),
+			),
+			jen.Return(jen.Id("result")),
+		)),
+	)
+}
+
+func (t GojaTarget) toSequence() *jen.Statement {
+	value := t.qual("Value")
+	sliceType := jen.Index().Id("T")
+	return jen.Comment("toSequence returns an encoder converting a slice to a JS array, encoding each").
+		Line().Comment("value using encode.").
+		Line().Func().Id("toSequence").Types(jen.Id("T").Any()).Params(
+		jen.Id("vm").Op("*").Add(t.qual("Runtime")),
+		jen.Id("encode").Add(gojaConverter(jen.Id("T"), value.Clone())),
+	).Add(gojaConverter(sliceType.Clone(), value.Clone())).Block(
+		jen.Return(jen.Func().Params(jen.Id("values").Add(sliceType.Clone())).Add(value.Clone()).Block(
+			jen.Id("items").Op(":=").Make(jen.Index().Any(), jen.Len(jen.Id("values"))),
+			jen.For(jen.List(jen.Id("i"), jen.Id("v")).Op(":=").Range().Id("values")).Block(
+				jen.Id("items").Index(jen.Id("i")).Op("=").Id("encode").Call(jen.Id("v")),
+			),
+			jen.Return(jen.Id("vm").Dot("NewArray").Call(jen.Id("items").Op("..."))),
+		)),
+	)
+}
+
+func (t GojaTarget) toFrozenArray() *jen.Statement {
+	value := t.qual("Value")
+	sliceType := jen.Index().Id("T")
+	return jen.Comment("toFrozenArray returns an encoder converting a slice to a frozen JS array,").
+		Line().Comment("encoding each value using encode.").
+		Line().Func().Id("toFrozenArray").Types(jen.Id("T").Any()).Params(
+		jen.Id("vm").Op("*").Add(t.qual("Runtime")),
+		jen.Id("encode").Add(gojaConverter(jen.Id("T"), value.Clone())),
+	).Add(gojaConverter(sliceType.Clone(), value.Clone())).Block(
+		jen.Id("toArray").Op(":=").Id("toSequence").Call(jen.Id("vm"), jen.Id("encode")),
+		jen.Return(jen.Func().Params(jen.Id("values").Add(sliceType.Clone())).Add(value.Clone()).Block(
+			jen.List(jen.Id("freeze"), jen.Id("_")).Op(":=").Add(t.builtin("Object", "freeze")),
+			jen.List(jen.Id("array"), jen.Err()).Op(":=").Id("freeze").Call(
+				jen.Nil(), jen.Id("toArray").Call(jen.Id("values")),
+			),
+			jen.If(jen.Err().Op("!=").Nil()).Block(jen.Panic(jen.Err())),
+			jen.Return(jen.Id("array")),
+		)),
+	)
+}
+
+func (t GojaTarget) toRecord() *jen.Statement {
+	value := t.qual("Value")
+	mapType := jen.Map(jen.String()).Id("V")
+	return jen.Comment("toRecord returns an encoder converting a map to a JS object, encoding each").
+		Line().Comment("value using encode.").
+		Line().Func().Id("toRecord").Types(jen.Id("V").Any()).Params(
+		jen.Id("vm").Op("*").Add(t.qual("Runtime")),
+		jen.Id("encode").Add(gojaConverter(jen.Id("V"), value.Clone())),
+	).Add(gojaConverter(mapType.Clone(), value.Clone())).Block(
+		jen.Return(jen.Func().Params(jen.Id("values").Add(mapType.Clone())).Add(value.Clone()).Block(
+			jen.Id("obj").Op(":=").Id("vm").Dot("NewObject").Call(),
+			jen.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Qual("slices", "Sorted").Call(
+				jen.Qual("maps", "Keys").Call(jen.Id("values")),
+			)).Block(
+				jen.Id("obj").Dot("Set").Call(jen.Id("key"), jen.Id("encode").Call(jen.Id("values").Index(jen.Id("key")))),
+			),
+			jen.Return(jen.Id("obj")),
+		)),
+	)
+}
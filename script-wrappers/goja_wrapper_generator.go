@@ -9,6 +9,9 @@ func NewGojaWrapperModuleGenerator() ScriptWrapperModulesGenerator {
 	return ScriptWrapperModulesGenerator{
 		Specs:            specs,
 		PackagePath:      gojahost,
-		TargetGenerators: GojaTargetGenerators{},
+		TargetGenerators: EngineTargetGenerators{GojaEngine},
+		ErrorMappings:    DefaultErrorMappings,
+		// Keep in sync with the classes registered by hand in gojahost
+		HostClasses: []string{"EventTarget"},
 	}
 }
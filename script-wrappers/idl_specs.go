@@ -0,0 +1,287 @@
+package wrappers
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/gost-dom/webref/idl"
+)
+
+// partials contains the partial definitions of the specs used as extra specs
+// of a module. The webref package doesn't expose the members of partial
+// definitions, so these are extracted from the webref module. Add the spec to
+// the go:generate directive when adding an extra spec to a module.
+//
+//go:generate go run ./partials/extract.go -out partials cssom-view-1
+//go:embed partials/*.json
+var partials embed.FS
+
+// AddSpecs adds IDL specs extending the interfaces of the module, e.g.,
+// "cssom-view-1" adding scrollTop to Element in a partial interface, and the
+// GeometryUtils mixin.
+func (spec *WrapperGeneratorFileSpec) AddSpecs(names ...string) {
+	for _, name := range names {
+		if name != spec.Name && !slices.Contains(spec.ExtraSpecs, name) {
+			spec.ExtraSpecs = append(spec.ExtraSpecs, name)
+		}
+	}
+}
+
+// LoadIDL loads the IDL spec of the module, and merges the specs in
+// [WrapperGeneratorFileSpec.ExtraSpecs] into it: interfaces and mixins, and
+// the members of partial definitions of the interfaces of the module. The spec
+// each interface and merged member was loaded from is recorded, and can be
+// retrieved using [WrapperGeneratorFileSpec.SpecOf].
+//
+// Partial definitions in the spec of the module itself are not merged; in the
+// html spec, these are obsolete members.
+func (spec *WrapperGeneratorFileSpec) LoadIDL() (idl.Spec, error) {
+	data, err := loadSpec(spec.Name)
+	if err != nil {
+		return data, err
+	}
+	spec.origins = make(map[string]string)
+	for _, name := range spec.ExtraSpecs {
+		extra, err := loadSpec(name)
+		if err != nil {
+			return data, err
+		}
+		logger := spec.log().With("spec", name)
+		for _, intf := range mergeSpec(logger, &data, extra) {
+			spec.origins[intf] = name
+		}
+		definitions, err := loadPartials(name)
+		if err != nil {
+			return data, err
+		}
+		for _, member := range mergePartials(logger, &data, definitions) {
+			spec.origins[member] = name
+		}
+	}
+	return data, nil
+}
+
+// loadPartials loads the partial definitions of the IDL spec, by the name of
+// the interface or mixin extended.
+func loadPartials(name string) (map[string][]idl.Name, error) {
+	data, err := partials.ReadFile("partials/" + name + ".json")
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf(
+			"no partial definitions extracted from IDL spec %q; add it to the go:generate directive in idl_specs.go",
+			name,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var result map[string][]idl.Name
+	err = json.Unmarshal(data, &result)
+	return result, err
+}
+
+// idlCache contains the parsed IDL specs. Several modules, and several
+// generators in a run, load the same specs, e.g., the classes and the
+// wrappers of a module.
+var idlCache = struct {
+	sync.Mutex
+	specs map[string]*cachedSpec
+}{specs: make(map[string]*cachedSpec)}
+
+type cachedSpec struct {
+	once sync.Once
+	spec idl.Spec
+	err  error
+}
+
+// loadSpec loads the IDL spec by name, parsing each spec once. It is safe for
+// concurrent use. The maps of the returned spec are copies, so the spec can be
+// modified by [mergeSpec], but the interfaces are shared, and must not be
+// modified.
+func loadSpec(name string) (idl.Spec, error) {
+	idlCache.Lock()
+	c, ok := idlCache.specs[name]
+	if !ok {
+		c = new(cachedSpec)
+		idlCache.specs[name] = c
+	}
+	idlCache.Unlock()
+	c.once.Do(func() { c.spec, c.err = idl.Load(name) })
+	if c.err != nil {
+		return idl.Spec{}, c.err
+	}
+	result := c.spec
+	result.IdlNames = maps.Clone(result.IdlNames)
+	result.IdlExtendedNames = maps.Clone(result.IdlExtendedNames)
+	result.Interfaces = maps.Clone(result.Interfaces)
+	return result, nil
+}
+
+// preloadSpecs parses the IDL specs of the modules, and the specs they
+// extend, in parallel.
+func preloadSpecs(specs WrapperGeneratorsSpec) {
+	var names []string
+	for _, spec := range specs {
+		names = append(names, spec.Name)
+		names = append(names, spec.ExtraSpecs...)
+	}
+	var wg sync.WaitGroup
+	for _, name := range slices.Compact(slices.Sorted(slices.Values(names))) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Errors are returned when the spec is loaded
+			_, _ = loadSpec(name)
+		}()
+	}
+	wg.Wait()
+}
+
+// SpecOf returns the name of the IDL spec defining the member of the
+// interface or mixin, e.g., "cssom-view-1" for Element.scrollTop, and for all
+// members of GeometryUtils. Members merged from a partial definition are
+// defined by the spec of the partial definition, and other members by the
+// spec defining the interface.
+func (spec *WrapperGeneratorFileSpec) SpecOf(typeName, member string) string {
+	if origin, ok := spec.origins[methodKey(typeName, member)]; ok {
+		return origin
+	}
+	if origin, ok := spec.origins[typeName]; ok {
+		return origin
+	}
+	return spec.Name
+}
+
+// mergeSpec merges the interfaces and mixins defined in source into target,
+// as well as includes statements adding mixins to interfaces in target. An
+// interface defined in both specs is kept from target. It returns the names
+// of the interfaces and mixins added. Definitions that can't be merged are
+// logged to logger.
+//
+// Members of partial interfaces in source are not merged, as the webref
+// package does not expose these.
+func mergeSpec(logger *slog.Logger, target *idl.Spec, source idl.Spec) (added []string) {
+	for _, name := range slices.Sorted(maps.Keys(source.IdlNames)) {
+		if _, ok := target.IdlNames[name]; ok {
+			logger.Warn("Duplicate IDL definition", "interface", name)
+			continue
+		}
+		target.IdlNames[name] = source.IdlNames[name]
+		target.Interfaces[name] = source.Interfaces[name]
+		added = append(added, name)
+	}
+	for _, name := range slices.Sorted(maps.Keys(source.IdlExtendedNames)) {
+		intf, ok := target.Interfaces[name]
+		if !ok {
+			continue
+		}
+		for _, ext := range source.IdlExtendedNames[name] {
+			if ext.Type != "includes" || includes(intf, ext.Includes) {
+				continue
+			}
+			mixin, ok := target.Interfaces[ext.Includes]
+			if !ok {
+				logger.Warn("Included mixin not found", "interface", name, "mixin", ext.Includes)
+				continue
+			}
+			// Clip, as the slices are shared with the cached spec
+			intf.Includes = append(slices.Clip(intf.Includes), mixin)
+			if target.IdlExtendedNames == nil {
+				target.IdlExtendedNames = make(map[string]idl.ExtendedNames)
+			}
+			target.IdlExtendedNames[name] = append(slices.Clip(target.IdlExtendedNames[name]), ext)
+		}
+		target.Interfaces[name] = intf
+	}
+	return added
+}
+
+// mergePartials merges the members of the partial definitions into the
+// interfaces and mixins of target, and returns the merged members as
+// "Interface.member". Partial definitions of interfaces not in target are
+// skipped, as are members already defined by the interface.
+func mergePartials(
+	logger *slog.Logger,
+	target *idl.Spec,
+	definitions map[string][]idl.Name,
+) (added []string) {
+	for _, name := range slices.Sorted(maps.Keys(definitions)) {
+		intf, ok := target.Interfaces[name]
+		if !ok {
+			logger.Debug("Partial definition of unknown interface", "interface", name)
+			continue
+		}
+		// Clip, as the slices are shared with the cached spec
+		n := intf.InternalSpec
+		n.Members = slices.Clip(n.Members)
+		intf.Attributes = slices.Clip(intf.Attributes)
+		intf.Operations = slices.Clip(intf.Operations)
+		// Overloads of an operation of the partial definition are merged, and
+		// handled as other overloads.
+		defined := make(map[string]bool)
+		for _, m := range n.Members {
+			defined[m.Name] = true
+		}
+		merged := make(map[string]bool)
+		for _, def := range definitions[name] {
+			for _, member := range def.Members {
+				if member.Name != "" && defined[member.Name] && !merged[member.Name] {
+					logger.Warn("Duplicate partial member", "interface", name, "member", member.Name)
+					continue
+				}
+				n.Members = append(n.Members, member)
+				switch member.Type {
+				case "attribute":
+					typeName, nullable := idl.FindMemberAttributeType(member)
+					intf.Attributes = append(intf.Attributes, idl.Attribute{
+						InternalSpec: member,
+						Name:         member.Name,
+						Type:         idl.Type{Name: typeName, Nullable: nullable},
+						Readonly:     member.Readonly,
+					})
+				case "operation":
+					typeName, nullable := idl.FindMemberReturnType(member)
+					intf.Operations = append(intf.Operations, idl.Operation{
+						Name:         member.Name,
+						ReturnType:   idl.Type{Name: typeName, Nullable: nullable},
+						Arguments:    operationArguments(member),
+						Static:       member.Special == "static",
+						InternalSpec: member,
+					})
+				}
+				if member.Name != "" && !merged[member.Name] {
+					defined[member.Name] = true
+					merged[member.Name] = true
+					added = append(added, methodKey(name, member.Name))
+				}
+			}
+		}
+		intf.InternalSpec = n
+		target.IdlNames[name] = n
+		target.Interfaces[name] = intf
+	}
+	return added
+}
+
+// operationArguments returns the arguments of an operation of a partial
+// definition.
+func operationArguments(member idl.NameMember) []idl.Argument {
+	result := make([]idl.Argument, len(member.Arguments))
+	for i, a := range member.Arguments {
+		result[i].Name = a.Name
+		if t := a.IdlType.IdlType; t != nil {
+			result[i].Type = idl.Type{Name: t.IType.TypeName, Nullable: t.Nullable}
+		}
+	}
+	return result
+}
+
+func includes(intf idl.Interface, mixin string) bool {
+	return slices.ContainsFunc(intf.Includes, func(i idl.Interface) bool { return i.Name == mixin })
+}
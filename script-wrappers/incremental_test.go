@@ -0,0 +1,108 @@
+package wrappers_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gost-dom/code-gen/output"
+	wrappers "github.com/gost-dom/code-gen/script-wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Regenerating wrappers", func() {
+	var dir string
+	past := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// generate generates the V8 wrappers, with the specs changed by customize.
+	generate := func(cache bool, customize ...func(wrappers.WrapperGeneratorsSpec)) {
+		out := output.NewDir(dir, "wrappers-v8")
+		out.Cache = cache
+		gen := wrappers.NewScriptWrapperModulesGenerator()
+		for _, c := range customize {
+			c(gen.Specs)
+		}
+		Expect(gen.GenerateScriptWrappers(out)).To(Succeed())
+		Expect(out.Close()).To(Succeed())
+	}
+	read := func(name string) string {
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		Expect(err).ToNot(HaveOccurred())
+		return string(content)
+	}
+
+	// modified sets the modification time of all files to past, and returns
+	// a function returning the files modified since.
+	modified := func() func() []string {
+		entries, err := os.ReadDir(dir)
+		Expect(err).ToNot(HaveOccurred())
+		for _, e := range entries {
+			Expect(os.Chtimes(filepath.Join(dir, e.Name()), past, past)).To(Succeed())
+		}
+		return func() []string {
+			var result []string
+			entries, err := os.ReadDir(dir)
+			Expect(err).ToNot(HaveOccurred())
+			for _, e := range entries {
+				info, err := e.Info()
+				Expect(err).ToNot(HaveOccurred())
+				if !info.ModTime().Equal(past) {
+					result = append(result, e.Name())
+				}
+			}
+			return result
+		}
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	It("doesn't write files with unchanged content", func() {
+		generate(false)
+		changed := modified()
+		generate(false)
+		Expect(changed()).To(BeEmpty())
+	})
+
+	It("regenerates only modules with changed files when cached", func() {
+		generate(true)
+		original, err := os.ReadFile(filepath.Join(dir, "url_generated.go"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.WriteFile(filepath.Join(dir, "url_generated.go"), []byte("package v8host\n"), 0666)).
+			To(Succeed())
+		changed := modified()
+		generate(true)
+		Expect(changed()).To(ConsistOf("url_generated.go"))
+		Expect(os.ReadFile(filepath.Join(dir, "url_generated.go"))).To(Equal(original))
+	})
+
+	// The cache key of a module is a hash of the customizations of its types,
+	// members, and arguments, formatted without the pointers between them.
+	It("regenerates a module when a member customization changes", func() {
+		generate(true)
+		original := read("url_generated.go")
+		changed := modified()
+		generate(true, func(specs wrappers.WrapperGeneratorsSpec) {
+			specs.Module("url").Type("URL").Method("toJSON").SetNotImplemented()
+		})
+		Expect(changed()).To(ConsistOf(
+			"url_generated.go", "not_implemented_generated.go", ".wrappers-v8.cache",
+		))
+		Expect(read("url_generated.go")).ToNot(Equal(original))
+	})
+
+	It("regenerates a module when an argument customization changes", func() {
+		generate(true)
+		original := read("console_generated.go")
+		changed := modified()
+		generate(true, func(specs wrappers.WrapperGeneratorsSpec) {
+			specs.Module("console").Type("console").Method("count").Argument("label").
+				HasDefaultValue("defaultCountLabel")
+		})
+		Expect(changed()).To(ConsistOf("console_generated.go", ".wrappers-v8.cache"))
+		Expect(read("console_generated.go")).To(ContainSubstring("defaultCountLabel"))
+		Expect(read("console_generated.go")).ToNot(Equal(original))
+	})
+})
@@ -0,0 +1,65 @@
+package wrappers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"runtime/debug"
+	"slices"
+	"sync"
+)
+
+// generatorVersion identifies the code generator, and the IDL data, as the
+// version of the webref module, and a hash of the executable. The executable
+// changes with any change to the generators, including uncommitted changes.
+var generatorVersion = sync.OnceValue(func() string {
+	h := sha256.New()
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range info.Deps {
+			if dep.Path == "github.com/gost-dom/webref" {
+				fmt.Fprintf(h, "webref %s %s\n", dep.Version, dep.Sum)
+			}
+		}
+	}
+	if exe, err := os.Executable(); err == nil {
+		if f, err := os.Open(exe); err == nil {
+			defer f.Close()
+			_, _ = io.Copy(h, f)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+})
+
+// moduleKey returns the key of the inputs of the wrappers of a module: the
+// version of the generator, the target, and the customizations of the module.
+// If the key is unchanged, so are the generated files.
+func (gen ScriptWrapperModulesGenerator) moduleKey(spec *WrapperGeneratorFileSpec) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "generator %s\n", generatorVersion())
+	fmt.Fprintf(h, "package %s\n", gen.PackagePath)
+	fmt.Fprintf(h, "target %T %+v\n", gen.TargetGenerators, gen.TargetGenerators)
+	fmt.Fprintf(h, "errors %+v\n", gen.ErrorMappings)
+	fmt.Fprintf(h, "module %s %t %q\n", spec.Name, spec.MultipleFiles, spec.ExtraSpecs)
+	for _, name := range slices.Sorted(maps.Keys(spec.Types)) {
+		t := *spec.Types[name]
+		customization := t.Customization
+		// The module is hashed above; the pointer would make the key differ
+		// for every run.
+		t.DomSpec = nil
+		t.Customization = nil
+		fmt.Fprintf(h, "type %+v\n", t)
+		for _, member := range slices.Sorted(maps.Keys(customization)) {
+			m := *customization[member]
+			arguments := m.Arguments
+			m.Arguments = nil
+			fmt.Fprintf(h, "member %s %+v\n", member, m)
+			for _, arg := range slices.Sorted(maps.Keys(arguments)) {
+				fmt.Fprintf(h, "argument %s %+v\n", arg, *arguments[arg])
+			}
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
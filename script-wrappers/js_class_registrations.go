@@ -0,0 +1,122 @@
+package wrappers
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// JSClass is a class to register with the script host, and the class it
+// inherits from.
+type JSClass struct {
+	Data ESConstructorData
+	// Module is the name of the module wrapping the class, e.g., "dom".
+	Module string
+}
+
+func (c JSClass) Name() string        { return c.Data.Spec.TypeName }
+func (c JSClass) Inheritance() string { return c.Data.Inheritance }
+
+// CreateJSClasses creates the classes from all modules that must be registered
+// with the script host, i.e., all wrapped interfaces, except namespaces,
+// mixins, and types with [ESClassWrapper.SkipPrototypeRegistration] set.
+func CreateJSClasses(modules []ModuleData) []JSClass {
+	var result []JSClass
+	for _, module := range modules {
+		for _, d := range module.Types {
+			if !d.Spec.SkipPrototypeRegistration && !d.Namespace && !d.Mixin {
+				result = append(result, JSClass{d, module.Spec.Name})
+			}
+		}
+	}
+	return result
+}
+
+// SortJSClasses returns the classes in the order they must be registered,
+// i.e., a class comes after the class it inherits from. Classes without a
+// dependency between them are sorted by name, making the order deterministic.
+//
+// The parent of a class must either be one of the classes, or one of the
+// hostClasses, classes implemented by hand in the script host. Types with
+// [ESClassWrapper.SkipPrototypeRegistration] set are also registered by the
+// script host, so the specs are searched for these. An error is returned if a
+// parent is not found, if a class is wrapped in multiple modules, or if the
+// inheritance graph has a cycle.
+func SortJSClasses(
+	specs WrapperGeneratorsSpec,
+	classes []JSClass,
+	hostClasses []string,
+) ([]JSClass, error) {
+	external := make(map[string]bool)
+	for _, name := range hostClasses {
+		external[name] = true
+	}
+	for _, spec := range specs {
+		for _, t := range spec.Types {
+			if t.SkipPrototypeRegistration {
+				external[t.TypeName] = true
+			}
+		}
+	}
+	byName := make(map[string]JSClass, len(classes))
+	var errs []error
+	for _, c := range classes {
+		if prev, ok := byName[c.Name()]; ok {
+			errs = append(errs, fmt.Errorf(
+				"wrappers: %s is wrapped in both modules %s and %s", c.Name(), prev.Module, c.Module,
+			))
+			continue
+		}
+		byName[c.Name()] = c
+	}
+	for _, c := range classes {
+		parent := c.Inheritance()
+		if _, ok := byName[parent]; parent != "" && !ok && !external[parent] {
+			errs = append(errs, fmt.Errorf(
+				"wrappers: %s inherits from %s, which is not wrapped", c.Name(), parent,
+			))
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int, len(classes))
+	result := make([]JSClass, 0, len(classes))
+	var visit func(c JSClass, path []string) error
+	visit = func(c JSClass, path []string) error {
+		switch state[c.Name()] {
+		case visited:
+			return nil
+		case visiting:
+			start := slices.Index(path, c.Name())
+			cycle := append(slices.Clone(path[start:]), c.Name())
+			return fmt.Errorf("wrappers: inheritance cycle: %s", strings.Join(cycle, " -> "))
+		}
+		state[c.Name()] = visiting
+		if parent, ok := byName[c.Inheritance()]; ok {
+			if err := visit(parent, append(path, c.Name())); err != nil {
+				return err
+			}
+		}
+		state[c.Name()] = visited
+		result = append(result, c)
+		return nil
+	}
+	sorted := slices.SortedFunc(maps.Values(byName), func(x, y JSClass) int {
+		return cmp.Compare(x.Name(), y.Name())
+	})
+	for _, c := range sorted {
+		if err := visit(c, nil); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
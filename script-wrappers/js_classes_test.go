@@ -0,0 +1,86 @@
+package wrappers_test
+
+import (
+	wrappers "github.com/gost-dom/code-gen/script-wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// jsClass creates a class named name, wrapped in module, inheriting from
+// parent.
+func jsClass(module, name, parent string) wrappers.JSClass {
+	return wrappers.JSClass{
+		Data: wrappers.ESConstructorData{
+			Spec:        &wrappers.ESClassWrapper{TypeName: name},
+			Inheritance: parent,
+		},
+		Module: module,
+	}
+}
+
+var _ = Describe("Sorting JS classes", func() {
+	hostClasses := []string{"EventTarget"}
+
+	names := func(classes []wrappers.JSClass) (res []string) {
+		for _, c := range classes {
+			res = append(res, c.Name())
+		}
+		return
+	}
+
+	It("registers a class after the class it inherits from", func() {
+		sorted, err := wrappers.SortJSClasses(wrappers.WrapperGeneratorsSpec{}, []wrappers.JSClass{
+			jsClass("dom", "Element", "Node"),
+			jsClass("dom", "Attr", "Node"),
+			jsClass("dom", "Node", "EventTarget"),
+			jsClass("url", "URL", ""),
+		}, hostClasses)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(names(sorted)).To(Equal([]string{"Node", "Attr", "Element", "URL"}))
+	})
+
+	It("accepts a parent registered by the script host", func() {
+		specs := wrappers.NewWrapperGeneratorsSpec()
+		specs.Module("dom").Type("Node").SkipPrototypeRegistration = true
+		sorted, err := wrappers.SortJSClasses(specs, []wrappers.JSClass{
+			jsClass("dom", "Element", "Node"),
+		}, hostClasses)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(names(sorted)).To(Equal([]string{"Element"}))
+	})
+
+	DescribeTable("invalid classes",
+		func(classes []wrappers.JSClass, message string) {
+			_, err := wrappers.SortJSClasses(wrappers.WrapperGeneratorsSpec{}, classes, hostClasses)
+			Expect(err).To(MatchError(message))
+		},
+		Entry("missing parent",
+			[]wrappers.JSClass{jsClass("dom", "Element", "Node")},
+			"wrappers: Element inherits from Node, which is not wrapped",
+		),
+		Entry("duplicate",
+			[]wrappers.JSClass{
+				jsClass("dom", "Node", "EventTarget"),
+				jsClass("html", "Node", "EventTarget"),
+			},
+			"wrappers: Node is wrapped in both modules dom and html",
+		),
+		Entry("cycle",
+			[]wrappers.JSClass{
+				jsClass("dom", "A", "B"),
+				jsClass("dom", "B", "C"),
+				jsClass("dom", "C", "A"),
+			},
+			"wrappers: inheritance cycle: A -> B -> C -> A",
+		),
+		Entry("all errors before sorting",
+			[]wrappers.JSClass{
+				jsClass("dom", "Element", "Node"),
+				jsClass("dom", "URL", ""),
+				jsClass("url", "URL", ""),
+			},
+			"wrappers: URL is wrapped in both modules dom and url\n"+
+				"wrappers: Element inherits from Node, which is not wrapped",
+		),
+	)
+})
@@ -0,0 +1,107 @@
+package wrappers
+
+import "github.com/gost-dom/webref/idl"
+
+// isJSONType returns whether the IDL type is a JSON type, i.e., the type of an
+// attribute included in the result of a default toJSON operation.
+//
+// The IDL data doesn't contain the type named by a typedef, so typedefs are
+// not JSON types. Dictionaries and interfaces not defined in the spec aren't
+// either.
+//
+// See also: https://webidl.spec.whatwg.org/#dfn-json-types
+func isJSONType(spec idl.Spec, t idl.IdlType) bool {
+	return jsonTypes{spec, make(map[string]bool)}.isJSONType(t)
+}
+
+type jsonTypes struct {
+	spec idl.Spec
+	// visiting contains the dictionaries being checked. A dictionary with a
+	// member of its own type, e.g., a sequence of children, is a JSON type if
+	// its other members are.
+	visiting map[string]bool
+}
+
+func (j jsonTypes) isJSONType(t idl.IdlType) bool {
+	// Nullable and annotated types are represented by the inner type, with
+	// the nullable flag and extended attributes set.
+	params := idlTypeList(t.IType)
+	switch t.Generic {
+	case "sequence", "FrozenArray":
+		return len(params) == 1 && j.isJSONType(params[0])
+	case "record":
+		return len(params) == 2 && j.isJSONType(params[1])
+	case "":
+	default:
+		return false
+	}
+	if t.Union {
+		for _, member := range params {
+			if !j.isJSONType(member) {
+				return false
+			}
+		}
+		return len(params) > 0
+	}
+	name := t.IType.TypeName
+	if name == "object" || isPrimitiveType(name) {
+		return true
+	}
+	switch j.spec.IdlNames[name].Type {
+	case "dictionary":
+		return j.isJSONDictionary(name)
+	case "interface":
+		return j.hasToJSON(name)
+	}
+	return false
+}
+
+// isJSONDictionary returns whether the types of all members of the dictionary,
+// and the dictionaries it inherits from, are JSON types.
+func (j jsonTypes) isJSONDictionary(name string) bool {
+	if j.visiting[name] {
+		return true
+	}
+	j.visiting[name] = true
+	defer delete(j.visiting, name)
+	for name != "" {
+		dict, ok := j.spec.IdlNames[name]
+		if !ok || dict.Type != "dictionary" {
+			return false
+		}
+		for _, member := range dict.Members {
+			if t := member.IdlType.IdlType; t == nil || !j.isJSONType(*t) {
+				return false
+			}
+		}
+		name = dict.Inheritance
+	}
+	return true
+}
+
+// hasToJSON returns whether the interface, or an interface it inherits from,
+// declares a toJSON operation.
+func (j jsonTypes) hasToJSON(name string) bool {
+	for name != "" {
+		intf, ok := j.spec.Interfaces[name]
+		if !ok {
+			return false
+		}
+		for member := range members(intf, nil) {
+			if member.Type == "operation" && member.Name == "toJSON" && member.Special != "static" {
+				return true
+			}
+		}
+		name = intf.InternalSpec.Inheritance
+	}
+	return false
+}
+
+// idlTypeList returns the types of a union, or the type parameters of a
+// generic type.
+func idlTypeList(t idl.IdlTypes) []idl.IdlType {
+	if len(t.Types) == 0 && t.IdlType != nil {
+		return []idl.IdlType{*t.IdlType}
+	}
+	return t.Types
+}
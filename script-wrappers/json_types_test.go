@@ -0,0 +1,118 @@
+package wrappers_test
+
+import (
+	"bytes"
+	"encoding/json"
+
+	wrappers "github.com/gost-dom/code-gen/script-wrappers"
+	"github.com/gost-dom/webref/idl"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// The helpers below create the JSON representation of IDL types, as parsed
+// by webidl2.
+
+type idlType = map[string]any
+
+func namedType(name string) idlType {
+	return idlType{"type": nil, "generic": "", "nullable": false, "union": false, "idlType": name}
+}
+
+func nullableType(t idlType) idlType {
+	t["nullable"] = true
+	return t
+}
+
+func genericType(generic string, params ...idlType) idlType {
+	return idlType{
+		"type": nil, "generic": generic, "nullable": false, "union": false, "idlType": params,
+	}
+}
+
+func unionType(types ...idlType) idlType {
+	return idlType{"type": nil, "generic": "", "nullable": false, "union": true, "idlType": types}
+}
+
+func member(memberType string, name string, t idlType) map[string]any {
+	return map[string]any{"type": memberType, "name": name, "idlType": t, "extAttrs": []any{}}
+}
+
+func definition(
+	defType string,
+	name string,
+	parent string,
+	members ...map[string]any,
+) map[string]any {
+	return map[string]any{"type": defType, "name": name, "inheritance": parent, "members": members}
+}
+
+// jsonTypesSpec returns a spec with the interface Example, with a default
+// toJSON operation, and an attribute, value, of the type t.
+func jsonTypesSpec(t idlType) idl.Spec {
+	t["type"] = "attribute-type"
+	toJSON := member("operation", "toJSON", namedType("object"))
+	toJSON["extAttrs"] = []any{map[string]any{"type": "extended-attribute", "name": "Default"}}
+	names := map[string]any{}
+	for _, d := range []map[string]any{
+		definition("interface", "Example", "", toJSON, member("attribute", "value", t)),
+		definition("interface", "Node", ""),
+		definition("interface", "Point", "", member("operation", "toJSON", namedType("object"))),
+		definition("interface", "Point3D", "Point"),
+		definition("dictionary", "Options", "", member("field", "name", namedType("DOMString"))),
+		definition("dictionary", "DerivedOptions", "Options",
+			member("field", "count", namedType("long"))),
+		definition("dictionary", "NodeOptions", "", member("field", "node", namedType("Node"))),
+		definition("dictionary", "DerivedNodeOptions", "NodeOptions"),
+		definition("dictionary", "Tree", "",
+			member("field", "children", genericType("sequence", namedType("Tree")))),
+	} {
+		names[d["name"].(string)] = d
+	}
+	data, err := json.Marshal(map[string]any{
+		"idlParsed": map[string]any{"idlNames": names, "idlExtendedNames": map[string]any{}},
+	})
+	Expect(err).ToNot(HaveOccurred())
+	spec, err := idl.ParseIdlJsonReader(bytes.NewReader(data))
+	Expect(err).ToNot(HaveOccurred())
+	return spec
+}
+
+var _ = Describe("Default toJSON operations", func() {
+	DescribeTable("include attributes of JSON types",
+		func(t idlType, isJSONType bool) {
+			attributes := wrappers.DefaultJSONAttributes(jsonTypesSpec(t), "Example")
+			if isJSONType {
+				Expect(attributes).To(Equal([]string{"value"}))
+			} else {
+				Expect(attributes).To(BeEmpty())
+			}
+		},
+		Entry("string", namedType("DOMString"), true),
+		Entry("numeric", namedType("unrestricted double"), true),
+		Entry("boolean", namedType("boolean"), true),
+		Entry("object", namedType("object"), true),
+		Entry("any", namedType("any"), false),
+		Entry("nullable string", nullableType(namedType("USVString")), true),
+		Entry("nullable interface", nullableType(namedType("Node")), false),
+		Entry("sequence of strings", genericType("sequence", namedType("DOMString")), true),
+		Entry("sequence of interfaces", genericType("sequence", namedType("Node")), false),
+		Entry("frozen array of numbers", genericType("FrozenArray", namedType("long")), true),
+		Entry("record of numbers",
+			genericType("record", namedType("DOMString"), namedType("long")), true),
+		Entry("record of interfaces",
+			genericType("record", namedType("DOMString"), namedType("Node")), false),
+		Entry("promise", genericType("Promise", namedType("long")), false),
+		Entry("union of JSON types", unionType(namedType("long"), namedType("DOMString")), true),
+		Entry("union with an interface", unionType(namedType("long"), namedType("Node")), false),
+		Entry("dictionary of JSON types", namedType("Options"), true),
+		Entry("inherited dictionary of JSON types", namedType("DerivedOptions"), true),
+		Entry("dictionary with an interface", namedType("NodeOptions"), false),
+		Entry("inherited dictionary with an interface", namedType("DerivedNodeOptions"), false),
+		Entry("recursive dictionary", namedType("Tree"), true),
+		Entry("interface without toJSON", namedType("Node"), false),
+		Entry("interface with toJSON", namedType("Point"), true),
+		Entry("interface inheriting toJSON", namedType("Point3D"), true),
+		Entry("undefined type", namedType("Unknown"), false),
+	)
+})
@@ -0,0 +1,51 @@
+package wrappers_test
+
+import (
+	"github.com/gost-dom/code-gen/output"
+	wrappers "github.com/gost-dom/code-gen/script-wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("V8 mixin wrappers", func() {
+	var files output.Memory
+
+	BeforeEach(func() {
+		files = output.Memory{}
+		Expect(wrappers.NewScriptWrapperModulesGenerator().GenerateScriptWrappers(files)).
+			To(Succeed())
+	})
+
+	It("embed the node wrapper base when included by Node types only", func() {
+		Expect(string(files["parent_node_generated.go"])).
+			To(ContainSubstring("nodeV8WrapperBase[dom.ParentNode]"))
+	})
+
+	It("embed the base of other objects when included by other types", func() {
+		Expect(string(files["window_or_worker_global_scope_generated.go"])).
+			To(ContainSubstring("handleReffedObject[html.WindowOrWorkerGlobalScope]"))
+		Expect(string(files["global_event_handlers_generated.go"])).
+			To(ContainSubstring("handleReffedObject["))
+	})
+	It("check the interface including the mixin in the brand check", func() {
+		parentNode := string(files["parent_node_generated.go"])
+		Expect(parentNode).To(ContainSubstring(`case "Element":
+		_, ok := value.(dom.Element)`))
+		Expect(parentNode).To(ContainSubstring(`if !ok || !n.isIncluder(instance) {`))
+	})
+})
+
+var _ = Describe("V8 brand checks", func() {
+	It("return the error looking up the wrapped value, and throw Illegal invocation for other types", func() {
+		files := output.Memory{}
+		Expect(wrappers.NewScriptWrapperModulesGenerator().GenerateScriptWrappers(files)).
+			To(Succeed())
+		Expect(string(files["node_generated.go"])).To(ContainSubstring(`wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Node)
+	if !ok {
+		return nil, v8.NewTypeError(n.scriptHost.iso, "Node.`))
+	})
+})
@@ -0,0 +1,101 @@
+package wrappers
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/dave/jennifer/jen"
+	g "github.com/gost-dom/generators"
+)
+
+// NotImplementedMember is a member of a wrapped interface marked as not
+// implemented, see [ESMethodWrapper.SetNotImplemented]. Member is the name of
+// the wrapper function, e.g., "setHref" for the setter of the href attribute.
+type NotImplementedMember struct {
+	Interface string
+	Member    string
+}
+
+// CollectNotImplementedMembers returns the members of all modules with
+// generated wrapper functions that are not implemented, sorted by interface
+// and member name. Members of mixins are returned once, for the mixin.
+func CollectNotImplementedMembers(modules []ModuleData) []NotImplementedMember {
+	var result []NotImplementedMember
+	for _, module := range modules {
+		for _, d := range module.Types {
+			if c := d.Constructor; c != nil && c.NotImplemented {
+				result = append(result, NotImplementedMember{d.Name(), c.Name})
+			}
+			for op := range d.WrapperFunctionsToGenerate() {
+				if op.NotImplemented {
+					result = append(result, NotImplementedMember{d.Name(), op.Name})
+				}
+			}
+		}
+	}
+	slices.SortFunc(result, func(x, y NotImplementedMember) int {
+		return cmp.Or(cmp.Compare(x.Interface, y.Interface), cmp.Compare(x.Member, y.Member))
+	})
+	return slices.Compact(result)
+}
+
+// CreateNotImplementedRegistry generates the table of all members that are not
+// implemented, and the notImplemented function used by the wrapper functions
+// of these. The function calls the OnNotImplemented hook, allowing embedding
+// code to log or count which missing APIs are used by scripts, and returns a
+// NotImplementedError.
+//
+// The generated code doesn't depend on the script engine.
+func CreateNotImplementedRegistry(members []NotImplementedMember) g.Generator {
+	memberType := jen.Id("NotImplementedMember")
+	entries := make([]jen.Code, len(members))
+	for i, m := range members {
+		entries[i] = jen.Values(jen.Lit(m.Interface), jen.Lit(m.Member))
+	}
+	e := jen.Id("e")
+	m := jen.Id("m")
+	return g.Raw(jen.Comment("NotImplementedMember identifies a member of a wrapped interface that is").Line().
+		Comment("not implemented.").Line().
+		Type().Add(memberType.Clone()).Struct(
+		jen.Id("Interface").String(),
+		jen.Id("Member").String(),
+	).Line().Line().
+		Comment("NotImplementedMembers contains all members that are not implemented, and").Line().
+		Comment("throw an error when called from JavaScript.").Line().
+		Var().Id("NotImplementedMembers").Op("=").Index().Add(memberType.Clone()).
+		ValuesFunc(func(group *jen.Group) {
+			for _, entry := range entries {
+				group.Line().Add(entry)
+			}
+			if len(entries) > 0 {
+				group.Line()
+			}
+		}).Line().Line().
+		Comment("NotImplementedError is the error returned when JavaScript calls a member").Line().
+		Comment("that is not implemented.").Line().
+		Type().Id("NotImplementedError").Struct(memberType.Clone()).Line().Line().
+		Func().Params(e.Clone().Id("NotImplementedError")).Id("Error").Params().String().Block(
+		jen.Return(jen.Qual("fmt", "Sprintf").Call(
+			jen.Lit("%s.%s: Not implemented. Create an issue: %s"),
+			e.Clone().Dot("Interface"),
+			e.Clone().Dot("Member"),
+			jen.Lit(ISSUE_URL),
+		)),
+	).Line().Line().
+		Comment("OnNotImplemented is called when JavaScript calls a member that is not").Line().
+		Comment("implemented, e.g., to log or count which missing APIs scripts use. Set it").Line().
+		Comment("before running scripts.").Line().
+		Var().Id("OnNotImplemented").Func().Params(memberType.Clone()).Line().Line().
+		Comment("notImplemented reports the call to a member that is not implemented to").Line().
+		Comment("OnNotImplemented, and returns the error to throw.").Line().
+		Func().Id("notImplemented").Params(
+		jen.Id("intf").String(),
+		jen.Id("member").String(),
+	).Error().Block(
+		m.Clone().Op(":=").Add(memberType.Clone()).Values(jen.Id("intf"), jen.Id("member")),
+		jen.If(jen.Id("OnNotImplemented").Op("!=").Nil()).Block(
+			jen.Id("OnNotImplemented").Call(m.Clone()),
+		),
+		jen.Return(jen.Id("NotImplementedError").Values(m.Clone())),
+	))
+}
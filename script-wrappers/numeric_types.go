@@ -0,0 +1,258 @@
+package wrappers
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	g "github.com/gost-dom/generators"
+	"github.com/gost-dom/webref/idl"
+)
+
+// NumericType describes one of the WebIDL numeric types. Arguments of numeric
+// types are decoded by functions generated once per script engine, see
+// [CreateNumericConversions], implementing the conversion rules of the WebIDL
+// specification.
+//
+// See also: https://webidl.spec.whatwg.org/#es-numeric-types
+type NumericType struct {
+	// Name is the IDL name of the type, e.g., "unsigned long".
+	Name string
+	// BitLength is the size of an integer type. It is 0 for floating point
+	// types.
+	BitLength int
+	Signed    bool
+	// Single is set for the 32 bit floating point types, float and
+	// unrestricted float.
+	Single       bool
+	Unrestricted bool
+	// GoType is the Go type the value is decoded to.
+	GoType string
+}
+
+func (t NumericType) IsInteger() bool { return t.BitLength > 0 }
+
+var numericTypes = []NumericType{
+	{Name: "byte", BitLength: 8, Signed: true, GoType: "int"},
+	{Name: "octet", BitLength: 8, GoType: "int"},
+	{Name: "short", BitLength: 16, Signed: true, GoType: "int"},
+	{Name: "unsigned short", BitLength: 16, GoType: "int"},
+	{Name: "long", BitLength: 32, Signed: true, GoType: "int"},
+	{Name: "unsigned long", BitLength: 32, GoType: "int"},
+	{Name: "long long", BitLength: 64, Signed: true, GoType: "int64"},
+	{Name: "unsigned long long", BitLength: 64, GoType: "uint64"},
+	{Name: "float", Single: true, GoType: "float32"},
+	{Name: "unrestricted float", Single: true, Unrestricted: true, GoType: "float32"},
+	{Name: "double", GoType: "float64"},
+	{Name: "unrestricted double", Unrestricted: true, GoType: "float64"},
+}
+
+// FindNumericType returns the numeric type with the name. The name can be
+// either the IDL name, e.g., "unsigned long", or the Go name, e.g.,
+// "UnsignedLong".
+func FindNumericType(name string) (NumericType, bool) {
+	goName := idlNameToGoName(name)
+	for _, t := range numericTypes {
+		if idlNameToGoName(t.Name) == goName {
+			return t, true
+		}
+	}
+	return NumericType{}, false
+}
+
+// IntegerConversion is the conversion mode of an integer type, controlled by
+// the extended attributes, [EnforceRange] and [Clamp].
+type IntegerConversion string
+
+const (
+	IntegerConversionModulo       IntegerConversion = ""
+	IntegerConversionEnforceRange IntegerConversion = "EnforceRange"
+	IntegerConversionClamp        IntegerConversion = "Clamp"
+)
+
+// NewIntegerConversion returns the conversion mode specified by the extended
+// attributes. WebIDL places the attributes on either the argument, or on the
+// type, so all lists are searched.
+func NewIntegerConversion(attrs ...[]idl.ExtAttr) IntegerConversion {
+	for _, a := range attrs {
+		if hasExtAttr(a, "EnforceRange") {
+			return IntegerConversionEnforceRange
+		}
+		if hasExtAttr(a, "Clamp") {
+			return IntegerConversionClamp
+		}
+	}
+	return IntegerConversionModulo
+}
+
+// DecoderName returns the name of the generated function decoding a JS value
+// to the type, e.g., "decodeIDLUnsignedLongEnforceRange".
+func (t NumericType) DecoderName(c IntegerConversion) string {
+	if !t.IsInteger() {
+		c = IntegerConversionModulo
+	}
+	return fmt.Sprintf("decodeIDL%s%s", idlNameToGoName(t.Name), c)
+}
+
+// numericDecoder returns the generated decoder for a numeric type, or nil if
+// typeName isn't a numeric type. The hostArgs are passed to engines whose
+// decoders need access to the runtime.
+func numericDecoder(
+	typeName string,
+	c IntegerConversion,
+	hostArgs ...g.Generator,
+) g.Generator {
+	t, ok := FindNumericType(typeName)
+	if !ok {
+		return nil
+	}
+	return hostFunction(t.DecoderName(c), hostArgs)
+}
+
+// NumericDecoder describes one of the generated decoder functions for a
+// numeric type.
+type NumericDecoder struct {
+	Type       NumericType
+	Conversion IntegerConversion
+}
+
+func (d NumericDecoder) Name() string { return d.Type.DecoderName(d.Conversion) }
+
+// NumericDecoders returns all the decoders that are generated for each engine;
+// three per integer type, and one per floating point type.
+func NumericDecoders() []NumericDecoder {
+	var result []NumericDecoder
+	for _, t := range numericTypes {
+		if t.IsInteger() {
+			result = append(result,
+				NumericDecoder{t, IntegerConversionModulo},
+				NumericDecoder{t, IntegerConversionEnforceRange},
+				NumericDecoder{t, IntegerConversionClamp},
+			)
+		} else {
+			result = append(result, NumericDecoder{t, IntegerConversionModulo})
+		}
+	}
+	return result
+}
+
+// Convert generates a call to the engine independent conversion function for
+// the JS number x, returning a float64 and an error.
+func (d NumericDecoder) Convert(x g.Generator) g.Generator {
+	t := d.Type
+	if t.IsInteger() {
+		return g.NewValue("convertToInt").Call(
+			x,
+			g.Lit(t.Name),
+			g.Lit(t.BitLength),
+			g.Lit(t.Signed),
+			g.Lit(d.Conversion == IntegerConversionEnforceRange),
+			g.Lit(d.Conversion == IntegerConversionClamp),
+		)
+	}
+	return g.NewValue("convertToFloat").Call(
+		x, g.Lit(t.Name), g.Lit(t.Single), g.Lit(t.Unrestricted),
+	)
+}
+
+// CreateNumericConversions generates the engine independent functions,
+// convertToInt and convertToFloat, implementing the WebIDL conversion of a JS
+// number to an IDL numeric type. The engine specific decoders convert the JS
+// value to a number, and any error to a TypeError.
+func CreateNumericConversions() g.Generator {
+	return g.Raw(jen.Add(createConvertToInt()).Line().Line().Add(createConvertToFloat()))
+}
+
+func createConvertToInt() *jen.Statement {
+	x := jen.Id("x")
+	typeName := jen.Id("typeName")
+	bitLength := jen.Id("bitLength")
+	signed := jen.Id("signed")
+	lowerBound := jen.Id("lowerBound")
+	upperBound := jen.Id("upperBound")
+	m := jen.Id("m")
+	pow := func(exp jen.Code) *jen.Statement {
+		return jen.Qual("math", "Pow").Call(jen.Lit(2), exp)
+	}
+	isNotFinite := jen.Qual("math", "IsNaN").Call(x).
+		Op("||").Qual("math", "IsInf").Call(x, jen.Lit(0))
+	typeError := func(format string) *jen.Statement {
+		return jen.Return(jen.Lit(0), jen.Qual("fmt", "Errorf").Call(jen.Lit(format), typeName))
+	}
+	return jen.Comment("convertToInt implements the WebIDL ConvertToInt abstract operation, converting").
+		Line().Comment("the JS number x to an integer type of bitLength bits.").
+		Line().Comment("").
+		Line().Comment("See also: https://webidl.spec.whatwg.org/#abstract-opdef-converttoint").
+		Line().Func().Id("convertToInt").Params(
+		x.Clone().Float64(),
+		typeName.Clone().String(),
+		bitLength.Clone().Int(),
+		signed.Clone().Bool(),
+		jen.Id("enforceRange").Bool(),
+		jen.Id("clamp").Bool(),
+	).Params(jen.Float64(), jen.Error()).Block(
+		jen.Var().List(lowerBound, upperBound).Float64(),
+		jen.If(bitLength.Clone().Op("==").Lit(64)).Block(
+			upperBound.Clone().Op("=").Add(pow(jen.Lit(53))).Op("-").Lit(1),
+			jen.If(signed).Block(lowerBound.Clone().Op("=").Op("-").Add(upperBound)),
+		).Else().If(signed).Block(
+			lowerBound.Clone().Op("=").Op("-").Add(pow(jen.Float64().Call(bitLength.Clone().Op("-").Lit(1)))),
+			upperBound.Clone().Op("=").Add(pow(jen.Float64().Call(bitLength.Clone().Op("-").Lit(1)))).Op("-").Lit(1),
+		).Else().Block(
+			upperBound.Clone().Op("=").Add(pow(jen.Float64().Call(bitLength))).Op("-").Lit(1),
+		),
+		jen.If(jen.Id("enforceRange")).Block(
+			jen.If(isNotFinite.Clone()).Block(
+				typeError("Value is not a finite '%s'"),
+			),
+			x.Clone().Op("=").Qual("math", "Trunc").Call(x),
+			jen.If(x.Clone().Op("<").Add(lowerBound).Op("||").Add(x).Op(">").Add(upperBound)).Block(
+				typeError("Value is outside the '%s' value range"),
+			),
+			jen.Return(x, jen.Nil()),
+		),
+		jen.If(jen.Id("clamp").Op("&&").Op("!").Qual("math", "IsNaN").Call(x)).Block(
+			jen.Return(
+				jen.Qual("math", "RoundToEven").Call(
+					jen.Min(jen.Max(x, lowerBound), upperBound),
+				),
+				jen.Nil(),
+			),
+		),
+		jen.If(isNotFinite.Clone()).Block(jen.Return(jen.Lit(0), jen.Nil())),
+		m.Clone().Op(":=").Add(pow(jen.Float64().Call(bitLength))),
+		x.Clone().Op("=").Qual("math", "Mod").Call(jen.Qual("math", "Trunc").Call(x), m),
+		jen.If(x.Clone().Op("<").Lit(0)).Block(x.Clone().Op("+=").Add(m)),
+		jen.If(signed.Clone().Op("&&").Add(x).Op(">=").Add(m).Op("/").Lit(2)).Block(
+			x.Clone().Op("-=").Add(m),
+		),
+		jen.Return(x, jen.Nil()),
+	)
+}
+
+func createConvertToFloat() *jen.Statement {
+	x := jen.Id("x")
+	return jen.Comment("convertToFloat converts the JS number x to an IDL float or double. Single").
+		Line().Comment("precision values are rounded to the nearest float32.").
+		Line().Comment("").
+		Line().Comment("See also: https://webidl.spec.whatwg.org/#es-float").
+		Line().Func().Id("convertToFloat").Params(
+		x.Clone().Float64(),
+		jen.Id("typeName").String(),
+		jen.Id("single").Bool(),
+		jen.Id("unrestricted").Bool(),
+	).Params(jen.Float64(), jen.Error()).Block(
+		jen.If(jen.Id("single")).Block(
+			x.Clone().Op("=").Float64().Call(jen.Float32().Call(x)),
+		),
+		jen.If(
+			jen.Op("!").Id("unrestricted").Op("&&").Parens(
+				jen.Qual("math", "IsNaN").Call(x).Op("||").Qual("math", "IsInf").Call(x, jen.Lit(0)),
+			),
+		).Block(
+			jen.Return(jen.Lit(0), jen.Qual("fmt", "Errorf").Call(
+				jen.Lit("Value is not a finite '%s'"), jen.Id("typeName"),
+			)),
+		),
+		jen.Return(x, jen.Nil()),
+	)
+}
@@ -0,0 +1,39 @@
+package wrappers_test
+
+import (
+	"github.com/gost-dom/code-gen/output"
+	wrappers "github.com/gost-dom/code-gen/script-wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// The V8 dom module adds cssom-view-1, extending Element with a partial
+// interface.
+var _ = Describe("Partial interfaces of extra specs", func() {
+	It("installs the merged members on the prototype", func() {
+		files := output.Memory{}
+		Expect(wrappers.NewScriptWrapperModulesGenerator().GenerateScriptWrappers(files)).
+			To(Succeed())
+		element := string(files["element_generated.go"])
+		Expect(element).To(ContainSubstring(`prototypeTmpl.Set("getBoundingClientRect",`))
+		Expect(element).To(ContainSubstring(`prototypeTmpl.SetAccessorProperty("scrollTop",`))
+	})
+
+	It("reports the spec of the partial interface as the spec of the member", func() {
+		report, err := wrappers.CreateCoverageReport([]wrappers.CoverageTarget{
+			{Name: "v8", Specs: wrappers.NewScriptWrapperModulesGenerator().Specs},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		specs := make(map[string]string)
+		for _, intf := range report.Interfaces {
+			if intf.Name == "Element" {
+				for _, m := range intf.Members {
+					specs[m.Name] = m.Spec
+				}
+			}
+		}
+		Expect(specs).To(HaveKeyWithValue("scrollTop", "cssom-view-1"))
+		Expect(specs).To(HaveKeyWithValue("getBoundingClientRect", "cssom-view-1"))
+		Expect(specs).To(HaveKeyWithValue("getAttribute", "dom"))
+	})
+})
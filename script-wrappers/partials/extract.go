@@ -0,0 +1,79 @@
+//go:build ignore
+
+// Extract writes the partial definitions of interfaces and mixins in the IDL
+// specs given as arguments to <spec>.json in the folder given by -out. The specs are
+// read from the webref module, which doesn't expose the members of partial
+// definitions.
+//
+// Run go generate in the script-wrappers folder after updating webref.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	out := flag.String("out", ".", "Folder to write the files to")
+	flag.Parse()
+	if err := run(*out, flag.Args()); err != nil {
+		fmt.Fprintln(os.Stderr, "extract:", err)
+		os.Exit(1)
+	}
+}
+
+func run(outDir string, specs []string) error {
+	out, err := exec.Command(
+		"go", "list", "-m", "-f", "{{.Dir}}", "github.com/gost-dom/webref",
+	).Output()
+	if err != nil {
+		return fmt.Errorf("locating webref module: %w", err)
+	}
+	dir := filepath.Join(strings.TrimSpace(string(out)), "internal/specs/curated/idlparsed")
+	for _, name := range specs {
+		if err := extract(filepath.Join(dir, name+".json"), filepath.Join(outDir, name+".json")); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func extract(source, target string) error {
+	data, err := os.ReadFile(source)
+	if err != nil {
+		return err
+	}
+	var parsed struct {
+		IdlParsed struct {
+			IdlExtendedNames map[string][]json.RawMessage `json:"idlExtendedNames"`
+		} `json:"idlparsed"`
+	}
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return err
+	}
+	partials := make(map[string][]json.RawMessage)
+	for name, definitions := range parsed.IdlParsed.IdlExtendedNames {
+		for _, def := range definitions {
+			var header struct{ Partial bool }
+			if err := json.Unmarshal(def, &header); err != nil {
+				return err
+			}
+			if header.Partial {
+				partials[name] = append(partials[name], def)
+			}
+		}
+	}
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(partials); err != nil {
+		return err
+	}
+	return os.WriteFile(target, buf.Bytes(), 0644)
+}
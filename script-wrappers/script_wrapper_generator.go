@@ -2,15 +2,17 @@ package wrappers
 
 import (
 	"cmp"
-	"errors"
 	"fmt"
 	"io"
-	"os"
+	"log/slog"
+	"maps"
 	"regexp"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/dave/jennifer/jen"
+	"github.com/gost-dom/code-gen/output"
 	"github.com/gost-dom/generators"
 	g "github.com/gost-dom/generators"
 	"github.com/gost-dom/webref/idl"
@@ -34,6 +36,27 @@ type WrapperGeneratorFileSpec struct {
 	Name          string
 	MultipleFiles bool
 	Types         map[string]WrapperTypeSpec
+	// ExtraSpecs are the names of IDL specs extending the interfaces of the
+	// module with mixins. Use [WrapperGeneratorFileSpec.AddSpecs] to add.
+	ExtraSpecs []string
+	// Logger logs messages about the module, e.g., members that can't be
+	// wrapped. If nil, the default logger is used.
+	Logger *slog.Logger
+
+	origins map[string]string
+	// modules are the modules generated with this module. Types that are not
+	// defined by the IDL of the module are looked up in their IDL specs.
+	modules WrapperGeneratorsSpec
+}
+
+// log returns the logger of the module, adding the name of the module to the
+// messages.
+func (spec *WrapperGeneratorFileSpec) log() *slog.Logger {
+	logger := spec.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return logger.With("module", spec.Name)
 }
 
 func (spec WrapperGeneratorFileSpec) GetTypesSorted() []WrapperTypeSpec {
@@ -49,83 +72,210 @@ func (spec WrapperGeneratorFileSpec) GetTypesSorted() []WrapperTypeSpec {
 	return types
 }
 
+// ModuleData is the IDL of a module, and the data of the wrapped types, and of
+// the mixins with members merged into the wrapped interfaces, sorted by name.
+// The wrapper functions for the members of a mixin are generated once, and
+// shared by all including interfaces.
+type ModuleData struct {
+	Spec  *WrapperGeneratorFileSpec
+	IDL   idl.Spec
+	Types []ESConstructorData
+}
+
+// LoadModule loads the IDL of the module, and creates the data of each type
+// once, shared by all code generated from the module. The module isn't
+// modified, so the mixins aren't added to Types.
+func (spec *WrapperGeneratorFileSpec) LoadModule() (ModuleData, error) {
+	data, err := spec.LoadIDL()
+	if err != nil {
+		return ModuleData{}, err
+	}
+	var types []ESConstructorData
+	found := make(map[string]bool)
+	for _, t := range spec.GetTypesSorted() {
+		found[t.TypeName] = true
+	}
+	for _, t := range spec.GetTypesSorted() {
+		d := createData(data, t)
+		types = append(types, d)
+		for _, mixin := range d.Mixins {
+			if !found[mixin] {
+				found[mixin] = true
+				types = append(types, createData(data, spec.lookupType(mixin)))
+			}
+		}
+	}
+	slices.SortFunc(types, func(x, y ESConstructorData) int {
+		return cmp.Compare(x.Name(), y.Name())
+	})
+	return ModuleData{spec, data, types}, nil
+}
+
+// LoadModules loads all modules, sorted by name. See
+// [WrapperGeneratorFileSpec.LoadModule].
+func (g WrapperGeneratorsSpec) LoadModules() ([]ModuleData, error) {
+	result := make([]ModuleData, 0, len(g))
+	for _, name := range slices.Sorted(maps.Keys(g)) {
+		module, err := g[name].LoadModule()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, module)
+	}
+	return result, nil
+}
+
 func (spec WrapperGeneratorFileSpec) UseMultipleFiles() bool {
 	return spec.MultipleFiles == true
 }
 
 func (spec *WrapperGeneratorFileSpec) SetMultipleFiles(value bool) { spec.MultipleFiles = value }
 
+// SetLogger sets the logger of all modules.
+func (g WrapperGeneratorsSpec) SetLogger(logger *slog.Logger) {
+	for _, mod := range g {
+		mod.Logger = logger
+	}
+}
+
 func (g WrapperGeneratorsSpec) Module(spec string) *WrapperGeneratorFileSpec {
 	if mod, ok := g[spec]; ok {
 		return mod
 	}
 	mod := &WrapperGeneratorFileSpec{
-		Name:  spec,
-		Types: make(map[string]WrapperTypeSpec),
+		Name:    spec,
+		Types:   make(map[string]WrapperTypeSpec),
+		modules: g,
 	}
 	g[spec] = mod
 	return mod
 }
 
-func writeGenerator(writer io.Writer, packagePath string, generator g.Generator) error {
+// generatedHeader is the header comment of generated files.
+const generatedHeader = "This file is generated. Do not edit."
+
+func writeGenerator(
+	writer io.Writer,
+	packagePath string,
+	header string,
+	generator g.Generator,
+) error {
+	return renderFile(writer, packagePath, header, generator.Generate())
+}
+
+// renderFile renders the code as a file of the package, with the header
+// comment, and writes it to writer.
+func renderFile(writer io.Writer, packagePath string, header string, code jen.Code) error {
 	file := jen.NewFilePath(packagePath)
-	file.HeaderComment("This file is generated. Do not edit.")
+	file.HeaderComment(header)
 	// file.ImportName(dom, "browser")
 	file.ImportAlias(v8, "v8")
 	file.ImportAlias(gojaSrc, "g")
-	file.Add(generator.Generate())
+	file.Add(code)
 	return file.Render(writer)
 }
 
+// TargetGenerators generates the wrappers for a script engine. See
+// [EngineTarget] for engines where wrappers are plain Go functions, like goja.
 type TargetGenerators interface {
+	// CreateJSConstructorGenerator generates the wrapper of a class, mixin, or
+	// namespace.
 	CreateJSConstructorGenerator(data ESConstructorData) g.Generator
+	PackageGenerators
+}
+
+// PackageGenerators generates the code used by all wrappers in the package.
+type PackageGenerators interface {
+	// CreateErrorMapper generates the function converting errors from Go code
+	// to DOMExceptions, used by all wrappers in the package.
+	CreateErrorMapper(mappings []ErrorMapping) g.Generator
+	// CreateNumericDecoders generates the functions decoding JS values to IDL
+	// numeric types, used by all wrappers in the package.
+	CreateNumericDecoders() g.Generator
+	// CreateBufferSourceConverters generates the functions converting between
+	// JS buffer types, e.g., BufferSource, and Go []byte values, used by all
+	// wrappers in the package.
+	CreateBufferSourceConverters() g.Generator
+	// CreateSequenceConverters generates the functions converting between JS
+	// values and Go values of the generic types, e.g., sequences, used by all
+	// wrappers in the package.
+	CreateSequenceConverters() g.Generator
+	// CreateAsyncIterators generates the function converting a Go sequence to
+	// a JS async iterator, used by all wrappers in the package.
+	CreateAsyncIterators() g.Generator
+	// CreatePromises generates the functions converting the asynchronous
+	// results of Go functions to JS promises, used by all wrappers in the
+	// package.
+	CreatePromises() g.Generator
+	// CreateJSClassRegistrations generates the registration of all classes
+	// with the script host, in the order returned by [SortJSClasses].
+	CreateJSClassRegistrations(classes []JSClass) g.Generator
+	// CreateConverterStubs generates stubs of the missing converters in the
+	// manifest, see [ScriptWrapperModulesGenerator.WriteConverterStubs].
+	CreateConverterStubs(m ConverterManifest) g.Generator
 }
 
 type ScriptWrapperModulesGenerator struct {
 	Specs            WrapperGeneratorsSpec
 	PackagePath      string
 	TargetGenerators TargetGenerators
+	// ErrorMappings contains the error mappings that apply to all wrapped
+	// methods.
+	ErrorMappings []ErrorMapping
+	// HostClasses contains the names of the classes implemented by hand, and
+	// registered by the script host, e.g., EventTarget. Generated classes can
+	// inherit from these.
+	//
+	// The list isn't derived from the script host, and must be kept in sync
+	// with the classes the script host package in the browser repository
+	// registers by hand, e.g., using registerJSClass in v8host. A generated
+	// class inheriting from a class in neither list fails generation, see
+	// [SortJSClasses].
+	HostClasses []string
+	// OnFileGenerated is called with the name of each file written, and the
+	// time spent generating it, if set. It is called in the order the files
+	// are written.
+	OnFileGenerated func(name string, elapsed time.Duration)
+	// Logger logs the messages of the generators, with the module, interface,
+	// and member as attributes. If nil, the loggers of the modules are used.
+	Logger *slog.Logger
 }
 
-func (gen ScriptWrapperModulesGenerator) writeModule(
-	writer io.Writer,
-	spec *WrapperGeneratorFileSpec,
-) error {
-	data, err := idl.Load(spec.Name)
-	if err != nil {
-		return err
+// setLogger sets the logger of the generator on all modules.
+func (gen ScriptWrapperModulesGenerator) setLogger() {
+	if gen.Logger != nil {
+		gen.Specs.SetLogger(gen.Logger)
 	}
-	generators := g.StatementList()
-	for _, specType := range spec.GetTypesSorted() {
-		typeGenerationInformation := createData(data, specType)
-		generators.Append(
-			gen.TargetGenerators.CreateJSConstructorGenerator(typeGenerationInformation),
-		)
-		generators.Append(g.Line)
-	}
-	return writeGenerator(writer, gen.PackagePath, generators)
 }
 
-func (gen ScriptWrapperModulesGenerator) writeModuleTypes(
-	spec *WrapperGeneratorFileSpec,
-) error {
-	data, err := idl.Load(spec.Name)
-	if err != nil {
-		return err
+// moduleFiles returns the files of the wrappers of the module: a file for each
+// type if the module uses multiple files, or else a single file named after
+// the module.
+func (gen ScriptWrapperModulesGenerator) moduleFiles(module ModuleData) []generatedFile {
+	spec := module.Spec
+	if !spec.UseMultipleFiles() {
+		return []generatedFile{{
+			name: fmt.Sprintf("%s_generated.go", spec.Name),
+			create: func() g.Generator {
+				generators := g.StatementList()
+				for _, data := range module.Types {
+					generators.Append(gen.TargetGenerators.CreateJSConstructorGenerator(data))
+					generators.Append(g.Line)
+				}
+				return generators
+			},
+		}}
 	}
-	// generators := g.StatementList()
-	types := spec.GetTypesSorted()
-	errs := make([]error, len(types))
-	for i, specType := range types {
-		outputFileName := fmt.Sprintf("%s_generated.go", typeNameToFileName(specType.TypeName))
-		if writer, err := os.Create(outputFileName); err != nil {
-			errs[i] = err
-		} else {
-			typeGenerationInformation := createData(data, specType)
-			errs[i] = writeGenerator(writer, gen.PackagePath, gen.TargetGenerators.CreateJSConstructorGenerator(typeGenerationInformation))
+	files := make([]generatedFile, len(module.Types))
+	for i, data := range module.Types {
+		files[i] = generatedFile{
+			name: fmt.Sprintf("%s_generated.go", typeNameToFileName(data.Name())),
+			create: func() g.Generator {
+				return gen.TargetGenerators.CreateJSConstructorGenerator(data)
+			},
 		}
 	}
-	return errors.Join(errs...)
+	return files
 }
 
 var matchKnownWord = regexp.MustCompile("(HTML|URL|DOM)([A-Z][a-z]+)")
@@ -139,43 +289,93 @@ func typeNameToFileName(name string) string {
 	return strings.ToLower(snake)
 }
 
-func (gen ScriptWrapperModulesGenerator) writeModules(specs WrapperGeneratorsSpec) error {
-	errs := make([]error, len(specs))
-	i := 0
-	for _, spec := range specs {
-		if spec.UseMultipleFiles() {
-			errs[i] = gen.writeModuleTypes(spec)
-		} else {
-			errs[i] = gen.writeModuleSingleFile(spec)
+// writeModules generates the wrappers of all modules, and the code shared by
+// the wrappers. IDL specs are parsed, and files rendered, in parallel, and
+// the files are written to out in the order of their names.
+//
+// If out is an [output.Cache], the wrappers of a module are only generated if
+// the inputs changed, see [ScriptWrapperModulesGenerator.moduleKey]. The code
+// shared by the wrappers depends on all modules, and is always generated.
+func (gen ScriptWrapperModulesGenerator) writeModules(
+	out output.Files,
+	specs WrapperGeneratorsSpec,
+) error {
+	preloadSpecs(specs)
+	modules, err := specs.LoadModules()
+	if err != nil {
+		return err
+	}
+	classes := CreateJSClasses(modules)
+	if classes, err = SortJSClasses(specs, classes, gen.HostClasses); err != nil {
+		return err
+	}
+	notImplemented := CollectNotImplementedMembers(modules)
+	files := []generatedFile{
+		{name: "dom_exceptions_generated.go", create: func() g.Generator {
+			return gen.TargetGenerators.CreateErrorMapper(gen.ErrorMappings)
+		}},
+		{name: "numeric_conversions_generated.go", create: gen.TargetGenerators.CreateNumericDecoders},
+		{name: "buffer_sources_generated.go", create: gen.TargetGenerators.CreateBufferSourceConverters},
+		{name: "sequences_generated.go", create: gen.TargetGenerators.CreateSequenceConverters},
+		{name: "async_iterators_generated.go", create: gen.TargetGenerators.CreateAsyncIterators},
+		{name: "promises_generated.go", create: gen.TargetGenerators.CreatePromises},
+		{name: "js_classes_generated.go", create: func() g.Generator {
+			return gen.TargetGenerators.CreateJSClassRegistrations(classes)
+		}},
+		{name: "not_implemented_generated.go", create: func() g.Generator {
+			return CreateNotImplementedRegistry(notImplemented)
+		}},
+	}
+	for _, module := range modules {
+		spec := module.Spec
+		moduleOut := out
+		if cache, ok := out.(output.Cache); ok {
+			var upToDate bool
+			if moduleOut, upToDate = cache.Group(spec.Name, gen.moduleKey(spec)); upToDate {
+				spec.log().Debug("Module up to date")
+				continue
+			}
+		}
+		for _, f := range gen.moduleFiles(module) {
+			f.out = moduleOut
+			files = append(files, f)
 		}
-		i++
 	}
-	return errors.Join(errs...)
+	slices.SortFunc(files, func(x, y generatedFile) int { return strings.Compare(x.name, y.name) })
+	return gen.writeFiles(out, files)
 }
 
-func (gen ScriptWrapperModulesGenerator) writeModuleSingleFile(
-	spec *WrapperGeneratorFileSpec,
-) error {
-	outputFileName := fmt.Sprintf("%s_generated.go", spec.Name)
-	if writer, err := os.Create(outputFileName); err != nil {
-		return err
-	} else {
-		defer writer.Close()
-		return gen.writeModule(writer, spec)
+func (s *WrapperGeneratorFileSpec) Type(typeName string) WrapperTypeSpec {
+	if result, ok := s.Types[typeName]; ok {
+		return result
 	}
+	result := s.newType(typeName)
+	s.Types[typeName] = result
+	return result
 }
 
-func (s *WrapperGeneratorFileSpec) Type(typeName string) WrapperTypeSpec {
+// lookupType returns the customizations of the type, like
+// [WrapperGeneratorFileSpec.Type], but doesn't add the type to the module, e.g.,
+// for a mixin, which is wrapped if it is included by a wrapped interface.
+func (s *WrapperGeneratorFileSpec) lookupType(typeName string) WrapperTypeSpec {
 	if result, ok := s.Types[typeName]; ok {
 		return result
 	}
+	return s.newType(typeName)
+}
+
+func (s *WrapperGeneratorFileSpec) newType(typeName string) WrapperTypeSpec {
+	receiver := generators.DefaultReceiverName(typeName)
+	if receiver == "" {
+		// Namespaces, e.g., `console`, are lower case
+		receiver = strings.ToLower(typeName[0:1])
+	}
 	result := &ESClassWrapper{
 		DomSpec:  s,
 		TypeName: typeName,
-		Receiver: generators.DefaultReceiverName(typeName),
+		Receiver: receiver,
 	}
 	result.ensureMap()
-	s.Types[typeName] = result
 	return result
 }
 
@@ -206,6 +406,35 @@ func CreateSpecs() WrapperGeneratorsSpec {
 	domNode.Method("nodeValue").Ignore()
 	domNode.Method("textContent").Ignore()
 
+	consoleSpecs := specs.Module("console")
+	consoleNamespace := consoleSpecs.Type("console")
+	consoleNamespace.InnerTypeName = "Console"
+	consoleNamespace.Method("assert").SetNoError()
+	consoleNamespace.Method("clear").SetNoError()
+	consoleNamespace.Method("debug").SetNoError()
+	consoleNamespace.Method("error").SetNoError()
+	consoleNamespace.Method("info").SetNoError()
+	consoleNamespace.Method("log").SetNoError()
+	consoleNamespace.Method("trace").SetNoError()
+	consoleNamespace.Method("warn").SetNoError()
+	consoleNamespace.Method("dirxml").SetNoError()
+	consoleNamespace.Method("count").SetNoError()
+	consoleNamespace.Method("countReset").SetNoError()
+	consoleNamespace.Method("group").SetNoError()
+	consoleNamespace.Method("groupCollapsed").SetNoError()
+	consoleNamespace.Method("groupEnd").SetNoError()
+	consoleNamespace.Method("time").SetNoError()
+	consoleNamespace.Method("timeLog").SetNoError()
+	consoleNamespace.Method("timeEnd").SetNoError()
+	consoleNamespace.Method("table").SetNotImplemented()
+	consoleNamespace.Method("dir").SetNotImplemented()
+	// The label and condition have default values in the IDL, so the Go
+	// functions have one signature, e.g., Count(label), for all engines.
+	consoleNamespace.Method("assert").Argument("condition").HasDefaultValue("defaultCondition")
+	for _, name := range []string{"count", "countReset", "time", "timeLog", "timeEnd"} {
+		consoleNamespace.Method(name).Argument("label").HasDefaultValue("defaultLabel")
+	}
+
 	return specs
 }
 
@@ -269,6 +498,9 @@ func NewScriptWrapperModulesGenerator() ScriptWrapperModulesGenerator {
 	event.Method("srcElement").Ignore()
 	event.Method("defaultPrevented").Ignore()
 
+	// cssom-view extends Element with a partial interface, e.g., scrollTop
+	domSpecs.AddSpecs("cssom-view-1")
+
 	domElement := domSpecs.Type("Element")
 	domElement.RunCustomCode = true
 	domElement.Method("getAttribute").SetCustomImplementation()
@@ -308,6 +540,29 @@ func NewScriptWrapperModulesGenerator() ScriptWrapperModulesGenerator {
 		"attachShadow",
 	)
 
+	// Members of the partial interface in cssom-view. Layout is not
+	// implemented, so these are shown as not implemented in the coverage
+	// report.
+	domElement.MarkMembersAsNotImplemented(
+		"getClientRects",
+		"getBoundingClientRect",
+		"checkVisibility",
+		"scrollIntoView",
+		"scroll",
+		"scrollTo",
+		"scrollBy",
+		"scrollTop",
+		"scrollLeft",
+		"scrollWidth",
+		"scrollHeight",
+		"clientTop",
+		"clientLeft",
+		"clientWidth",
+		"clientHeight",
+		"currentCSSZoom",
+	)
+	domElement.ExcludeMixins("GeometryUtils")
+
 	domElement.MarkMembersAsIgnored(
 		// HTMX fails if these exist but throw
 		"webkitMatchesSelector",
@@ -465,7 +720,6 @@ func NewScriptWrapperModulesGenerator() ScriptWrapperModulesGenerator {
 	history.Method("state").SetEncoder("toJSON")
 
 	anchor := htmlSpecs.Type("HTMLAnchorElement")
-	anchor.IncludeIncludes = true
 	anchor.CreateWrapper()
 	anchor.Method("download").Ignore()
 	anchor.Method("Ping").Ignore()
@@ -481,9 +735,15 @@ func NewScriptWrapperModulesGenerator() ScriptWrapperModulesGenerator {
 		Specs:            specs,
 		PackagePath:      v8host,
 		TargetGenerators: V8TargetGenerators{},
+		ErrorMappings:    DefaultErrorMappings,
+		// Keep in sync with the classes registered by hand in v8host
+		HostClasses: []string{"EventTarget", "HTMLElement"},
 	}
 }
 
-func (gen ScriptWrapperModulesGenerator) GenerateScriptWrappers() error {
-	return gen.writeModules(gen.Specs)
+// GenerateScriptWrappers generates the wrappers for all modules, and the code
+// shared by the wrappers, writing the files to out.
+func (gen ScriptWrapperModulesGenerator) GenerateScriptWrappers(out output.Files) error {
+	gen.setLogger()
+	return gen.writeModules(out, gen.Specs)
 }
@@ -0,0 +1,13 @@
+package wrappers_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestScriptWrappers(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "ScriptWrappers Suite")
+}
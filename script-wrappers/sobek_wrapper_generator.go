@@ -0,0 +1,22 @@
+package wrappers
+
+// SobekEngine is the target of the sobek script host. Sobek is a fork of goja
+// with the same API, so only the engine package, and the context type of the
+// host differ from [GojaEngine].
+var SobekEngine = GojaTarget{Package: sobekSrc, ContextType: "SobekContext"}
+
+func NewSobekWrapperModuleGenerator() ScriptWrapperModulesGenerator {
+	specs := CreateSpecs()
+	dom := specs.Module("dom")
+	domNode := dom.Type("Node")
+	domNode.Method("childNodes").SetNotImplemented()
+
+	return ScriptWrapperModulesGenerator{
+		Specs:            specs,
+		PackagePath:      sobekhost,
+		TargetGenerators: EngineTargetGenerators{SobekEngine},
+		ErrorMappings:    DefaultErrorMappings,
+		// Keep in sync with the classes registered by hand in sobekhost
+		HostClasses: []string{"EventTarget"},
+	}
+}
@@ -0,0 +1,429 @@
+package wrappers
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gost-dom/code-gen/output"
+	"github.com/gost-dom/webref/idl"
+)
+
+// TypeScriptDeclarationsFileName is the name of the TypeScript declaration file
+// written by [ScriptWrapperModulesGenerator.GenerateTypeScriptDeclarations].
+const TypeScriptDeclarationsFileName = "gost-dom.d.ts"
+
+// GenerateTypeScriptDeclarations writes a TypeScript declaration file
+// describing the JavaScript API exposed by the wrappers, from the same
+// [ESConstructorData] as the wrappers are generated from. Ignored members are
+// omitted, and members that are not implemented are tagged with
+// `@deprecated Not implemented`, so editors flag code that relies on them.
+//
+// The file is intended to replace the "dom" lib of TypeScript. Types that are
+// referenced, but not wrapped, are declared from the IDL, or as empty
+// interfaces if the IDL has no information about the members.
+func (gen ScriptWrapperModulesGenerator) GenerateTypeScriptDeclarations(out output.Files) error {
+	gen.setLogger()
+	start := time.Now()
+	decls := newTSDeclarations()
+	modules, err := gen.Specs.LoadModules()
+	if err != nil {
+		return err
+	}
+	for _, module := range modules {
+		decls.specs = append(decls.specs, module.IDL)
+		for _, d := range module.Types {
+			if !d.Mixin {
+				decls.types = append(decls.types, tsType{module.IDL, d})
+			}
+		}
+	}
+	slices.SortFunc(decls.types, func(x, y tsType) int {
+		return strings.Compare(x.data.Name(), y.data.Name())
+	})
+	if err := out.WriteFile(TypeScriptDeclarationsFileName, []byte(decls.generate())); err != nil {
+		return err
+	}
+	if gen.OnFileGenerated != nil {
+		gen.OnFileGenerated(TypeScriptDeclarationsFileName, time.Since(start))
+	}
+	return nil
+}
+
+// tsType is a wrapped interface or namespace, and the IDL spec defining it.
+type tsType struct {
+	spec idl.Spec
+	data ESConstructorData
+}
+
+// tsDeclarations builds the content of the TypeScript declaration file.
+type tsDeclarations struct {
+	specs      []idl.Spec
+	types      []tsType
+	declared   map[string]bool
+	referenced map[string]bool
+	b          strings.Builder
+}
+
+func newTSDeclarations() *tsDeclarations {
+	return &tsDeclarations{
+		declared:   make(map[string]bool),
+		referenced: make(map[string]bool),
+	}
+}
+
+func (d *tsDeclarations) printf(format string, args ...any) {
+	fmt.Fprintf(&d.b, format, args...)
+}
+
+func (d *tsDeclarations) generate() string {
+	d.printf("// This file is generated. Do not edit.\n")
+	for _, t := range d.types {
+		d.declared[t.data.Name()] = true
+	}
+	for _, t := range d.types {
+		d.printf("\n")
+		if t.data.Namespace {
+			d.declareNamespace(t)
+		} else {
+			d.declareInterface(t)
+		}
+	}
+	// Declaring a referenced type can reference more types.
+	for {
+		pending := slices.Sorted(func(yield func(string) bool) {
+			for name := range d.referenced {
+				if !d.declared[name] && !yield(name) {
+					return
+				}
+			}
+		})
+		if len(pending) == 0 {
+			break
+		}
+		for _, name := range pending {
+			d.declared[name] = true
+			d.printf("\n")
+			d.declareReferenced(name)
+		}
+	}
+	return d.b.String()
+}
+
+// members returns the IDL members of the interface and included mixins by
+// name, giving access to the IDL types not represented in [ESOperation].
+func (t tsType) members() map[string]idl.NameMember {
+	result := make(map[string]idl.NameMember)
+	intf := t.spec.Interfaces[t.data.Name()]
+	add := func(n idl.Name) {
+		for _, m := range n.Members {
+			if _, ok := result[m.Name]; !ok && m.Name != "" && m.Special != "static" {
+				result[m.Name] = m
+			}
+		}
+	}
+	add(intf.InternalSpec)
+	for mixin := range IncludedMixins(intf, t.data.Spec) {
+		add(mixin.InternalSpec)
+	}
+	return result
+}
+
+func (d *tsDeclarations) declareInterface(t tsType) {
+	data := t.data
+	members := t.members()
+	extends := ""
+	if data.Inheritance != "" {
+		extends = " extends " + d.typeName(data.Inheritance)
+	}
+	d.printf("interface %s%s {\n", data.Name(), extends)
+	for _, a := range data.Attributes {
+		d.declareAttribute(a, members[a.Name])
+	}
+	for op := range data.WrapperFunctionsToInstall() {
+		d.declareOperation("\t", "", op, members[op.Name])
+	}
+	if it := data.AsyncIterator; it != nil {
+		d.printf("\t[Symbol.asyncIterator](): %s;\n", d.returnType(*it, members[it.Name]))
+	}
+	d.printf("}\n\n")
+	d.printf("declare var %s: {\n", data.Name())
+	d.printf("\tprototype: %s;\n", data.Name())
+	if c := data.Constructor; c != nil {
+		d.printf("\tnew(%s): %s;\n", d.parameters(*c), data.Name())
+	}
+	d.printf("};\n")
+}
+
+func (d *tsDeclarations) declareNamespace(t tsType) {
+	members := t.members()
+	d.printf("declare namespace %s {\n", t.data.Name())
+	for op := range t.data.WrapperFunctionsToInstall() {
+		d.declareOperation("\t", "function ", op, members[op.Name])
+	}
+	d.printf("}\n")
+}
+
+func (d *tsDeclarations) deprecated(indent string, notImplemented bool) {
+	if notImplemented {
+		d.printf("%s/** @deprecated Not implemented */\n", indent)
+	}
+}
+
+func (d *tsDeclarations) declareAttribute(a ESAttribute, member idl.NameMember) {
+	d.deprecated("\t", a.Getter.NotImplemented)
+	if a.Setter != nil && a.Setter.NotImplemented && !a.Getter.NotImplemented {
+		d.printf("\t/** Setting %s is not implemented. */\n", a.Name)
+	}
+	readonly := ""
+	if a.Setter == nil {
+		readonly = "readonly "
+	}
+	d.printf("\t%s%s: %s;\n", readonly, a.Name, d.idlTypes(member.IdlType))
+}
+
+func (d *tsDeclarations) declareOperation(
+	indent string,
+	keyword string,
+	op ESOperation,
+	member idl.NameMember,
+) {
+	d.deprecated(indent, op.NotImplemented)
+	d.printf("%s%s%s(%s): %s;\n",
+		indent, keyword, op.Name, d.parameters(op), d.returnType(op, member))
+}
+
+func (d *tsDeclarations) parameters(op ESOperation) string {
+	params := make([]string, len(op.Arguments))
+	for i, a := range op.Arguments {
+		name := a.Name
+		if tsReservedWords[name] {
+			name += "_"
+		}
+		typ := d.idlTypes(a.IdlType)
+		switch {
+		case a.Variadic:
+			params[i] = fmt.Sprintf("...%s: %s", name, tsArray(typ, false))
+		case a.Optional:
+			params[i] = fmt.Sprintf("%s?: %s", name, typ)
+		default:
+			params[i] = fmt.Sprintf("%s: %s", name, typ)
+		}
+	}
+	return strings.Join(params, ", ")
+}
+
+// returnType returns the TypeScript return type of the operation. Operations
+// added by the generator, e.g., "toString" for a stringifier attribute, have
+// no IDL member.
+func (d *tsDeclarations) returnType(op ESOperation, member idl.NameMember) string {
+	switch {
+	case op.RetType.TypeName == "async iterable":
+		return fmt.Sprintf("AsyncIterableIterator<%s>", d.esType(op.GenericReturnType.TypeParams[0]))
+	case member.Name == "":
+		return d.typeName(op.RetType.TypeName)
+	}
+	return d.idlTypes(member.IdlType)
+}
+
+func (d *tsDeclarations) esType(t ESType) string {
+	if len(t.TypeParams) == 0 {
+		return tsNullable(d.typeName(t.Name), t.Nullable)
+	}
+	params := make([]string, len(t.TypeParams))
+	for i, p := range t.TypeParams {
+		params[i] = d.esType(p)
+	}
+	return tsNullable(tsGeneric(t.Name, params), t.Nullable)
+}
+
+func (d *tsDeclarations) idlTypes(t idl.IdlTypes) string {
+	switch {
+	case t.IdlType != nil:
+		return d.idlType(*t.IdlType)
+	case len(t.Types) > 0:
+		types := make([]string, len(t.Types))
+		for i, t := range t.Types {
+			types[i] = d.idlType(t)
+		}
+		return strings.Join(types, " | ")
+	case t.TypeName != "":
+		return d.typeName(t.TypeName)
+	}
+	return "any"
+}
+
+func (d *tsDeclarations) idlType(t idl.IdlType) string {
+	var result string
+	switch {
+	case t.Union:
+		result = d.idlTypes(idl.IdlTypes{Types: t.IType.Types})
+		if t.Nullable {
+			result = "(" + result + ")"
+		}
+	case t.Generic != "":
+		params := make([]string, len(t.IType.Types))
+		for i, p := range t.IType.Types {
+			params[i] = d.idlType(p)
+		}
+		if len(params) == 0 {
+			params = append(params, d.idlTypes(t.IType))
+		}
+		result = tsGeneric(t.Generic, params)
+	default:
+		result = d.idlTypes(t.IType)
+	}
+	return tsNullable(result, t.Nullable)
+}
+
+// typeName returns the TypeScript type for the IDL type name, and records
+// names of interfaces, dictionaries, etc., that must be declared.
+func (d *tsDeclarations) typeName(name string) string {
+	if ts, ok := tsPrimitiveTypes[name]; ok {
+		return ts
+	}
+	if !tsBuiltinTypes[name] {
+		d.referenced[name] = true
+	}
+	return name
+}
+
+// declareReferenced declares a type referenced by a wrapped interface, but
+// not wrapped itself. Interfaces are declared as empty interfaces, and
+// dictionaries with all fields optional, as the IDL doesn't tell which fields
+// are required.
+func (d *tsDeclarations) declareReferenced(name string) {
+	if alias, ok := tsTypedefs[name]; ok {
+		d.printf("type %s = %s;\n", name, alias)
+		return
+	}
+	var (
+		n     idl.Name
+		found bool
+	)
+	for _, spec := range d.specs {
+		if n, found = spec.IdlNames[name]; found {
+			break
+		}
+	}
+	switch {
+	case !found:
+		d.printf("// %s is not defined in the loaded IDL specs.\n", name)
+		d.printf("type %s = any;\n", name)
+	case n.Type == "dictionary":
+		extends := ""
+		if n.Inheritance != "" {
+			extends = " extends " + d.typeName(n.Inheritance)
+		}
+		d.printf("interface %s%s {\n", name, extends)
+		for _, m := range n.Members {
+			d.printf("\t%s?: %s;\n", m.Name, d.idlTypes(m.IdlType))
+		}
+		d.printf("}\n")
+	case n.Type == "enum", n.Type == "typedef", n.Type == "callback":
+		d.printf("// The IDL %s %s is not available in the loaded IDL specs.\n", n.Type, name)
+		d.printf("type %s = any;\n", name)
+	default:
+		d.printf("// %s is not exposed by the generated wrappers.\n", name)
+		d.printf("interface %s {}\n", name)
+	}
+}
+
+func tsNullable(t string, nullable bool) string {
+	if nullable {
+		return t + " | null"
+	}
+	return t
+}
+
+// tsArray returns the array type of the element type t.
+func tsArray(t string, readonly bool) string {
+	if strings.ContainsAny(t, " |") {
+		t = "(" + t + ")"
+	}
+	if readonly {
+		return "readonly " + t + "[]"
+	}
+	return t + "[]"
+}
+
+func tsGeneric(generic string, params []string) string {
+	switch generic {
+	case "sequence":
+		return tsArray(params[0], false)
+	case "FrozenArray", "ObservableArray":
+		return tsArray(params[0], true)
+	case "record":
+		return fmt.Sprintf("Record<%s, %s>", params[0], params[len(params)-1])
+	case "Promise":
+		return fmt.Sprintf("Promise<%s>", params[0])
+	case "async iterable":
+		return fmt.Sprintf("AsyncIterableIterator<%s>", params[0])
+	}
+	return "any"
+}
+
+var tsPrimitiveTypes = map[string]string{
+	"undefined":           "void",
+	"any":                 "any",
+	"object":              "object",
+	"boolean":             "boolean",
+	"DOMString":           "string",
+	"USVString":           "string",
+	"ByteString":          "string",
+	"CSSOMString":         "string",
+	"byte":                "number",
+	"octet":               "number",
+	"short":               "number",
+	"unsigned short":      "number",
+	"long":                "number",
+	"unsigned long":       "number",
+	"long long":           "number",
+	"unsigned long long":  "number",
+	"float":               "number",
+	"unrestricted float":  "number",
+	"double":              "number",
+	"unrestricted double": "number",
+	"bigint":              "bigint",
+}
+
+// tsBuiltinTypes are types declared by the "es" libs of TypeScript.
+var tsBuiltinTypes = map[string]bool{
+	"ArrayBuffer":       true,
+	"SharedArrayBuffer": true,
+	"DataView":          true,
+	"Int8Array":         true,
+	"Int16Array":        true,
+	"Int32Array":        true,
+	"Uint8Array":        true,
+	"Uint16Array":       true,
+	"Uint32Array":       true,
+	"Uint8ClampedArray": true,
+	"BigInt64Array":     true,
+	"BigUint64Array":    true,
+	"Float32Array":      true,
+	"Float64Array":      true,
+}
+
+// tsTypedefs are typedefs from the Web IDL spec, which isn't loaded.
+var tsTypedefs = map[string]string{
+	"ArrayBufferView":         "Int8Array | Int16Array | Int32Array | Uint8Array | Uint16Array | Uint32Array | Uint8ClampedArray | BigInt64Array | BigUint64Array | Float32Array | Float64Array | DataView",
+	"BufferSource":            "ArrayBufferView | ArrayBuffer",
+	"AllowSharedBufferSource": "ArrayBuffer | SharedArrayBuffer | ArrayBufferView",
+	"DOMHighResTimeStamp":     "number",
+	"EpochTimeStamp":          "number",
+}
+
+// tsReservedWords are reserved words in TypeScript that are valid argument
+// names in IDL.
+var tsReservedWords = map[string]bool{
+	"break": true, "case": true, "catch": true, "class": true, "const": true,
+	"continue": true, "debugger": true, "default": true, "delete": true,
+	"do": true, "else": true, "enum": true, "export": true, "extends": true,
+	"false": true, "finally": true, "for": true, "function": true, "if": true,
+	"import": true, "in": true, "instanceof": true, "new": true, "null": true,
+	"return": true, "super": true, "switch": true, "this": true, "throw": true,
+	"true": true, "try": true, "typeof": true, "var": true, "void": true,
+	"while": true, "with": true,
+}
@@ -0,0 +1,282 @@
+package wrappers
+
+import (
+	"slices"
+
+	"github.com/dave/jennifer/jen"
+	g "github.com/gost-dom/generators"
+)
+
+// CreateAsyncIterators generates toAsyncIterator, the encoder converting the
+// iter.Seq2 returned from Go to a JS async iterator. Each call to "next"
+// returns a promise, and pulls a value from the sequence in a new goroutine,
+// see pullQueue. The promise is settled with the result in a task queued in
+// the script context, and "return" stops the sequence. The iterator returns
+// itself from Symbol.asyncIterator, so it can be used in a for await loop.
+//
+// An error from the sequence rejects the promise with a JS Error with the
+// message of the Go error, see [V8TargetGenerators.CreatePromises].
+//
+// The iterator objects are created from one object template per script host,
+// stored in the asyncIteratorTemplate field of the V8ScriptHost. The state of
+// an iterator is stored in the asyncIterators field of the V8ScriptContext,
+// and found by the ID in the internal field of the iterator object. V8 doesn't
+// tell when JS drops an iterator, so the sequences of unfinished iterators are
+// stopped when the script context is disposed.
+func (_ V8TargetGenerators) CreateAsyncIterators() g.Generator {
+	return g.Raw(jen.Add(
+		v8AsyncIteratorState(),
+		jen.Line().Line(),
+		createPullQueue(),
+		jen.Line().Line(),
+		v8ToAsyncIterator(),
+		jen.Line().Line(),
+		v8AsyncIteratorTemplate(),
+		jen.Line().Line(),
+		v8AsyncIteratorResult(),
+	))
+}
+
+func v8AsyncIteratorState() *jen.Statement {
+	ctx := jen.Id("ctx")
+	iterators := jen.Id("iterators")
+	ctxIterators := ctx.Clone().Dot("asyncIterators").Dot("iterators")
+	settleFunc := jen.Func().Params().Params(jen.Op("*").Qual(v8, "Value"), jen.Bool(), jen.Error())
+	iteratorsMap := jen.Map(jen.Uint32()).Op("*").Id("asyncIterator")
+	stop := func(iterator *jen.Statement) *jen.Statement {
+		return iterator.Clone().Dot("pulls").Dot("pull").Call(iterator.Clone().Dot("stop"))
+	}
+	return jen.Comment("asyncIterator is the state of a JS async iterator. next pulls the next value").
+		Line().Comment("from the sequence, and returns the function encoding it in the script").
+		Line().Comment("context, also returning if the iterator is done.").
+		Line().Type().Id("asyncIterator").Struct(
+		jen.Id("next").Func().Params().Add(settleFunc),
+		jen.Id("stop").Func().Params(),
+		jen.Id("pulls").Id("pullQueue"),
+	).
+		Line().Line().
+		Comment("asyncIterators contains the async iterators of a script context that").
+		Line().Comment("haven't finished, by the ID in the internal field of the iterator object.").
+		Line().Type().Id("asyncIterators").Struct(
+		jen.Id("lastID").Uint32(),
+		iterators.Clone().Add(iteratorsMap.Clone()),
+	).
+		Line().Line().
+		Comment("dispose stops the sequences of the iterators that haven't finished.").
+		Line().Func().Params(jen.Id("i").Op("*").Id("asyncIterators")).Id("dispose").Params().Block(
+		jen.For(jen.List(jen.Id("_"), jen.Id("iterator")).Op(":=").Range().Id("i").Dot("iterators")).Block(
+			stop(jen.Id("iterator")),
+		),
+		jen.Clear(jen.Id("i").Dot("iterators")),
+	).
+		Line().Line().
+		Comment("addAsyncIterator adds the iterator to the script context, and returns its ID.").
+		Line().Func().Id("addAsyncIterator").Params(
+		ctx.Clone().Op("*").Id("V8ScriptContext"),
+		jen.Id("iterator").Op("*").Id("asyncIterator"),
+	).Uint32().Block(
+		iterators.Clone().Op(":=").Op("&").Add(ctx.Clone()).Dot("asyncIterators"),
+		jen.If(iterators.Clone().Dot("iterators").Op("==").Nil()).Block(
+			iterators.Clone().Dot("iterators").Op("=").Make(iteratorsMap.Clone()),
+			ctx.Clone().Dot("addDisposer").Call(iterators.Clone()),
+		),
+		iterators.Clone().Dot("lastID").Op("++"),
+		iterators.Clone().Dot("iterators").Index(iterators.Clone().Dot("lastID")).Op("=").Id("iterator"),
+		jen.Return(iterators.Clone().Dot("lastID")),
+	).
+		Line().Line().
+		Comment("removeAsyncIterator stops the sequence of the iterator, after the pending").
+		Line().Comment("pulls, and removes it from the script context.").
+		Line().Func().Id("removeAsyncIterator").Params(
+		ctx.Clone().Op("*").Id("V8ScriptContext"),
+		jen.Id("id").Uint32(),
+	).Block(
+		jen.If(
+			jen.List(jen.Id("iterator"), jen.Id("ok")).Op(":=").Add(ctxIterators.Clone()).Index(jen.Id("id")),
+			jen.Id("ok"),
+		).Block(
+			stop(jen.Id("iterator")),
+			jen.Delete(ctxIterators.Clone(), jen.Id("id")),
+		),
+	)
+}
+
+func v8ToAsyncIterator() *jen.Statement {
+	ctxPtr := jen.Op("*").Id("V8ScriptContext")
+	v8Val := jen.Op("*").Qual(v8, "Value")
+	seqType := jen.Qual("iter", "Seq2").Index(jen.List(jen.Id("T"), jen.Error()))
+	encoderType := jen.Func().Params(ctxPtr.Clone(), jen.Id("T")).Params(v8Val.Clone(), jen.Error())
+	settleFunc := jen.Func().Params().Params(v8Val.Clone(), jen.Bool(), jen.Error())
+	return jen.Comment("toAsyncIterator returns an encoder converting a sequence to a JS async iterator,").
+		Line().Comment("encoding each value of the sequence using encode.").
+		Line().Func().Id("toAsyncIterator").Types(jen.Id("T").Any()).
+		Params(jen.Id("encode").Add(encoderType)).
+		Func().Params(ctxPtr.Clone(), seqType.Clone()).Params(v8Val.Clone(), jen.Error()).
+		Block(jen.Return(jen.Func().Params(
+			jen.Id("ctx").Add(ctxPtr.Clone()),
+			jen.Id("seq").Add(seqType.Clone()),
+		).Params(v8Val.Clone(), jen.Error()).Block(
+			jen.List(jen.Id("iterator"), jen.Err()).Op(":=").
+				Id("asyncIteratorTemplate").Call(jen.Id("ctx").Dot("host")).
+				Dot("NewInstance").Call(jen.Id("ctx").Dot("v8ctx")),
+			jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err())),
+			jen.List(jen.Id("next"), jen.Id("stop")).Op(":=").Qual("iter", "Pull2").Call(jen.Id("seq")),
+			jen.Id("id").Op(":=").Id("addAsyncIterator").Call(jen.Id("ctx"), jen.Op("&").Id("asyncIterator").Values(jen.Dict{
+				jen.Id("next"): jen.Func().Params().Add(settleFunc.Clone()).Block(
+					jen.List(jen.Id("value"), jen.Err(), jen.Id("ok")).Op(":=").Id("next").Call(),
+					jen.Return(jen.Func().Params().Params(v8Val.Clone(), jen.Bool(), jen.Error()).Block(
+						jen.If(jen.Op("!").Id("ok")).Block(
+							jen.Return(jen.Qual(v8, "Undefined").Call(jen.Id("ctx").Dot("host").Dot("iso")), jen.True(), jen.Nil()),
+						),
+						jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.True(), jen.Err())),
+						jen.List(jen.Id("encoded"), jen.Err()).Op(":=").Id("encode").Call(jen.Id("ctx"), jen.Id("value")),
+						jen.Return(jen.Id("encoded"), jen.Err().Op("!=").Nil(), jen.Err()),
+					)),
+				),
+				jen.Id("stop"): jen.Id("stop"),
+			})),
+			jen.If(
+				jen.Err().Op(":=").Id("iterator").Dot("SetInternalField").Call(jen.Lit(0), jen.Id("id")),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Id("removeAsyncIterator").Call(jen.Id("ctx"), jen.Id("id")),
+				jen.Return(jen.Nil(), jen.Err()),
+			),
+			jen.Return(jen.Id("iterator").Dot("Value"), jen.Nil()),
+		)))
+}
+
+func v8AsyncIteratorTemplate() *jen.Statement {
+	host := jen.Id("host")
+	iso := jen.Id("iso")
+	tmpl := host.Clone().Dot("asyncIteratorTemplate")
+	info := jen.Id("info").Op("*").Qual(v8, "FunctionCallbackInfo")
+	v8Val := jen.Op("*").Qual(v8, "Value")
+	undefined := jen.Qual(v8, "Undefined").Call(iso.Clone())
+	result := func(value jen.Code, done jen.Code) *jen.Statement {
+		return jen.Return(jen.Id("asyncIteratorResult").Call(jen.Id("ctx"), value, done))
+	}
+	callback := func(body ...jen.Code) *jen.Statement {
+		return jen.Qual(v8, "NewFunctionTemplateWithError").Call(
+			iso.Clone(),
+			jen.Func().Params(info.Clone()).Params(v8Val.Clone(), jen.Error()).Block(body...),
+		)
+	}
+	function := func(name string, body ...jen.Code) *jen.Statement {
+		return tmpl.Clone().Dot("Set").Call(jen.Lit(name), callback(
+			slices.Concat([]jen.Code{
+				jen.Id("ctx").Op(":=").Add(host.Clone()).Dot("mustGetContext").Call(jen.Id("info").Dot("Context").Call()),
+				jen.Id("this").Op(":=").Id("info").Dot("This").Call(),
+				jen.If(
+					jen.Id("this").Dot("InternalFieldCount").Call().Op("==").Lit(0).Op("||").
+						Op("!").Id("this").Dot("GetInternalField").Call(jen.Lit(0)).Dot("IsUint32").Call(),
+				).Block(
+					jen.Return(jen.Nil(), jen.Qual(v8, "NewTypeError").Call(
+						iso.Clone(), jen.Lit("AsyncIterator."+name+": Illegal invocation"),
+					)),
+				),
+				jen.Id("id").Op(":=").Id("this").Dot("GetInternalField").Call(jen.Lit(0)).Dot("Uint32").Call(),
+			}, body)...,
+		))
+	}
+	return jen.Comment("asyncIteratorTemplate returns the template of async iterator objects, created").
+		Line().Comment("once for each script host. The functions find the iterator in the script").
+		Line().Comment("context by the ID in the internal field of the receiver.").
+		Line().Func().Id("asyncIteratorTemplate").Params(
+		host.Clone().Op("*").Id("V8ScriptHost"),
+	).Op("*").Qual(v8, "ObjectTemplate").Block(
+		jen.If(tmpl.Clone().Op("!=").Nil()).Block(jen.Return(tmpl.Clone())),
+		iso.Clone().Op(":=").Add(host.Clone()).Dot("iso"),
+		tmpl.Clone().Op("=").Qual(v8, "NewObjectTemplate").Call(iso.Clone()),
+		tmpl.Clone().Dot("SetInternalFieldCount").Call(jen.Lit(1)),
+		function("next",
+			jen.List(jen.Id("iterator"), jen.Id("ok")).Op(":=").
+				Id("ctx").Dot("asyncIterators").Dot("iterators").Index(jen.Id("id")),
+			jen.If(jen.Op("!").Id("ok")).Block(result(undefined.Clone(), jen.True())),
+			jen.List(jen.Id("resolver"), jen.Err()).Op(":=").Qual(v8, "NewPromiseResolver").Call(jen.Id("ctx").Dot("v8ctx")),
+			jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err())),
+			jen.Id("iterator").Dot("pulls").Dot("pull").Call(jen.Func().Params().Block(
+				jen.Id("settle").Op(":=").Id("iterator").Dot("next").Call(),
+				jen.Id("ctx").Dot("queueTask").Call(jen.Func().Params().Block(
+					jen.List(jen.Id("value"), jen.Id("done"), jen.Err()).Op(":=").Id("settle").Call(),
+					jen.If(jen.Id("done")).Block(jen.Id("removeAsyncIterator").Call(jen.Id("ctx"), jen.Id("id"))),
+					jen.Id("settleAsyncIteratorResult").Call(
+						jen.Id("ctx"), jen.Id("resolver"), jen.Id("value"), jen.Id("done"), jen.Err(),
+					),
+				)),
+			)),
+			jen.Return(jen.Id("resolver").Dot("GetPromise").Call().Dot("Value"), jen.Nil()),
+		),
+		function("return",
+			jen.Id("removeAsyncIterator").Call(jen.Id("ctx"), jen.Id("id")),
+			jen.Id("value").Op(":=").Add(undefined.Clone()),
+			jen.If(
+				jen.Id("args").Op(":=").Id("info").Dot("Args").Call(),
+				jen.Len(jen.Id("args")).Op(">").Lit(0),
+			).Block(jen.Id("value").Op("=").Id("args").Index(jen.Lit(0))),
+			result(jen.Id("value"), jen.True()),
+		),
+		tmpl.Clone().Dot("SetSymbol").Call(
+			jen.Qual(v8, "SymbolAsyncIterator").Call(iso.Clone()),
+			callback(jen.Return(jen.Id("info").Dot("This").Call().Dot("Value"), jen.Nil())),
+		),
+		jen.Return(tmpl.Clone()),
+	)
+}
+
+func v8AsyncIteratorResult() *jen.Statement {
+	ctx := jen.Id("ctx")
+	iso := jen.Id("ctx").Dot("host").Dot("iso")
+	resolver := jen.Id("resolver")
+	ctxParam := ctx.Clone().Op("*").Id("V8ScriptContext")
+	resolverParam := resolver.Clone().Op("*").Qual(v8, "PromiseResolver")
+	valueParam := jen.Id("value").Op("*").Qual(v8, "Value")
+	returnOnError := jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err()))
+	return jen.Comment("newIteratorResult creates an iterator result object.").
+		Line().Func().Id("newIteratorResult").Params(
+		ctxParam.Clone(), valueParam.Clone(), jen.Id("done").Bool(),
+	).Params(jen.Op("*").Qual(v8, "Object"), jen.Error()).Block(
+		jen.List(jen.Id("result"), jen.Err()).Op(":=").Qual(v8, "NewObjectTemplate").Call(iso.Clone()).
+			Dot("NewInstance").Call(ctx.Clone().Dot("v8ctx")),
+		returnOnError.Clone(),
+		jen.If(
+			jen.Err().Op(":=").Id("result").Dot("Set").Call(jen.Lit("value"), jen.Id("value")),
+			jen.Err().Op("!=").Nil(),
+		).Block(jen.Return(jen.Nil(), jen.Err())),
+		jen.If(
+			jen.Err().Op(":=").Id("result").Dot("Set").Call(jen.Lit("done"), jen.Id("done")),
+			jen.Err().Op("!=").Nil(),
+		).Block(jen.Return(jen.Nil(), jen.Err())),
+		jen.Return(jen.Id("result"), jen.Nil()),
+	).
+		Line().Line().
+		Comment("settleAsyncIteratorResult resolves the promise with an iterator result").
+		Line().Comment("object, or rejects it with an Error if reason is not nil.").
+		Line().Func().Id("settleAsyncIteratorResult").Params(
+		ctxParam.Clone(), resolverParam.Clone(), valueParam.Clone(),
+		jen.Id("done").Bool(), jen.Id("reason").Error(),
+	).Block(
+		jen.If(jen.Id("reason").Op("==").Nil()).Block(
+			jen.Var().Id("result").Op("*").Qual(v8, "Object"),
+			jen.If(
+				jen.List(jen.Id("result"), jen.Id("reason")).Op("=").
+					Id("newIteratorResult").Call(ctx.Clone(), jen.Id("value"), jen.Id("done")),
+				jen.Id("reason").Op("==").Nil(),
+			).Block(
+				resolver.Clone().Dot("Resolve").Call(jen.Id("result").Dot("Value")),
+				jen.Return(),
+			),
+		),
+		jen.Id("rejectPromise").Call(ctx.Clone(), resolver.Clone(), jen.Id("reason")),
+	).
+		Line().Line().
+		Comment("asyncIteratorResult returns a promise resolved with an iterator result object.").
+		Line().Func().Id("asyncIteratorResult").Params(
+		ctxParam.Clone(), valueParam.Clone(), jen.Id("done").Bool(),
+	).Params(jen.Op("*").Qual(v8, "Value"), jen.Error()).Block(
+		jen.List(resolver, jen.Err()).Op(":=").Qual(v8, "NewPromiseResolver").Call(ctx.Clone().Dot("v8ctx")),
+		returnOnError.Clone(),
+		jen.Id("settleAsyncIteratorResult").Call(ctx.Clone(), resolver.Clone(), jen.Id("value"), jen.Id("done"), jen.Nil()),
+		jen.Return(resolver.Clone().Dot("GetPromise").Call().Dot("Value"), jen.Nil()),
+	)
+}
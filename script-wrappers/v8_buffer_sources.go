@@ -0,0 +1,225 @@
+package wrappers
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	g "github.com/gost-dom/generators"
+)
+
+var (
+	v8ScriptContextPtr = g.NewType("V8ScriptContext").Pointer()
+	v8ObjectPtr        = g.NewTypePackage("Object", v8).Pointer()
+	v8FunctionPtr      = g.NewTypePackage("Function", v8).Pointer()
+	byteSlice          = g.Raw(jen.Index().Byte())
+)
+
+// CreateBufferSourceConverters generates the decoders and encoders for buffer
+// types. V8 doesn't expose the backing store of an ArrayBuffer, so the bytes
+// are read and written through a Uint8Array, one element at a time. Each
+// element is a cgo call, so converting a buffer takes time linear in its size
+// with a large constant; fine for the small buffers passed by the wrapped
+// APIs, e.g., TextDecoder input, but not for bulk data.
+func (_ V8TargetGenerators) CreateBufferSourceConverters() g.Generator {
+	result := g.StatementList(
+		v8UInt8ArrayConstructor(),
+		g.Line,
+		v8NewUint8Array(),
+		g.Line,
+		v8BufferSourceBytes(),
+	)
+	for _, d := range BufferSourceDecoders() {
+		result.Append(g.Line, v8BufferSourceDecoder(d))
+	}
+	for _, t := range EncodableBufferSourceTypes() {
+		result.Append(g.Line, v8BufferSourceEncoder(t))
+	}
+	return result
+}
+
+func v8BufferSourceDecoder(d BufferSourceDecoder) g.Generator {
+	ctx := g.NewValue("ctx")
+	val := g.NewValue("val")
+	checks := make([]jen.Code, 0, len(d.Accepts()))
+	for _, k := range d.Accepts() {
+		checks = append(checks, val.Method(fmt.Sprintf("Is%s", k)).Call().Generate())
+	}
+	return g.FunctionDefinition{
+		Name:     d.Name(),
+		Args:     g.Arg(ctx, v8ScriptContextPtr).Arg(val, v8Value),
+		RtnTypes: g.List(byteSlice, g.Id("error")),
+		Body: g.StatementList(
+			g.IfStmt{
+				Condition: g.Raw(notAny(checks)),
+				Block: g.Return(g.Nil, v8TypeError(ctx,
+					g.Lit(fmt.Sprintf("Value is not of type '%s'", d.Type.Name)))),
+			},
+			g.Return(g.NewValue("bufferSourceBytes").Call(ctx, val, g.Lit(d.AllowShared))),
+		),
+	}
+}
+
+func v8BufferSourceEncoder(t BufferSourceType) g.Generator {
+	ctx := g.NewValue("ctx")
+	data := g.NewValue("data")
+	array := g.NewValue("array")
+	var result g.Generator = g.Return(array.Field("Value"), g.Nil)
+	if t.Name == "ArrayBuffer" {
+		result = g.Return(array.Method("Get").Call(g.Lit("buffer")))
+	}
+	return g.FunctionDefinition{
+		Name:     t.EncoderName(),
+		Args:     g.Arg(ctx, v8ScriptContextPtr).Arg(data, byteSlice),
+		RtnTypes: g.List(v8Value, g.Id("error")),
+		Body: g.StatementList(
+			g.AssignMany(g.List(array, g.Id("err")), g.NewValue("newUint8Array").Call(ctx, data)),
+			ReturnOnError{},
+			result,
+		),
+	}
+}
+
+func v8UInt8ArrayConstructor() g.Generator {
+	ctx := g.NewValue("ctx")
+	constructor := g.NewValue("constructor")
+	return g.FunctionDefinition{
+		Name:     "uint8ArrayConstructor",
+		Args:     g.Arg(ctx, v8ScriptContextPtr),
+		RtnTypes: g.List(v8FunctionPtr, g.Id("error")),
+		Body: g.StatementList(
+			g.AssignMany(g.List(constructor, g.Id("err")),
+				ctx.Field("v8ctx").Method("Global").Call().Method("Get").Call(g.Lit("Uint8Array"))),
+			ReturnOnError{},
+			g.Return(constructor.Method("AsFunction").Call()),
+		),
+	}
+}
+
+func v8NewUint8Array() g.Generator {
+	ctx := g.NewValue("ctx")
+	data := g.NewValue("data")
+	constructor := g.NewValue("constructor")
+	length := g.NewValue("length")
+	array := g.NewValue("array")
+	err := g.Id("err")
+	return g.StatementList(g.Raw(
+		jen.Comment("newUint8Array creates a Uint8Array with a copy of data. The bytes are set one").Line().
+			Comment("at a time, each a cgo call, as v8go doesn't expose the backing store.")),
+		g.FunctionDefinition{
+			Name:     "newUint8Array",
+			Args:     g.Arg(ctx, v8ScriptContextPtr).Arg(data, byteSlice),
+			RtnTypes: g.List(v8ObjectPtr, g.Id("error")),
+			Body: g.StatementList(
+				g.AssignMany(g.List(constructor, err), g.NewValue("uint8ArrayConstructor").Call(ctx)),
+				ReturnOnError{},
+				g.AssignMany(g.List(length, err), g.NewValuePackage("NewValue", v8).Call(
+					ctx.Field("host").Field("iso"),
+					g.Raw(jen.Uint32().Call(jen.Len(data.Generate()))),
+				)),
+				ReturnOnError{},
+				g.AssignMany(g.List(array, err), constructor.Method("NewInstance").Call(length)),
+				ReturnOnError{},
+				g.Raw(jen.For(jen.List(jen.Id("i"), jen.Id("b")).Op(":=").Range().Add(data.Generate())).Block(
+					jen.If(
+						jen.Err().Op(":=").Add(array.Method("SetIdx").Call(
+							g.Raw(jen.Uint32().Call(jen.Id("i"))),
+							g.Raw(jen.Uint32().Call(jen.Id("b"))),
+						).Generate()),
+						jen.Err().Op("!=").Nil(),
+					).Block(jen.Return(jen.Nil(), jen.Err())),
+				)),
+				g.Return(array, g.Nil),
+			),
+		})
+}
+
+// v8BufferSourceBytes generates the function copying the bytes of an
+// ArrayBuffer, or of the range of the buffer viewed by an ArrayBufferView. The
+// value must already have been checked to be one of these.
+func v8BufferSourceBytes() g.Generator {
+	ctx := g.NewValue("ctx")
+	val := g.NewValue("val")
+	allowShared := g.NewValue("allowShared")
+	obj := g.NewValue("obj")
+	buffer := g.NewValue("buffer")
+	bufferObj := g.NewValue("bufferObj")
+	detached := g.NewValue("detached")
+	offset := g.NewValue("offset")
+	length := g.NewValue("length")
+	constructor := g.NewValue("constructor")
+	view := g.NewValue("view")
+	bytes := g.NewValue("bytes")
+	err := g.Id("err")
+	assign := func(v g.Generator, expr g.Generator) g.Generator {
+		return g.StatementList(g.AssignMany(g.List(v, err), expr), ReturnOnError{})
+	}
+	return g.StatementList(g.Raw(
+		jen.Comment("bufferSourceBytes returns a copy of the bytes of an ArrayBuffer, or of the range").Line().
+			Comment("of the buffer viewed by an ArrayBufferView. The bytes are read one at a time,").Line().
+			Comment("each a cgo call, as v8go doesn't expose the backing store.")),
+		g.FunctionDefinition{
+			Name: "bufferSourceBytes",
+			Args: g.Arg(ctx, v8ScriptContextPtr).
+				Arg(val, v8Value).
+				Arg(allowShared, g.Id("bool")),
+			RtnTypes: g.List(byteSlice, g.Id("error")),
+			Body: g.StatementList(
+				assign(obj, val.Method("AsObject").Call()),
+				g.Assign(buffer, val),
+				g.IfStmt{
+					Condition: val.Method("IsArrayBufferView").Call(),
+					Block: g.IfStmt{
+						Condition: g.Raw(
+							jen.List(buffer.Generate(), err.Generate()).Op("=").
+								Add(obj.Method("Get").Call(g.Lit("buffer")).Generate()).Op(";").
+								Add(err.Generate()).Op("!=").Nil(),
+						),
+						Block: g.Return(g.Nil, err),
+					},
+				},
+				g.IfStmt{
+					Condition: g.Raw(
+						buffer.Method("IsSharedArrayBuffer").Call().Generate().
+							Op("&&").Op("!").Add(allowShared.Generate()),
+					),
+					Block: g.Return(g.Nil, v8TypeError(ctx, g.Lit("The ArrayBuffer must not be shared"))),
+				},
+				assign(bufferObj, buffer.Method("AsObject").Call()),
+				assign(detached, bufferObj.Method("Get").Call(g.Lit("detached"))),
+				g.IfStmt{
+					Condition: detached.Method("Boolean").Call(),
+					Block:     g.Return(g.Nil, v8TypeError(ctx, g.Lit("The ArrayBuffer is detached"))),
+				},
+				assign(offset, obj.Method("Get").Call(g.Lit("byteOffset"))),
+				assign(length, obj.Method("Get").Call(g.Lit("byteLength"))),
+				assign(constructor, g.NewValue("uint8ArrayConstructor").Call(ctx)),
+				assign(view, constructor.Method("NewInstance").Call(buffer, offset, length)),
+				g.Assign(bytes, g.Raw(jen.Make(jen.Index().Byte(), length.Method("Uint32").Call().Generate()))),
+				g.Raw(jen.For(jen.Id("i").Op(":=").Range().Add(bytes.Generate())).Block(
+					jen.List(jen.Id("b"), jen.Err()).Op(":=").
+						Add(view.Method("GetIdx").Call(g.Raw(jen.Uint32().Call(jen.Id("i")))).Generate()),
+					ReturnOnError{}.Generate(),
+					jen.Add(bytes.Generate()).Index(jen.Id("i")).Op("=").Byte().Call(
+						jen.Id("b").Dot("Uint32").Call(),
+					),
+				)),
+				g.Return(bytes, g.Nil),
+			),
+		})
+}
+
+func v8TypeError(ctx g.Value, msg g.Generator) g.Generator {
+	return g.NewValuePackage("NewTypeError", v8).Call(ctx.Field("host").Field("iso"), msg)
+}
+
+// notAny generates a condition that is true when none of the conditions are.
+func notAny(conditions []jen.Code) *jen.Statement {
+	if len(conditions) == 1 {
+		return jen.Op("!").Add(conditions[0])
+	}
+	result := jen.Add(conditions[0])
+	for _, c := range conditions[1:] {
+		result = result.Op("||").Add(c)
+	}
+	return jen.Op("!").Parens(result)
+}
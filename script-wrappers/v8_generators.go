@@ -19,21 +19,93 @@ func (t NewV8FunctionTemplate) Generate() *jen.Statement {
 	return jen.Qual(v8, "NewFunctionTemplateWithError").Call(t.iso.Generate(), t.f.Generate())
 }
 
+// V8TargetGenerators generates the wrappers for the V8 script host. V8
+// implements [TargetGenerators] directly, rather than [EngineTarget], as
+// [EngineTargetGenerators] decodes each argument to a single Go value, and
+// calls one Go method, where the V8 wrappers:
+//
+//   - return errors, so each argument is decoded to a value and an error,
+//     which are checked after all arguments are read.
+//   - call the Go method matching the number of arguments passed, e.g.,
+//     Method() or MethodArg(arg), for optional arguments without a default.
+//   - create a function template for each constructor and wrapper function,
+//     and require the script context for some operations.
+//
+// Moving V8 to [EngineTarget] requires the target to generate the dispatch of
+// the whole call, not only the conversion of each argument.
 type V8TargetGenerators struct{}
 
 func (_ V8TargetGenerators) CreateJSConstructorGenerator(data ESConstructorData) g.Generator {
 	return CreateV8Generator(data)
 }
 
+// CreateErrorMapper generates the mapError function, converting errors
+// returned from Go to DOMExceptions using the host's newDOMException function.
+func (_ V8TargetGenerators) CreateErrorMapper(mappings []ErrorMapping) g.Generator {
+	err := g.Id("err")
+	return g.StatementList(
+		g.Raw(jen.Comment("mapError converts an error returned from Go code to a DOMException if")),
+		g.Raw(jen.Comment("the error has a known mapping. Other errors are returned unchanged.")),
+		g.FunctionDefinition{
+			Name:     "mapError",
+			Args:     g.Arg(scriptHost, scriptHostPtr).Arg(err, g.Id("error")),
+			RtnTypes: g.List(g.Id("error")),
+			Body: g.StatementList(
+				ErrorMappingChecks(mappings, err, func(m ErrorMapping) g.Generator {
+					return g.Return(V8NewDOMException(scriptHost, err, m))
+				}),
+				g.Return(err),
+			),
+		},
+	)
+}
+
+// CreateNumericDecoders generates the decoders for all numeric types. The
+// decoders have the signature expected by tryParseArg, and conversion errors are
+// returned as a TypeError.
+func (_ V8TargetGenerators) CreateNumericDecoders() g.Generator {
+	ctx := g.NewValue("ctx")
+	val := g.NewValue("val")
+	x := g.Id("x")
+	err := g.NewValue("err")
+	result := g.StatementList(CreateNumericConversions())
+	for _, d := range NumericDecoders() {
+		result.Append(g.Line, g.FunctionDefinition{
+			Name:     d.Name(),
+			Args:     g.Arg(ctx, g.NewType("V8ScriptContext").Pointer()).Arg(val, v8Value),
+			RtnTypes: g.List(g.Id(d.Type.GoType), g.Id("error")),
+			Body: g.StatementList(
+				g.AssignMany(g.List(x, err), d.Convert(val.Method("Number").Call())),
+				g.IfStmt{
+					Condition: g.Neq{Lhs: err, Rhs: g.Nil},
+					Block: g.Return(g.Lit(0), g.NewValuePackage("NewTypeError", v8).Call(
+						ctx.Field("host").Field("iso"), err.Method("Error").Call(),
+					)),
+				},
+				g.Return(g.NewValue(d.Type.GoType).Call(x), g.Nil),
+			),
+		})
+	}
+	return result
+}
+
+func V8NewDOMException(scriptHost g.Generator, err g.Generator, m ErrorMapping) g.Generator {
+	args := append(g.List(scriptHost), NewDOMExceptionArgs(err, m)...)
+	return g.NewValue("newDOMException").Call(args...)
+}
+
 func CreateV8Generator(data ESConstructorData) g.Generator {
-	generator := g.StatementList()
-	if !data.Spec.SkipPrototypeRegistration {
-		generator.Append(
-			CreateInitFunction(data),
-			g.Line,
+	if data.Namespace {
+		return CreateV8NamespaceGenerator(data)
+	}
+	if data.Mixin {
+		return g.StatementList(
+			CreateV8WrapperTypeGenerator(data),
+			CreateIncluderCheck(data, data.Receiver, v8WrapperTypeName(data)),
+			CreateV8WrapperMethods(data),
 		)
 	}
-
+	generator := g.StatementList()
 	generator.Append(
 		CreateV8Constructor(data),
 		CreateV8ConstructorWrapper(data),
@@ -50,40 +122,140 @@ func CreateV8Generator(data ESConstructorData) g.Generator {
 	return generator
 }
 
-func CreateInitFunction(data ESConstructorData) g.Generator {
-	return g.FunctionDefinition{
-		Name: "init",
-		Body: g.NewValue("registerJSClass").Call(
-			g.Lit(data.Spec.TypeName),
-			g.Lit(data.Inheritance),
-			g.Id(prototypeFactoryFunctionName(data))),
+// CreateJSClassRegistrations generates the init function registering all
+// classes with the script host, in the order returned by [SortJSClasses].
+func (_ V8TargetGenerators) CreateJSClassRegistrations(classes []JSClass) g.Generator {
+	body := g.StatementList()
+	for _, c := range classes {
+		body.Append(g.NewValue("registerJSClass").Call(
+			g.Lit(c.Name()),
+			g.Lit(c.Inheritance()),
+			g.Id(prototypeFactoryFunctionName(c.Data)),
+		))
 	}
+	return g.FunctionDefinition{Name: "init", Body: body}
 }
 
-func CreateV8WrapperTypeGenerator(data ESConstructorData) g.Generator {
-	typeNameBase := fmt.Sprintf("%sV8Wrapper", data.Name())
-	typeName := g.NewType(lowerCaseFirstLetter(typeNameBase))
-	constructorName := fmt.Sprintf("new%s", typeNameBase)
-	innerType := g.NewTypePackage(data.Name(), data.GetInternalPackage())
-	wrapperStruct := g.NewStruct(typeName)
-	wrapperStruct.Embed(g.NewType("nodeV8WrapperBase").TypeParam(innerType))
+// CreateConverterStubs generates stubs of the missing converters, with the
+// signatures used by the v8 wrappers.
+func (_ V8TargetGenerators) CreateConverterStubs(m ConverterManifest) g.Generator {
+	ctx := jen.Id("ctx").Op("*").Id("V8ScriptContext")
+	v8Value := jen.Op("*").Qual(v8, "Value")
+	return createConverterStubs(m, func(kind ConverterKind) ([]jen.Code, []jen.Code) {
+		switch kind {
+		case ConverterDecoder:
+			return []jen.Code{ctx, jen.Id("v").Add(v8Value)}, []jen.Code{jen.Any(), jen.Error()}
+		case ConverterEncoder:
+			return []jen.Code{ctx, jen.Id("v").Any()}, []jen.Code{v8Value, jen.Error()}
+		default:
+			return nil, []jen.Code{jen.Any()}
+		}
+	})
+}
 
+// CreateV8NamespaceGenerator generates the code for an IDL namespace, e.g.,
+// `console`. The namespace is an object template with the operations as
+// function properties, which is installed on the global object. The operations
+// call package-level functions in the Go package implementing the namespace.
+func CreateV8NamespaceGenerator(data ESConstructorData) g.Generator {
+	typeName := g.NewType(data.WrapperTypeName)
+	wrapperStruct := g.NewStruct(typeName)
+	wrapperStruct.Embed(g.NewType("namespaceV8WrapperBase"))
 	wrapperConstructor := g.FunctionDefinition{
-		Name:     constructorName,
+		Name:     fmt.Sprintf("new%s", data.WrapperTypeBaseName),
 		Args:     g.Arg(scriptHost, scriptHostPtr),
 		RtnTypes: g.List(typeName.Pointer()),
 		Body: g.Return(
 			typeName.CreateInstance(
-				g.NewValue("newNodeV8WrapperBase").
-					TypeParam(innerType).
-					Call(scriptHost),
+				g.NewValue("newNamespaceV8WrapperBase").Call(scriptHost),
 			).Reference(),
 		),
 	}
+	return g.StatementList(
+		g.FunctionDefinition{
+			Name: "init",
+			Body: g.NewValue("registerJSNamespace").Call(
+				g.Lit(data.Spec.TypeName),
+				g.Id(namespaceFactoryFunctionName(data))),
+		},
+		g.Line,
+		wrapperStruct,
+		wrapperConstructor,
+		g.Line,
+		g.FunctionDefinition{
+			Name:     namespaceFactoryFunctionName(data),
+			Args:     g.Arg(scriptHost, scriptHostPtr),
+			RtnTypes: g.List(v8ObjectTemplatePtr),
+			Body:     CreateV8NamespaceBody(data),
+		},
+		CreateV8WrapperMethods(data),
+	)
+}
+
+func CreateV8NamespaceBody(data ESConstructorData) g.Generator {
+	builder := NewConstructorBuilder()
+	builder.Proto = v8PrototypeTemplate{g.NewValue("namespace")}
+	createWrapperFunction := g.NewValue(fmt.Sprintf("new%s", data.WrapperTypeBaseName))
+	return g.StatementList(
+		builder.v8Iso.Assign(scriptHost.Field("iso")),
+		g.Assign(builder.Wrapper, createWrapperFunction.Call(scriptHost)),
+		g.Assign(builder.Proto, g.NewValuePackage("NewObjectTemplate", v8).Call(builder.v8Iso)),
+		builder.InstallFunctionHandlers(data),
+		builder.InstallAttributeHandlers(data),
+		g.Line,
+		g.Return(builder.Proto),
+	)
+}
+
+func namespaceFactoryFunctionName(data ESConstructorData) string {
+	return fmt.Sprintf("create%sNamespace", data.InnerTypeName)
+}
+
+func v8WrapperTypeName(data ESConstructorData) string {
+	return lowerCaseFirstLetter(fmt.Sprintf("%sV8Wrapper", data.Name()))
+}
+
+func CreateV8WrapperTypeGenerator(data ESConstructorData) g.Generator {
+	typeNameBase := fmt.Sprintf("%sV8Wrapper", data.Name())
+	typeName := g.NewType(v8WrapperTypeName(data))
+	constructorName := fmt.Sprintf("new%s", typeNameBase)
+	innerType := g.NewTypePackage(data.Name(), data.GetInternalPackage())
+	wrapperStruct := g.NewStruct(typeName)
+	base := v8WrapperBase(data)
+	wrapperStruct.Embed(g.NewType(base).TypeParam(innerType))
+
+	args := g.Arg(scriptHost, scriptHostPtr)
+	values := g.List(g.NewValue("new" + upperCaseFirstLetter(base)).TypeParam(innerType).Call(scriptHost))
+	if data.Mixin {
+		// The wrapper of a mixin is created by each including interface, see
+		// [IllegalInvocationMessage]
+		interfaceName := g.Id(mixinInterfaceNameField)
+		wrapperStruct.Field(interfaceName, g.Id("string"))
+		args = args.Arg(interfaceName, g.Id("string"))
+		values = append(values, interfaceName)
+	}
+	wrapperConstructor := g.FunctionDefinition{
+		Name:     constructorName,
+		Args:     args,
+		RtnTypes: g.List(typeName.Pointer()),
+		Body:     g.Return(typeName.CreateInstance(values...).Reference()),
+	}
 
 	return g.StatementList(wrapperStruct, wrapperConstructor, g.Line)
 }
 
+// v8WrapperBase returns the name of the type embedded in the wrapper,
+// providing the script host, converters, and the instance of the wrapped
+// object. The wrapper of a mixin embeds nodeV8WrapperBase only if all the
+// including interfaces are Node types, and otherwise handleReffedObject, e.g.,
+// for WindowOrWorkerGlobalScope, included by Window.
+func v8WrapperBase(data ESConstructorData) string {
+	if data.Mixin && !data.NodeMixin {
+		return "handleReffedObject"
+	}
+	return "nodeV8WrapperBase"
+}
+
 func CreateV8ConstructorWrapper(data ESConstructorData) JenGenerator {
 	var body g.Generator
 	if IsNodeType(data.InnerTypeName) {
@@ -139,43 +311,46 @@ func CreateV8FunctionTemplateCallbackBody(
 	debug := g.NewValuePackage("Debug", log).Call(
 		g.Lit(fmt.Sprintf("V8 Function call: %s.%s", data.Name(), op.Name)))
 	if op.NotImplemented {
-		errMsg := fmt.Sprintf(
-			"%s.%s: Not implemented. Create an issue: %s", data.Name(), op.Name, ISSUE_URL,
-		)
 		return g.StatementList(
 			debug,
-			g.Return(g.Nil, g.Raw(jen.Qual("errors", "New").Call(jen.Lit(errMsg)))))
+			g.Return(g.Nil, g.NewValue("notImplemented").Call(g.Lit(data.Name()), g.Lit(op.Name))))
+	}
+	if op.DefaultToJSON {
+		return g.StatementList(debug, CreateV8DefaultToJSONBody(data))
 	}
 	receiver := WrapperInstance{g.NewValue(data.Receiver)}
 	instance := g.NewValue("instance")
 	readArgsResult := ReadArguments(data, op)
-	err := g.Id("err0")
-	if len(op.Arguments) == 0 {
-		err = g.Id("err")
-	}
 	requireContext := false
+	invocation := V8InstanceInvocation{
+		Instance: &instance,
+		Receiver: receiver,
+	}
+	getInstance := V8BrandCheck(instance, data, op)
+	if data.Namespace {
+		invocation.Instance = nil
+		invocation.Package = data.GetInternalPackage()
+		getInstance = g.Noop
+	}
 	var CreateCall = func(functionName string, argnames []g.Generator, op ESOperation) g.Generator {
-		callInstance := V8InstanceInvocation{
-			Name:     functionName,
-			Args:     argnames,
-			Op:       op,
-			Instance: &instance,
-			Receiver: receiver,
-		}.GetGenerator()
+		invocation.Name = functionName
+		invocation.Args = argnames
+		invocation.Op = op
+		callInstance := invocation.GetGenerator()
 		requireContext = requireContext || callInstance.RequireContext
 		return callInstance.Generator
 	}
 	statements := g.StatementList(
 		debug,
 		AssignArgs(data, op),
-		GetInstanceAndError(instance, err, data),
+		getInstance,
 		readArgsResult,
 		CreateV8WrapperMethodInstanceInvocations(
 			data,
 			op,
 			idlNameToGoName(op.Name),
 			readArgsResult.Args,
-			err,
+			nil,
 			CreateCall,
 			true,
 		),
@@ -186,6 +361,45 @@ func CreateV8FunctionTemplateCallbackBody(
 	return statements
 }
 
+// CreateV8DefaultToJSONBody creates the body of a default toJSON operation,
+// creating a new object with the values of all JSON attributes of the "this"
+// object.
+//
+// See also: https://webidl.spec.whatwg.org/#default-tojson-steps
+func CreateV8DefaultToJSONBody(data ESConstructorData) g.Generator {
+	receiver := WrapperInstance{g.NewValue(data.Receiver)}
+	info := g.NewValue("info")
+	result := g.NewValue("result")
+	this := g.NewValue("this")
+	name := g.NewValue("name")
+	value := g.NewValue("value")
+	names := make([]jen.Code, len(data.JSONAttributes))
+	for i, n := range data.JSONAttributes {
+		names[i] = jen.Lit(n)
+	}
+	return g.StatementList(
+		g.Assign(this, info.Method("This").Call()),
+		g.AssignMany(g.List(result, g.Id("err")),
+			g.NewValuePackage("NewObjectTemplate", v8).
+				Call(receiver.GetScriptHost().Field("iso")).
+				Method("NewInstance").Call(info.Method("Context").Call()),
+		),
+		ReturnOnError{},
+		g.Raw(jen.For(
+			jen.List(jen.Id("_"), name.Generate()).
+				Op(":=").Range().Index().String().Values(names...),
+		).Block(
+			g.AssignMany(g.List(value, g.Id("err")), this.Method("Get").Call(name)).Generate(),
+			ReturnOnError{}.Generate(),
+			jen.If(
+				jen.Id("err").Op("=").Add(result.Method("Set").Call(name, value).Generate()),
+				jen.Id("err").Op("!=").Nil(),
+			).Block(jen.Return(jen.Nil(), jen.Id("err"))),
+		)),
+		g.Return(result.Field("Value"), g.Nil),
+	)
+}
+
 func prototypeFactoryFunctionName(data ESConstructorData) string {
 	return fmt.Sprintf("create%sPrototype", data.InnerTypeName)
 }
@@ -208,6 +422,7 @@ func CreateV8ConstructorBody(data ESConstructorData) g.Generator {
 	statements := g.StatementList(
 		builder.v8Iso.Assign(scriptHost.Field("iso")),
 		g.Assign(builder.Wrapper, createWrapperFunction.Call(scriptHost)),
+		builder.CreateMixinWrappers(data),
 		g.Assign(constructor, builder.NewFunctionTemplateOfWrappedMethod("Constructor")),
 		g.Line,
 		g.Assign(builder.InstanceTmpl, constructor.GetInstanceTemplate()),
@@ -215,6 +430,8 @@ func CreateV8ConstructorBody(data ESConstructorData) g.Generator {
 		g.Line,
 		g.Assign(builder.Proto, constructor.GetPrototypeTemplate()),
 		builder.InstallFunctionHandlers(data),
+		builder.InstallStringifier(data),
+		builder.InstallAsyncIterator(data),
 		builder.InstallAttributeHandlers(data),
 		g.Line,
 	)
@@ -234,6 +451,9 @@ func CreateV8ConstructorWrapperBody(data ESConstructorData) g.Generator {
 	}
 	var readArgsResult V8ReadArguments
 	op := *data.Constructor
+	if op.NotImplemented {
+		return g.Return(g.Nil, g.NewValue("notImplemented").Call(g.Lit(data.Name()), g.Lit(op.Name)))
+	}
 	readArgsResult = ReadArguments(data, op)
 	statements := g.StatementList(
 		AssignArgs(data, op),
@@ -283,7 +503,7 @@ func CreateV8WrapperMethodInstanceInvocations(
 		functionName := baseFunctionName
 		for j, arg := range args {
 			if j < i {
-				if arg.Argument.OptionalInGo() {
+				if arg.Argument.OptionalInGo() && !arg.Argument.Variadic {
 					functionName += idlNameToGoName(arg.Argument.Name)
 				}
 			}
@@ -304,6 +524,9 @@ func CreateV8WrapperMethodInstanceInvocations(
 		callArgs := make([]g.Generator, i)
 		for idx, a := range currentArgs {
 			callArgs[idx] = a.ArgName
+			if a.Argument.Variadic {
+				callArgs[idx] = g.Raw(a.ArgName.Generate().Op("..."))
+			}
 		}
 		callInstance := createCallInstance(functionName, callArgs, op)
 		if i > 0 {
@@ -348,6 +571,9 @@ type V8InstanceInvocation struct {
 	Op       ESOperation
 	Instance *g.Value
 	Receiver WrapperInstance
+	// Package is the package containing the function to call when there is no
+	// instance, i.e., a namespace operation.
+	Package string
 }
 
 type V8InstanceInvocationResult struct {
@@ -381,7 +607,9 @@ func (c V8InstanceInvocation) PerformCall() (genRes V8InstanceInvocationResult)
 	}
 	list := g.StatementListStmt{}
 	var evaluation g.Value
-	if c.Instance == nil {
+	if c.Instance == nil && c.Package != "" {
+		evaluation = g.NewValuePackage(idlNameToGoName(c.Name), c.Package).Call(args...)
+	} else if c.Instance == nil {
 		evaluation = g.NewValue(idlNameToGoName(c.Name)).Call(args...)
 	} else {
 		evaluation = c.Instance.Method(idlNameToGoName(c.Name)).Call(args...)
@@ -395,38 +623,81 @@ func (c V8InstanceInvocation) PerformCall() (genRes V8InstanceInvocationResult)
 	return
 }
 
+// ReturnNullOnNil generates code returning JS null when the operation returns
+// a nullable interface type, and the Go method returned nil. Otherwise the
+// valueReturn is generated.
+func (c V8InstanceInvocation) ReturnNullOnNil(valueReturn g.Generator) g.Generator {
+	if !c.Op.ReturnsNullableInterface() {
+		return valueReturn
+	}
+	result := g.Id("result")
+	return g.StatementList(
+		g.IfStmt{
+			Condition: g.Eq{Lhs: result, Rhs: g.Nil},
+			Block: g.Return(
+				g.NewValuePackage("Null", v8).Call(c.Receiver.GetScriptHost().Field("iso")),
+				g.Nil,
+			),
+		},
+		valueReturn,
+	)
+}
+
+// ReturnCallError generates code returning the error from calling the Go
+// method, mapped to a DOMException if a mapping exists.
+func (c V8InstanceInvocation) ReturnCallError() g.Generator {
+	callErr := g.Id("callErr")
+	scriptHost := c.Receiver.GetScriptHost()
+	return g.StatementList(
+		ErrorMappingChecks(c.Op.MethodCustomization.ErrorMappings, callErr,
+			func(m ErrorMapping) g.Generator {
+				return g.Return(g.Nil, V8NewDOMException(scriptHost, callErr, m))
+			}),
+		g.Return(g.Nil, g.NewValue("mapError").Call(scriptHost, callErr)),
+	)
+}
+
 func (c V8InstanceInvocation) GetGenerator() V8InstanceInvocationResult {
 	genRes := c.PerformCall()
 	list := g.StatementList()
 	list.Append(genRes.Generator)
 	if !genRes.HasValue {
 		if genRes.HasError {
-			list.Append(g.Return(g.Nil, g.Id("callErr")))
-		} else {
-			list.Append(g.Return(g.Nil, g.Nil))
+			list.Append(g.IfStmt{
+				Condition: g.Neq{Lhs: g.Id("callErr"), Rhs: g.Nil},
+				Block:     c.ReturnCallError(),
+			})
 		}
+		list.Append(g.Return(g.Nil, g.Nil))
 	} else {
 		retType := c.Op.RetType
 		if retType.IsNode() {
 			genRes.RequireContext = true
-			valueReturn := (g.Return(g.Raw(jen.Id("ctx").Dot("getInstanceForNode").Call(jen.Id("result")))))
+			valueReturn := c.ReturnNullOnNil(
+				g.Return(g.Raw(jen.Id("ctx").Dot("getInstanceForNode").Call(jen.Id("result")))))
 			if genRes.HasError {
 				list.Append(g.IfStmt{
 					Condition: g.Neq{Lhs: g.Id("callErr"), Rhs: g.Nil},
-					Block:     g.Return(g.Nil, g.Id("callErr")),
+					Block:     c.ReturnCallError(),
 					Else:      valueReturn,
 				})
 			} else {
 				list.Append(valueReturn)
 			}
 		} else {
-			converter := c.Op.Encoder()
+			encoder := g.Generator(c.Receiver.Method(c.Op.Encoder()))
+			if t := c.Op.GenericReturnType; t != nil {
+				encoder = t.Encoder(c.Receiver.Value, HostArgs{})
+			} else if e := c.Op.GeneratedEncoder(); e != nil {
+				encoder = e
+			}
 			genRes.RequireContext = true
-			valueReturn := g.Return(c.Receiver.Method(converter).Call(g.Id("ctx"), g.Id("result")))
+			valueReturn := c.ReturnNullOnNil(
+				g.Return(g.ValueOf(encoder).Call(g.Id("ctx"), g.Id("result"))))
 			if genRes.HasError {
 				list.Append(g.IfStmt{
 					Condition: g.Neq{Lhs: g.Id("callErr"), Rhs: g.Nil},
-					Block:     g.Return(g.Nil, g.Id("callErr")),
+					Block:     c.ReturnCallError(),
 					Else:      valueReturn,
 				})
 			} else {
@@ -493,28 +764,37 @@ func ReadArguments(data ESConstructorData, op ESOperation) (res V8ReadArguments)
 			Index:    i,
 		})
 
-		var convertNames []string
-		if arg.Type != "" {
-			convertNames = []string{fmt.Sprintf("decode%s", idlNameToGoName(arg.Type))}
+		receiver := g.NewValue(data.Receiver)
+		var converters []g.Generator
+		if arg.GenericType != nil {
+			converters = g.List(arg.GenericType.Decoder(receiver, HostArgs{}))
+		} else if decoder := arg.GeneratedDecoder(); decoder != nil {
+			converters = g.List(decoder)
+		} else if arg.Type != "" {
+			converters = g.List(receiver.Field(fmt.Sprintf("decode%s", idlNameToGoName(arg.Type))))
 		} else {
 			types := arg.IdlType.IdlType.IType.Types
-			convertNames = make([]string, len(types))
+			converters = make([]g.Generator, len(types))
 			for i, t := range types {
-				convertNames[i] = fmt.Sprintf("decode%s", t.IType.TypeName)
+				converters[i] = receiver.Field(fmt.Sprintf("decode%s", t.IType.TypeName))
 			}
 		}
 
 		gConverters := []g.Generator{g.Id("args"), g.Lit(i)}
 		defaultName, hasDefault := arg.DefaultValueInGo()
 		if hasDefault {
-			gConverters = append(gConverters, g.NewValue(data.Receiver).Field(defaultName))
-		}
-		for _, n := range convertNames {
-			gConverters = append(gConverters, g.NewValue(data.Receiver).Field(n))
+			gConverters = append(gConverters, receiver.Field(defaultName))
 		}
+		gConverters = append(gConverters, converters...)
 		if hasDefault {
 			statements.Append(g.AssignMany(g.List(argName, errName),
 				g.NewValue("tryParseArgWithDefault").Call(gConverters...)))
+		} else if arg.Nullable {
+			statements.Append(g.AssignMany(g.List(argName, errName),
+				g.NewValue("tryParseNullableArg").Call(gConverters...)))
+		} else if arg.Variadic {
+			statements.Append(g.AssignMany(g.List(argName, errName),
+				g.NewValue("tryParseVariadicArg").Call(gConverters...)))
 		} else {
 			statements.Append(g.AssignMany(
 				g.List(argName, errName),
@@ -525,9 +805,40 @@ func ReadArguments(data ESConstructorData, op ESOperation) (res V8ReadArguments)
 	return
 }
 
+// V8BrandCheck generates code that retrieves the Go value wrapped by the JS
+// "this" object, and throws a TypeError if it doesn't implement the interface
+// of the wrapper. Go interfaces embed the interfaces they inherit from, so a
+// value of a derived type passes the check. Other errors retrieving the value,
+// e.g., a missing script context, are returned as they are.
+//
+// This is what makes, e.g., `Node.prototype.appendChild.call({}, x)` throw
+// "Illegal invocation", as browsers do.
+func V8BrandCheck(id g.Generator, data ESConstructorData, op ESOperation) g.Generator {
+	receiver := WrapperInstance{g.NewValue(data.Receiver)}
+	err := g.Id("err")
+	ok := g.Id("ok")
+	wrapped := g.Id("wrapped")
+	innerType := g.NewTypePackage(data.Name(), data.GetInternalPackage())
+	return g.StatementList(
+		GetInstanceAndError(wrapped, err, data),
+		g.IfStmt{
+			Condition: g.Neq{Lhs: err, Rhs: g.Nil},
+			Block:     g.Return(g.Nil, err),
+		},
+		g.AssignMany(g.List(id, ok), g.Raw(wrapped.Generate().Assert(innerType.Generate()))),
+		g.IfStmt{
+			Condition: BrandCheckFailed(receiver.Value, data, id, ok),
+			Block: g.Return(g.Nil, g.NewValuePackage("NewTypeError", v8).Call(
+				receiver.GetScriptHost().Field("iso"),
+				IllegalInvocationMessage(receiver.Value, data, op),
+			)),
+		},
+	)
+}
+
 func GetInstanceAndError(id g.Generator, errId g.Generator, data ESConstructorData) g.Generator {
 	return g.AssignMany(
 		g.List(id, errId),
-		g.NewValue(data.Receiver).Field("getInstance").Call(g.Id("info")),
+		g.NewValue(data.Receiver).Field("getWrapped").Call(g.Id("info")),
 	)
 }
@@ -0,0 +1,125 @@
+package wrappers
+
+import (
+	"github.com/dave/jennifer/jen"
+	g "github.com/gost-dom/generators"
+)
+
+// CreatePromises generates toPromise, and toVoidPromise, the encoders
+// converting the functions returned from Go for `Promise<T>`, and
+// `Promise<undefined>`, to JS promises, see [ESType]. The function is called
+// in a new goroutine, and the promise is settled in a task queued in the
+// script context when it returns.
+//
+// An error from the function rejects the promise with a JS Error with the
+// message of the Go error.
+func (_ V8TargetGenerators) CreatePromises() g.Generator {
+	return g.Raw(jen.Add(
+		v8ToPromise(),
+		jen.Line().Line(),
+		v8ToVoidPromise(),
+		jen.Line().Line(),
+		v8RejectPromise(),
+	))
+}
+
+func v8ToPromise() *jen.Statement {
+	ctx := jen.Id("ctx")
+	ctxPtr := jen.Op("*").Id("V8ScriptContext")
+	v8Val := jen.Op("*").Qual(v8, "Value")
+	resolver := jen.Id("resolver")
+	funcType := jen.Func().Params().Params(jen.Id("T"), jen.Error())
+	encoderType := jen.Func().Params(ctxPtr.Clone(), jen.Id("T")).Params(v8Val.Clone(), jen.Error())
+	return jen.Comment("toPromise returns an encoder converting a function to a JS promise, settled").
+		Line().Comment("with the result of the function encoded using encode.").
+		Line().Func().Id("toPromise").Types(jen.Id("T").Any()).
+		Params(jen.Id("encode").Add(encoderType)).
+		Func().Params(ctxPtr.Clone(), funcType.Clone()).Params(v8Val.Clone(), jen.Error()).
+		Block(jen.Return(jen.Func().Params(
+			ctx.Clone().Add(ctxPtr.Clone()),
+			jen.Id("f").Add(funcType.Clone()),
+		).Params(v8Val.Clone(), jen.Error()).Block(
+			jen.List(resolver.Clone(), jen.Err()).Op(":=").Qual(v8, "NewPromiseResolver").Call(ctx.Clone().Dot("v8ctx")),
+			jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err())),
+			jen.Go().Func().Params().Block(
+				jen.List(jen.Id("value"), jen.Err()).Op(":=").Id("f").Call(),
+				ctx.Clone().Dot("queueTask").Call(jen.Func().Params().Block(
+					jen.If(jen.Err().Op("==").Nil()).Block(
+						jen.Var().Id("encoded").Add(v8Val.Clone()),
+						jen.If(
+							jen.List(jen.Id("encoded"), jen.Err()).Op("=").Id("encode").Call(ctx.Clone(), jen.Id("value")),
+							jen.Err().Op("==").Nil(),
+						).Block(
+							resolver.Clone().Dot("Resolve").Call(jen.Id("encoded")),
+							jen.Return(),
+						),
+					),
+					jen.Id("rejectPromise").Call(ctx.Clone(), resolver.Clone(), jen.Err()),
+				)),
+			).Call(),
+			jen.Return(resolver.Clone().Dot("GetPromise").Call().Dot("Value"), jen.Nil()),
+		)))
+}
+
+func v8ToVoidPromise() *jen.Statement {
+	ctx := jen.Id("ctx")
+	ctxPtr := jen.Op("*").Id("V8ScriptContext")
+	v8Val := jen.Op("*").Qual(v8, "Value")
+	return jen.Comment("toVoidPromise converts a function without a result to a JS promise, resolved").
+		Line().Comment("with undefined.").
+		Line().Func().Id("toVoidPromise").Params(
+		ctx.Clone().Add(ctxPtr.Clone()),
+		jen.Id("f").Func().Params().Error(),
+	).Params(v8Val.Clone(), jen.Error()).Block(
+		jen.Id("encode").Op(":=").Func().Params(ctx.Clone().Add(ctxPtr.Clone()), jen.Id("_").Struct()).
+			Params(v8Val.Clone(), jen.Error()).Block(
+			jen.Return(jen.Qual(v8, "Undefined").Call(ctx.Clone().Dot("host").Dot("iso")), jen.Nil()),
+		),
+		jen.Return(jen.Id("toPromise").Call(jen.Id("encode")).Call(
+			ctx.Clone(),
+			jen.Func().Params().Params(jen.Struct(), jen.Error()).Block(
+				jen.Return(jen.Struct().Values(), jen.Id("f").Call()),
+			),
+		)),
+	)
+}
+
+func v8RejectPromise() *jen.Statement {
+	ctx := jen.Id("ctx")
+	resolver := jen.Id("resolver")
+	return jen.Comment("rejectPromise rejects the promise with an Error with the message of reason. If").
+		Line().Comment("the Error can't be created, the promise is rejected with the message.").
+		Line().Func().Id("rejectPromise").Params(
+		ctx.Clone().Op("*").Id("V8ScriptContext"),
+		resolver.Clone().Op("*").Qual(v8, "PromiseResolver"),
+		jen.Id("reason").Error(),
+	).Block(
+		jen.Comment("Converting a string doesn't fail"),
+		jen.List(jen.Id("message"), jen.Id("_")).Op(":=").Qual(v8, "NewValue").Call(
+			ctx.Clone().Dot("host").Dot("iso"), jen.Id("reason").Dot("Error").Call(),
+		),
+		jen.If(
+			jen.List(jen.Id("errorValue"), jen.Err()).Op(":=").Id("newError").Call(ctx.Clone(), jen.Id("message")),
+			jen.Err().Op("==").Nil(),
+		).Block(
+			resolver.Clone().Dot("Reject").Call(jen.Id("errorValue")),
+			jen.Return(),
+		),
+		resolver.Clone().Dot("Reject").Call(jen.Id("message")),
+	).
+		Line().Line().
+		Comment("newError creates a JS Error with the message.").
+		Line().Func().Id("newError").Params(
+		ctx.Clone().Op("*").Id("V8ScriptContext"),
+		jen.Id("message").Op("*").Qual(v8, "Value"),
+	).Params(jen.Op("*").Qual(v8, "Value"), jen.Error()).Block(
+		jen.List(jen.Id("errorCtor"), jen.Err()).Op(":=").
+			Add(ctx.Clone()).Dot("v8ctx").Dot("Global").Call().Dot("Get").Call(jen.Lit("Error")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err())),
+		jen.List(jen.Id("constructor"), jen.Err()).Op(":=").Id("errorCtor").Dot("AsFunction").Call(),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err())),
+		jen.List(jen.Id("errorValue"), jen.Err()).Op(":=").Id("constructor").Dot("NewInstance").Call(jen.Id("message")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err())),
+		jen.Return(jen.Id("errorValue").Dot("Value"), jen.Nil()),
+	)
+}
@@ -0,0 +1,271 @@
+package wrappers
+
+import (
+	"github.com/dave/jennifer/jen"
+	g "github.com/gost-dom/generators"
+)
+
+// CreateSequenceConverters generates the converters of the generic types, see
+// [ESType]. A sequence is decoded from any JS iterable, by Array.from, and a
+// record from the own enumerable properties of an object, by Object.keys, as
+// v8go doesn't expose the iteration of values, and properties. The elements,
+// keys, and values are converted by the converters of the type parameters.
+//
+// A FrozenArray is encoded as a frozen JS array, and the properties of a
+// record are created in the order of the sorted keys, as Go maps don't keep the
+// insertion order.
+func (_ V8TargetGenerators) CreateSequenceConverters() g.Generator {
+	return g.Raw(jen.Add(
+		v8BuiltinFunction(),
+		jen.Line().Line(),
+		v8ArrayItems(),
+		jen.Line().Line(),
+		v8DecodeSequence(),
+		jen.Line().Line(),
+		v8DecodeRecord(),
+		jen.Line().Line(),
+		v8ToSequence(),
+		jen.Line().Line(),
+		v8ToFrozenArray(),
+		jen.Line().Line(),
+		v8ToRecord(),
+	))
+}
+
+var (
+	v8ValuePtr    = jen.Op("*").Qual(v8, "Value")
+	v8ContextPtr  = jen.Op("*").Id("V8ScriptContext")
+	v8ReturnOnErr = jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err()))
+	v8CtxIso      = jen.Id("ctx").Dot("host").Dot("iso")
+)
+
+// v8DecoderType is the type of a decoder of T, e.g., "func(*V8ScriptContext,
+// *v8.Value) (T, error)".
+func v8DecoderType(t jen.Code) *jen.Statement {
+	return jen.Func().Params(v8ContextPtr.Clone(), v8ValuePtr.Clone()).Params(t, jen.Error())
+}
+
+// v8EncoderType is the type of an encoder of T, e.g., "func(*V8ScriptContext,
+// T) (*v8.Value, error)".
+func v8EncoderType(t jen.Code) *jen.Statement {
+	return jen.Func().Params(v8ContextPtr.Clone(), t).Params(v8ValuePtr.Clone(), jen.Error())
+}
+
+// v8GetBuiltin returns the statements assigning the function with the name of
+// the global JS object to a variable with the name, e.g., "from" for Array.from.
+func v8GetBuiltin(object string, name string) *jen.Statement {
+	return jen.List(jen.Id(name), jen.Err()).Op(":=").
+		Id("builtinFunction").Call(jen.Id("ctx"), jen.Lit(object), jen.Lit(name)).
+		Line().Add(v8ReturnOnErr.Clone())
+}
+
+// v8CallBuiltin returns the expression calling the function in the variable
+// with the name, see [v8GetBuiltin].
+func v8CallBuiltin(name string, args ...jen.Code) *jen.Statement {
+	return jen.Id(name).Dot("Call").Call(append([]jen.Code{
+		jen.Qual(v8, "Undefined").Call(v8CtxIso.Clone()),
+	}, args...)...)
+}
+
+func v8BuiltinFunction() *jen.Statement {
+	return jen.Comment("builtinFunction returns the function with the name of the global JS object,").
+		Line().Comment("e.g., Array.from.").
+		Line().Func().Id("builtinFunction").Params(
+		jen.Id("ctx").Add(v8ContextPtr.Clone()),
+		jen.List(jen.Id("object"), jen.Id("name")).String(),
+	).Params(jen.Op("*").Qual(v8, "Function"), jen.Error()).Block(
+		jen.List(jen.Id("global"), jen.Err()).Op(":=").
+			Id("ctx").Dot("v8ctx").Dot("Global").Call().Dot("Get").Call(jen.Id("object")),
+		v8ReturnOnErr.Clone(),
+		jen.List(jen.Id("globalObj"), jen.Err()).Op(":=").Id("global").Dot("AsObject").Call(),
+		v8ReturnOnErr.Clone(),
+		jen.List(jen.Id("f"), jen.Err()).Op(":=").Id("globalObj").Dot("Get").Call(jen.Id("name")),
+		v8ReturnOnErr.Clone(),
+		jen.Return(jen.Id("f").Dot("AsFunction").Call()),
+	)
+}
+
+func v8ArrayItems() *jen.Statement {
+	return jen.Comment("arrayItems returns the items of a JS array.").
+		Line().Func().Id("arrayItems").Params(jen.Id("array").Add(v8ValuePtr.Clone())).
+		Params(jen.Index().Add(v8ValuePtr.Clone()), jen.Error()).Block(
+		jen.List(jen.Id("obj"), jen.Err()).Op(":=").Id("array").Dot("AsObject").Call(),
+		v8ReturnOnErr.Clone(),
+		jen.List(jen.Id("length"), jen.Err()).Op(":=").Id("obj").Dot("Get").Call(jen.Lit("length")),
+		v8ReturnOnErr.Clone(),
+		jen.Id("items").Op(":=").Make(jen.Index().Add(v8ValuePtr.Clone()), jen.Id("length").Dot("Uint32").Call()),
+		jen.For(jen.Id("i").Op(":=").Range().Id("items")).Block(
+			jen.If(
+				jen.List(jen.Id("items").Index(jen.Id("i")), jen.Err()).Op("=").
+					Id("obj").Dot("GetIdx").Call(jen.Uint32().Call(jen.Id("i"))),
+				jen.Err().Op("!=").Nil(),
+			).Block(jen.Return(jen.Nil(), jen.Err())),
+		),
+		jen.Return(jen.Id("items"), jen.Nil()),
+	)
+}
+
+func v8DecodeSequence() *jen.Statement {
+	notIterable := jen.Return(jen.Nil(), jen.Qual(v8, "NewTypeError").Call(
+		v8CtxIso.Clone(), jen.Lit("The value is not iterable"),
+	))
+	sliceType := jen.Index().Id("T")
+	return jen.Comment("decodeSequence returns a decoder converting a JS iterable to a slice,").
+		Line().Comment("decoding each value using decode.").
+		Line().Func().Id("decodeSequence").Types(jen.Id("T").Any()).
+		Params(jen.Id("decode").Add(v8DecoderType(jen.Id("T")))).
+		Add(v8DecoderType(sliceType.Clone())).Block(
+		jen.Return(jen.Func().Params(
+			jen.Id("ctx").Add(v8ContextPtr.Clone()),
+			jen.Id("val").Add(v8ValuePtr.Clone()),
+		).Params(sliceType.Clone(), jen.Error()).Block(
+			jen.If(jen.Op("!").Id("val").Dot("IsObject").Call()).Block(notIterable.Clone()),
+			jen.List(jen.Id("obj"), jen.Err()).Op(":=").Id("val").Dot("AsObject").Call(),
+			v8ReturnOnErr.Clone(),
+			jen.List(jen.Id("method"), jen.Err()).Op(":=").Id("obj").Dot("GetSymbol").Call(
+				jen.Qual(v8, "SymbolIterator").Call(v8CtxIso.Clone()),
+			),
+			v8ReturnOnErr.Clone(),
+			jen.If(jen.Op("!").Id("method").Dot("IsFunction").Call()).Block(notIterable.Clone()),
+			jen.Comment("Array.from iterates the value using Symbol.iterator"),
+			v8GetBuiltin("Array", "from"),
+			jen.List(jen.Id("array"), jen.Err()).Op(":=").Add(v8CallBuiltin("from", jen.Id("val"))),
+			v8ReturnOnErr.Clone(),
+			jen.List(jen.Id("items"), jen.Err()).Op(":=").Id("arrayItems").Call(jen.Id("array")),
+			v8ReturnOnErr.Clone(),
+			jen.Id("result").Op(":=").Make(sliceType.Clone(), jen.Len(jen.Id("items"))),
+			jen.For(jen.List(jen.Id("i"), jen.Id("item")).Op(":=").Range().Id("items")).Block(
+				jen.If(
+					jen.List(jen.Id("result").Index(jen.Id("i")), jen.Err()).Op("=").
+						Id("decode").Call(jen.Id("ctx"), jen.Id("item")),
+					jen.Err().Op("!=").Nil(),
+				).Block(jen.Return(jen.Nil(), jen.Err())),
+			),
+			jen.Return(jen.Id("result"), jen.Nil()),
+		)),
+	)
+}
+
+func v8DecodeRecord() *jen.Statement {
+	mapType := jen.Map(jen.Id("K")).Id("V")
+	return jen.Comment("decodeRecord returns a decoder converting the own enumerable properties of a").
+		Line().Comment("JS object to a map, decoding the keys and values using decodeKey and").
+		Line().Comment("decodeValue.").
+		Line().Func().Id("decodeRecord").Types(jen.Id("K").Comparable(), jen.Id("V").Any()).Params(
+		jen.Id("decodeKey").Add(v8DecoderType(jen.Id("K"))),
+		jen.Id("decodeValue").Add(v8DecoderType(jen.Id("V"))),
+	).Add(v8DecoderType(mapType.Clone())).Block(
+		jen.Return(jen.Func().Params(
+			jen.Id("ctx").Add(v8ContextPtr.Clone()),
+			jen.Id("val").Add(v8ValuePtr.Clone()),
+		).Params(mapType.Clone(), jen.Error()).Block(
+			jen.If(jen.Op("!").Id("val").Dot("IsObject").Call()).Block(
+				jen.Return(jen.Nil(), jen.Qual(v8, "NewTypeError").Call(
+					v8CtxIso.Clone(), jen.Lit("The value is not an object"),
+				)),
+			),
+			jen.List(jen.Id("obj"), jen.Err()).Op(":=").Id("val").Dot("AsObject").Call(),
+			v8ReturnOnErr.Clone(),
+			jen.Comment("Object.keys returns the names of the own enumerable properties"),
+			v8GetBuiltin("Object", "keys"),
+			jen.List(jen.Id("array"), jen.Err()).Op(":=").Add(v8CallBuiltin("keys", jen.Id("val"))),
+			v8ReturnOnErr.Clone(),
+			jen.List(jen.Id("names"), jen.Err()).Op(":=").Id("arrayItems").Call(jen.Id("array")),
+			v8ReturnOnErr.Clone(),
+			jen.Id("result").Op(":=").Make(mapType.Clone(), jen.Len(jen.Id("names"))),
+			jen.For(jen.List(jen.Id("_"), jen.Id("name")).Op(":=").Range().Id("names")).Block(
+				jen.List(jen.Id("key"), jen.Err()).Op(":=").Id("decodeKey").Call(jen.Id("ctx"), jen.Id("name")),
+				v8ReturnOnErr.Clone(),
+				jen.List(jen.Id("value"), jen.Err()).Op(":=").Id("obj").Dot("Get").Call(jen.Id("name").Dot("String").Call()),
+				v8ReturnOnErr.Clone(),
+				jen.If(
+					jen.List(jen.Id("result").Index(jen.Id("key")), jen.Err()).Op("=").
+						Id("decodeValue").Call(jen.Id("ctx"), jen.Id("value")),
+					jen.Err().Op("!=").Nil(),
+				).Block(jen.Return(jen.Nil(), jen.Err())),
+			),
+			jen.Return(jen.Id("result"), jen.Nil()),
+		)),
+	)
+}
+
+func v8ToSequence() *jen.Statement {
+	sliceType := jen.Index().Id("T")
+	return jen.Comment("toSequence returns an encoder converting a slice to a JS array, encoding each").
+		Line().Comment("value using encode.").
+		Line().Func().Id("toSequence").Types(jen.Id("T").Any()).
+		Params(jen.Id("encode").Add(v8EncoderType(jen.Id("T")))).
+		Add(v8EncoderType(sliceType.Clone())).Block(
+		jen.Return(jen.Func().Params(
+			jen.Id("ctx").Add(v8ContextPtr.Clone()),
+			jen.Id("values").Add(sliceType.Clone()),
+		).Params(v8ValuePtr.Clone(), jen.Error()).Block(
+			jen.List(jen.Id("constructor"), jen.Err()).Op(":=").
+				Id("ctx").Dot("v8ctx").Dot("Global").Call().Dot("Get").Call(jen.Lit("Array")),
+			v8ReturnOnErr.Clone(),
+			jen.List(jen.Id("arrayCtor"), jen.Err()).Op(":=").Id("constructor").Dot("AsFunction").Call(),
+			v8ReturnOnErr.Clone(),
+			jen.List(jen.Id("array"), jen.Err()).Op(":=").Id("arrayCtor").Dot("NewInstance").Call(),
+			v8ReturnOnErr.Clone(),
+			jen.For(jen.List(jen.Id("i"), jen.Id("v")).Op(":=").Range().Id("values")).Block(
+				jen.List(jen.Id("encoded"), jen.Err()).Op(":=").Id("encode").Call(jen.Id("ctx"), jen.Id("v")),
+				v8ReturnOnErr.Clone(),
+				jen.If(
+					jen.Err().Op(":=").Id("array").Dot("SetIdx").Call(jen.Uint32().Call(jen.Id("i")), jen.Id("encoded")),
+					jen.Err().Op("!=").Nil(),
+				).Block(jen.Return(jen.Nil(), jen.Err())),
+			),
+			jen.Return(jen.Id("array").Dot("Value"), jen.Nil()),
+		)),
+	)
+}
+
+func v8ToFrozenArray() *jen.Statement {
+	sliceType := jen.Index().Id("T")
+	return jen.Comment("toFrozenArray returns an encoder converting a slice to a frozen JS array,").
+		Line().Comment("encoding each value using encode.").
+		Line().Func().Id("toFrozenArray").Types(jen.Id("T").Any()).
+		Params(jen.Id("encode").Add(v8EncoderType(jen.Id("T")))).
+		Add(v8EncoderType(sliceType.Clone())).Block(
+		jen.Id("toArray").Op(":=").Id("toSequence").Call(jen.Id("encode")),
+		jen.Return(jen.Func().Params(
+			jen.Id("ctx").Add(v8ContextPtr.Clone()),
+			jen.Id("values").Add(sliceType.Clone()),
+		).Params(v8ValuePtr.Clone(), jen.Error()).Block(
+			jen.List(jen.Id("array"), jen.Err()).Op(":=").Id("toArray").Call(jen.Id("ctx"), jen.Id("values")),
+			v8ReturnOnErr.Clone(),
+			v8GetBuiltin("Object", "freeze"),
+			jen.Return(v8CallBuiltin("freeze", jen.Id("array"))),
+		)),
+	)
+}
+
+func v8ToRecord() *jen.Statement {
+	mapType := jen.Map(jen.String()).Id("V")
+	return jen.Comment("toRecord returns an encoder converting a map to a JS object, encoding each").
+		Line().Comment("value using encode.").
+		Line().Func().Id("toRecord").Types(jen.Id("V").Any()).
+		Params(jen.Id("encode").Add(v8EncoderType(jen.Id("V")))).
+		Add(v8EncoderType(mapType.Clone())).Block(
+		jen.Return(jen.Func().Params(
+			jen.Id("ctx").Add(v8ContextPtr.Clone()),
+			jen.Id("values").Add(mapType.Clone()),
+		).Params(v8ValuePtr.Clone(), jen.Error()).Block(
+			jen.List(jen.Id("obj"), jen.Err()).Op(":=").Qual(v8, "NewObjectTemplate").Call(v8CtxIso.Clone()).
+				Dot("NewInstance").Call(jen.Id("ctx").Dot("v8ctx")),
+			v8ReturnOnErr.Clone(),
+			jen.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Qual("slices", "Sorted").Call(
+				jen.Qual("maps", "Keys").Call(jen.Id("values")),
+			)).Block(
+				jen.List(jen.Id("encoded"), jen.Err()).Op(":=").
+					Id("encode").Call(jen.Id("ctx"), jen.Id("values").Index(jen.Id("key"))),
+				v8ReturnOnErr.Clone(),
+				jen.If(
+					jen.Err().Op(":=").Id("obj").Dot("Set").Call(jen.Id("key"), jen.Id("encoded")),
+					jen.Err().Op("!=").Nil(),
+				).Block(jen.Return(jen.Nil(), jen.Err())),
+			),
+			jen.Return(jen.Id("obj").Dot("Value"), jen.Nil()),
+		)),
+	)
+}
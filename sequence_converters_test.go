@@ -0,0 +1,16 @@
+package main
+
+import (
+	"github.com/gost-dom/code-gen/output"
+	wrappers "github.com/gost-dom/code-gen/script-wrappers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Goja sequence converters", func() {
+	It("convert each element between JS iterables, or objects, and Go slices, or maps", func() {
+		files := output.Memory{}
+		Expect(wrappers.NewGojaWrapperModuleGenerator().GenerateScriptWrappers(files)).To(Succeed())
+		runGenerated("goja-sequences", files, "sequences_generated.go")
+	})
+})
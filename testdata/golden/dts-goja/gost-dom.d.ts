@@ -0,0 +1,65 @@
+// This file is generated. Do not edit.
+
+interface Node extends EventTarget {
+	readonly nodeType: number;
+	readonly nodeName: string;
+	readonly isConnected: boolean;
+	readonly ownerDocument: Document | null;
+	readonly parentElement: Element | null;
+	/** @deprecated Not implemented */
+	readonly childNodes: NodeList;
+	readonly firstChild: Node | null;
+	readonly previousSibling: Node | null;
+	readonly nextSibling: Node | null;
+	getRootNode(options?: GetRootNodeOptions): Node;
+	cloneNode(subtree?: boolean): Node;
+	isSameNode(otherNode: Node | null): boolean;
+	contains(other: Node | null): boolean;
+	insertBefore(node: Node, child: Node | null): Node;
+	appendChild(node: Node): Node;
+	removeChild(child: Node): Node;
+}
+
+declare var Node: {
+	prototype: Node;
+};
+
+declare namespace console {
+	function assert(condition?: boolean, ...data: any[]): void;
+	function clear(): void;
+	function debug(...data: any[]): void;
+	function error(...data: any[]): void;
+	function info(...data: any[]): void;
+	function log(...data: any[]): void;
+	/** @deprecated Not implemented */
+	function table(tabularData?: any, properties?: string[]): void;
+	function trace(...data: any[]): void;
+	function warn(...data: any[]): void;
+	/** @deprecated Not implemented */
+	function dir(item?: any, options?: object | null): void;
+	function dirxml(...data: any[]): void;
+	function count(label?: string): void;
+	function countReset(label?: string): void;
+	function group(...data: any[]): void;
+	function groupCollapsed(...data: any[]): void;
+	function groupEnd(): void;
+	function time(label?: string): void;
+	function timeLog(label?: string, ...data: any[]): void;
+	function timeEnd(label?: string): void;
+}
+
+// Document is not exposed by the generated wrappers.
+interface Document {}
+
+// Element is not exposed by the generated wrappers.
+interface Element {}
+
+// EventTarget is not exposed by the generated wrappers.
+interface EventTarget {}
+
+interface GetRootNodeOptions {
+	composed?: boolean;
+}
+
+// NodeList is not exposed by the generated wrappers.
+interface NodeList {}
@@ -0,0 +1,611 @@
+// This file is generated. Do not edit.
+
+interface DOMTokenList {
+	readonly length: number;
+	value: string;
+	item(index: number): string | null;
+	contains(token: string): boolean;
+	add(...tokens: string[]): void;
+	remove(...tokens: string[]): void;
+	toggle(token: string, force?: boolean): boolean;
+	replace(token: string, newToken: string): boolean;
+	/** @deprecated Not implemented */
+	supports(token: string): boolean;
+}
+
+declare var DOMTokenList: {
+	prototype: DOMTokenList;
+};
+
+interface Element extends Node {
+	/** @deprecated Not implemented */
+	readonly namespaceURI: string | null;
+	/** @deprecated Not implemented */
+	readonly prefix: string | null;
+	/** @deprecated Not implemented */
+	readonly localName: string;
+	readonly tagName: string;
+	/** @deprecated Not implemented */
+	id: string;
+	/** @deprecated Not implemented */
+	className: string;
+	readonly classList: DOMTokenList;
+	/** @deprecated Not implemented */
+	slot: string;
+	readonly attributes: NamedNodeMap;
+	/** @deprecated Not implemented */
+	readonly shadowRoot: ShadowRoot | null;
+	/** @deprecated Not implemented */
+	scrollTop: number;
+	/** @deprecated Not implemented */
+	scrollLeft: number;
+	/** @deprecated Not implemented */
+	readonly scrollWidth: number;
+	/** @deprecated Not implemented */
+	readonly scrollHeight: number;
+	/** @deprecated Not implemented */
+	readonly clientTop: number;
+	/** @deprecated Not implemented */
+	readonly clientLeft: number;
+	/** @deprecated Not implemented */
+	readonly clientWidth: number;
+	/** @deprecated Not implemented */
+	readonly clientHeight: number;
+	/** @deprecated Not implemented */
+	readonly currentCSSZoom: number;
+	readonly children: HTMLCollection;
+	readonly firstElementChild: Element | null;
+	readonly lastElementChild: Element | null;
+	readonly childElementCount: number;
+	readonly previousElementSibling: Element | null;
+	readonly nextElementSibling: Element | null;
+	readonly assignedSlot: HTMLSlotElement | null;
+	/** @deprecated Not implemented */
+	hasAttributes(): boolean;
+	/** @deprecated Not implemented */
+	getAttributeNames(): string[];
+	getAttribute(qualifiedName: string): string | null;
+	/** @deprecated Not implemented */
+	getAttributeNS(namespace: string | null, localName: string): string | null;
+	setAttribute(qualifiedName: string, value: string): void;
+	/** @deprecated Not implemented */
+	setAttributeNS(namespace: string | null, qualifiedName: string, value: string): void;
+	/** @deprecated Not implemented */
+	removeAttribute(qualifiedName: string): void;
+	/** @deprecated Not implemented */
+	removeAttributeNS(namespace: string | null, localName: string): void;
+	/** @deprecated Not implemented */
+	toggleAttribute(qualifiedName: string, force?: boolean): boolean;
+	hasAttribute(qualifiedName: string): boolean;
+	/** @deprecated Not implemented */
+	hasAttributeNS(namespace: string | null, localName: string): boolean;
+	/** @deprecated Not implemented */
+	getAttributeNode(qualifiedName: string): Attr | null;
+	/** @deprecated Not implemented */
+	getAttributeNodeNS(namespace: string | null, localName: string): Attr | null;
+	/** @deprecated Not implemented */
+	setAttributeNode(attr: Attr): Attr | null;
+	/** @deprecated Not implemented */
+	setAttributeNodeNS(attr: Attr): Attr | null;
+	/** @deprecated Not implemented */
+	removeAttributeNode(attr: Attr): Attr;
+	/** @deprecated Not implemented */
+	attachShadow(init: ShadowRootInit): ShadowRoot;
+	matches(selectors: string): boolean;
+	/** @deprecated Not implemented */
+	getElementsByTagName(qualifiedName: string): HTMLCollection;
+	/** @deprecated Not implemented */
+	getElementsByTagNameNS(namespace: string | null, localName: string): HTMLCollection;
+	/** @deprecated Not implemented */
+	getElementsByClassName(classNames: string): HTMLCollection;
+	/** @deprecated Not implemented */
+	insertAdjacentElement(where: string, element: Element): Element | null;
+	/** @deprecated Not implemented */
+	insertAdjacentText(where: string, data: string): void;
+	/** @deprecated Not implemented */
+	getClientRects(): DOMRectList;
+	/** @deprecated Not implemented */
+	getBoundingClientRect(): DOMRect;
+	/** @deprecated Not implemented */
+	checkVisibility(options?: CheckVisibilityOptions): boolean;
+	/** @deprecated Not implemented */
+	scrollIntoView(arg?: boolean | ScrollIntoViewOptions): void;
+	/** @deprecated Not implemented */
+	scroll(options?: ScrollToOptions): void;
+	/** @deprecated Not implemented */
+	scrollTo(options?: ScrollToOptions): void;
+	/** @deprecated Not implemented */
+	scrollBy(options?: ScrollToOptions): void;
+	prepend(...nodes: (Node | string)[]): void;
+	append(...nodes: (Node | string)[]): void;
+	replaceChildren(...nodes: (Node | string)[]): void;
+	querySelector(selectors: string): Element | null;
+	querySelectorAll(selectors: string): NodeList;
+	before(...nodes: (Node | string)[]): void;
+	after(...nodes: (Node | string)[]): void;
+	replaceWith(...nodes: (Node | string)[]): void;
+	remove(): void;
+}
+
+declare var Element: {
+	prototype: Element;
+};
+
+interface Event {
+	readonly type: string;
+	readonly target: EventTarget | null;
+	readonly currentTarget: EventTarget | null;
+	readonly bubbles: boolean;
+	readonly cancelable: boolean;
+	stopPropagation(): void;
+	preventDefault(): void;
+}
+
+declare var Event: {
+	prototype: Event;
+	new(type: string, eventInitDict?: EventInit): Event;
+};
+
+interface HTMLAnchorElement extends HTMLElement {
+	target: string;
+	href: string;
+	readonly origin: string;
+	protocol: string;
+	username: string;
+	password: string;
+	host: string;
+	hostname: string;
+	port: string;
+	pathname: string;
+	search: string;
+	hash: string;
+}
+
+declare var HTMLAnchorElement: {
+	prototype: HTMLAnchorElement;
+	new(): HTMLAnchorElement;
+};
+
+interface HTMLFormElement extends HTMLElement {
+	/** @deprecated Not implemented */
+	acceptCharset: string;
+	action: string;
+	/** @deprecated Not implemented */
+	autocomplete: string;
+	/** @deprecated Not implemented */
+	enctype: string;
+	/** @deprecated Not implemented */
+	encoding: string;
+	method: string;
+	/** @deprecated Not implemented */
+	target: string;
+	/** @deprecated Not implemented */
+	rel: string;
+	/** @deprecated Not implemented */
+	readonly relList: DOMTokenList;
+	readonly elements: HTMLFormControlsCollection;
+	/** @deprecated Not implemented */
+	readonly length: number;
+	submit(): void;
+	requestSubmit(submitter?: HTMLElement | null): void;
+	/** @deprecated Not implemented */
+	reset(): void;
+	/** @deprecated Not implemented */
+	checkValidity(): boolean;
+	/** @deprecated Not implemented */
+	reportValidity(): boolean;
+}
+
+declare var HTMLFormElement: {
+	prototype: HTMLFormElement;
+	new(): HTMLFormElement;
+};
+
+interface HTMLInputElement extends HTMLElement {
+	type: string;
+	popoverTargetElement: Element | null;
+	popoverTargetAction: string;
+}
+
+declare var HTMLInputElement: {
+	prototype: HTMLInputElement;
+	new(): HTMLInputElement;
+};
+
+interface HTMLTemplateElement extends HTMLElement {
+	readonly content: DocumentFragment;
+	/** @deprecated Not implemented */
+	shadowRootMode: string;
+	/** @deprecated Not implemented */
+	shadowRootDelegatesFocus: boolean;
+	/** @deprecated Not implemented */
+	shadowRootClonable: boolean;
+	/** @deprecated Not implemented */
+	shadowRootSerializable: boolean;
+}
+
+declare var HTMLTemplateElement: {
+	prototype: HTMLTemplateElement;
+	new(): HTMLTemplateElement;
+};
+
+interface History {
+	readonly length: number;
+	readonly state: any;
+	go(delta?: number): void;
+	back(): void;
+	forward(): void;
+	pushState(data: any, unused: string, url?: string | null): void;
+	replaceState(data: any, unused: string, url?: string | null): void;
+}
+
+declare var History: {
+	prototype: History;
+};
+
+interface Node extends EventTarget {
+	readonly nodeType: number;
+	readonly nodeName: string;
+	readonly isConnected: boolean;
+	readonly ownerDocument: Document | null;
+	readonly parentElement: Element | null;
+	readonly childNodes: NodeList;
+	readonly firstChild: Node | null;
+	readonly previousSibling: Node | null;
+	readonly nextSibling: Node | null;
+	getRootNode(options?: GetRootNodeOptions): Node;
+	cloneNode(subtree?: boolean): Node;
+	isSameNode(otherNode: Node | null): boolean;
+	contains(other: Node | null): boolean;
+	insertBefore(node: Node, child: Node | null): Node;
+	appendChild(node: Node): Node;
+	removeChild(child: Node): Node;
+}
+
+declare var Node: {
+	prototype: Node;
+};
+
+interface URL {
+	/** Setting href is not implemented. */
+	href: string;
+	readonly origin: string;
+	/** Setting protocol is not implemented. */
+	protocol: string;
+	/** @deprecated Not implemented */
+	username: string;
+	/** @deprecated Not implemented */
+	password: string;
+	/** Setting host is not implemented. */
+	host: string;
+	/** Setting hostname is not implemented. */
+	hostname: string;
+	/** Setting port is not implemented. */
+	port: string;
+	/** Setting pathname is not implemented. */
+	pathname: string;
+	/** Setting search is not implemented. */
+	search: string;
+	/** @deprecated Not implemented */
+	readonly searchParams: URLSearchParams;
+	/** Setting hash is not implemented. */
+	hash: string;
+	toJSON(): string;
+}
+
+declare var URL: {
+	prototype: URL;
+	new(url: string, base?: string): URL;
+};
+
+interface Window extends EventTarget {
+	readonly window: WindowProxy;
+	/** @deprecated Not implemented */
+	readonly self: WindowProxy;
+	readonly document: Document;
+	/** @deprecated Not implemented */
+	name: string;
+	readonly history: History;
+	/** @deprecated Not implemented */
+	readonly navigation: Navigation;
+	/** @deprecated Not implemented */
+	readonly customElements: CustomElementRegistry;
+	/** @deprecated Not implemented */
+	readonly locationbar: BarProp;
+	/** @deprecated Not implemented */
+	readonly menubar: BarProp;
+	/** @deprecated Not implemented */
+	readonly personalbar: BarProp;
+	/** @deprecated Not implemented */
+	readonly scrollbars: BarProp;
+	/** @deprecated Not implemented */
+	readonly statusbar: BarProp;
+	/** @deprecated Not implemented */
+	readonly toolbar: BarProp;
+	/** @deprecated Not implemented */
+	status: string;
+	/** @deprecated Not implemented */
+	readonly closed: boolean;
+	/** @deprecated Not implemented */
+	readonly frames: WindowProxy;
+	/** @deprecated Not implemented */
+	readonly length: number;
+	/** @deprecated Not implemented */
+	readonly top: WindowProxy | null;
+	/** @deprecated Not implemented */
+	opener: any;
+	/** @deprecated Not implemented */
+	readonly frameElement: Element | null;
+	/** @deprecated Not implemented */
+	readonly navigator: Navigator;
+	/** @deprecated Not implemented */
+	readonly clientInformation: Navigator;
+	/** @deprecated Not implemented */
+	readonly originAgentCluster: boolean;
+	onerror: OnErrorEventHandler;
+	onbeforeunload: OnBeforeUnloadEventHandler;
+	readonly origin: string;
+	readonly isSecureContext: boolean;
+	readonly crossOriginIsolated: boolean;
+	readonly sessionStorage: Storage;
+	readonly localStorage: Storage;
+	/** @deprecated Not implemented */
+	close(): void;
+	/** @deprecated Not implemented */
+	stop(): void;
+	/** @deprecated Not implemented */
+	focus(): void;
+	/** @deprecated Not implemented */
+	blur(): void;
+	/** @deprecated Not implemented */
+	open(url?: string, target?: string, features?: string): WindowProxy | null;
+	/** @deprecated Not implemented */
+	alert(): void;
+	/** @deprecated Not implemented */
+	confirm(message?: string): boolean;
+	/** @deprecated Not implemented */
+	prompt(message?: string, default_?: string): string | null;
+	/** @deprecated Not implemented */
+	print(): void;
+	/** @deprecated Not implemented */
+	postMessage(message: any, targetOrigin: string, transfer?: object[]): void;
+	reportError(e: any): void;
+	btoa(data: string): string;
+	atob(data: string): string;
+	setTimeout(handler: TimerHandler, timeout?: number, ...arguments: any[]): number;
+	clearTimeout(id?: number): void;
+	setInterval(handler: TimerHandler, timeout?: number, ...arguments: any[]): number;
+	clearInterval(id?: number): void;
+	queueMicrotask(callback: VoidFunction): void;
+	createImageBitmap(image: ImageBitmapSource, options?: ImageBitmapOptions): Promise<ImageBitmap>;
+	structuredClone(value: any, options?: StructuredSerializeOptions): any;
+	requestAnimationFrame(callback: FrameRequestCallback): number;
+	cancelAnimationFrame(handle: number): void;
+}
+
+declare var Window: {
+	prototype: Window;
+};
+
+interface XMLHttpRequest extends XMLHttpRequestEventTarget {
+	/** @deprecated Not implemented */
+	readonly readyState: number;
+	timeout: number;
+	withCredentials: boolean;
+	readonly upload: XMLHttpRequestUpload;
+	readonly responseURL: string;
+	readonly status: number;
+	readonly statusText: string;
+	/** @deprecated Not implemented */
+	responseType: XMLHttpRequestResponseType;
+	readonly response: any;
+	readonly responseText: string;
+	/** @deprecated Not implemented */
+	readonly responseXML: Document | null;
+	open(method: string, url: string): void;
+	setRequestHeader(name: string, value: string): void;
+	send(body?: (Document | XMLHttpRequestBodyInit) | null): void;
+	abort(): void;
+	getResponseHeader(name: string): string | null;
+	getAllResponseHeaders(): string;
+	overrideMimeType(mime: string): void;
+}
+
+declare var XMLHttpRequest: {
+	prototype: XMLHttpRequest;
+	new(): XMLHttpRequest;
+};
+
+declare namespace console {
+	function assert(condition?: boolean, ...data: any[]): void;
+	function clear(): void;
+	function debug(...data: any[]): void;
+	function error(...data: any[]): void;
+	function info(...data: any[]): void;
+	function log(...data: any[]): void;
+	/** @deprecated Not implemented */
+	function table(tabularData?: any, properties?: string[]): void;
+	function trace(...data: any[]): void;
+	function warn(...data: any[]): void;
+	/** @deprecated Not implemented */
+	function dir(item?: any, options?: object | null): void;
+	function dirxml(...data: any[]): void;
+	function count(label?: string): void;
+	function countReset(label?: string): void;
+	function group(...data: any[]): void;
+	function groupCollapsed(...data: any[]): void;
+	function groupEnd(): void;
+	function time(label?: string): void;
+	function timeLog(label?: string, ...data: any[]): void;
+	function timeEnd(label?: string): void;
+}
+
+// Attr is not exposed by the generated wrappers.
+interface Attr {}
+
+// BarProp is not exposed by the generated wrappers.
+interface BarProp {}
+
+interface CheckVisibilityOptions {
+	checkOpacity?: boolean;
+	checkVisibilityCSS?: boolean;
+	contentVisibilityAuto?: boolean;
+	opacityProperty?: boolean;
+	visibilityProperty?: boolean;
+}
+
+// CustomElementRegistry is not exposed by the generated wrappers.
+interface CustomElementRegistry {}
+
+// DOMRect is not defined in the loaded IDL specs.
+type DOMRect = any;
+
+// DOMRectList is not defined in the loaded IDL specs.
+type DOMRectList = any;
+
+// Document is not exposed by the generated wrappers.
+interface Document {}
+
+// DocumentFragment is not exposed by the generated wrappers.
+interface DocumentFragment {}
+
+interface EventInit {
+	bubbles?: boolean;
+	cancelable?: boolean;
+	composed?: boolean;
+}
+
+// EventTarget is not exposed by the generated wrappers.
+interface EventTarget {}
+
+// The IDL callback FrameRequestCallback is not available in the loaded IDL specs.
+type FrameRequestCallback = any;
+
+interface GetRootNodeOptions {
+	composed?: boolean;
+}
+
+// HTMLCollection is not exposed by the generated wrappers.
+interface HTMLCollection {}
+
+// HTMLElement is not exposed by the generated wrappers.
+interface HTMLElement {}
+
+// HTMLFormControlsCollection is not exposed by the generated wrappers.
+interface HTMLFormControlsCollection {}
+
+// HTMLSlotElement is not exposed by the generated wrappers.
+interface HTMLSlotElement {}
+
+// ImageBitmap is not exposed by the generated wrappers.
+interface ImageBitmap {}
+
+interface ImageBitmapOptions {
+	imageOrientation?: ImageOrientation;
+	premultiplyAlpha?: PremultiplyAlpha;
+	colorSpaceConversion?: ColorSpaceConversion;
+	resizeWidth?: number;
+	resizeHeight?: number;
+	resizeQuality?: ResizeQuality;
+}
+
+// The IDL typedef ImageBitmapSource is not available in the loaded IDL specs.
+type ImageBitmapSource = any;
+
+// NamedNodeMap is not exposed by the generated wrappers.
+interface NamedNodeMap {}
+
+// Navigation is not exposed by the generated wrappers.
+interface Navigation {}
+
+// Navigator is not exposed by the generated wrappers.
+interface Navigator {}
+
+// NodeList is not exposed by the generated wrappers.
+interface NodeList {}
+
+// The IDL typedef OnBeforeUnloadEventHandler is not available in the loaded IDL specs.
+type OnBeforeUnloadEventHandler = any;
+
+// The IDL typedef OnErrorEventHandler is not available in the loaded IDL specs.
+type OnErrorEventHandler = any;
+
+interface ScrollIntoViewOptions extends ScrollOptions {
+	block?: ScrollLogicalPosition;
+	inline?: ScrollLogicalPosition;
+}
+
+interface ScrollToOptions extends ScrollOptions {
+	left?: number;
+	top?: number;
+}
+
+// ShadowRoot is not exposed by the generated wrappers.
+interface ShadowRoot {}
+
+interface ShadowRootInit {
+	mode?: ShadowRootMode;
+	delegatesFocus?: boolean;
+	slotAssignment?: SlotAssignmentMode;
+	clonable?: boolean;
+	serializable?: boolean;
+}
+
+// Storage is not exposed by the generated wrappers.
+interface Storage {}
+
+interface StructuredSerializeOptions {
+	transfer?: object[];
+}
+
+// The IDL typedef TimerHandler is not available in the loaded IDL specs.
+type TimerHandler = any;
+
+// URLSearchParams is not exposed by the generated wrappers.
+interface URLSearchParams {}
+
+// VoidFunction is not defined in the loaded IDL specs.
+type VoidFunction = any;
+
+// WindowProxy is not defined in the loaded IDL specs.
+type WindowProxy = any;
+
+// XMLHttpRequestBodyInit is not defined in the loaded IDL specs.
+type XMLHttpRequestBodyInit = any;
+
+// XMLHttpRequestEventTarget is not exposed by the generated wrappers.
+interface XMLHttpRequestEventTarget {}
+
+// The IDL enum XMLHttpRequestResponseType is not available in the loaded IDL specs.
+type XMLHttpRequestResponseType = any;
+
+// XMLHttpRequestUpload is not exposed by the generated wrappers.
+interface XMLHttpRequestUpload {}
+
+// The IDL enum ColorSpaceConversion is not available in the loaded IDL specs.
+type ColorSpaceConversion = any;
+
+// The IDL enum ImageOrientation is not available in the loaded IDL specs.
+type ImageOrientation = any;
+
+// The IDL enum PremultiplyAlpha is not available in the loaded IDL specs.
+type PremultiplyAlpha = any;
+
+// The IDL enum ResizeQuality is not available in the loaded IDL specs.
+type ResizeQuality = any;
+
+// The IDL enum ScrollLogicalPosition is not available in the loaded IDL specs.
+type ScrollLogicalPosition = any;
+
+interface ScrollOptions {
+	behavior?: ScrollBehavior;
+}
+
+// The IDL enum ShadowRootMode is not available in the loaded IDL specs.
+type ShadowRootMode = any;
+
+// The IDL enum SlotAssignmentMode is not available in the loaded IDL specs.
+type SlotAssignmentMode = any;
+
+// The IDL enum ScrollBehavior is not available in the loaded IDL specs.
+type ScrollBehavior = any;
@@ -0,0 +1,80 @@
+// This file is generated. Do not edit.
+
+package gojahost
+
+import (
+	g "github.com/dop251/goja"
+	"iter"
+)
+
+// pullQueue runs the functions pulling values from the sequence of an async
+// iterator, one at a time, in the order they were added. The queue is only
+// used from the JS thread.
+type pullQueue struct {
+	done chan struct{}
+}
+
+// pull runs f in a new goroutine when the previous function has returned.
+func (q *pullQueue) pull(f func()) {
+	prev := q.done
+	done := make(chan struct{})
+	q.done = done
+	go func() {
+		defer close(done)
+		if prev != nil {
+			<-prev
+		}
+		f()
+	}()
+}
+
+// toAsyncIterator returns an encoder converting a sequence to a JS async iterator,
+// encoding each value of the sequence using encode.
+func toAsyncIterator[T any](ctx *GojaContext, encode func(T) g.Value) func(iter.Seq2[T, error]) g.Value {
+	return func(seq iter.Seq2[T, error]) g.Value {
+		vm := ctx.vm
+		next, stop := iter.Pull2(seq)
+		var pulls pullQueue
+		iterator := vm.NewObject()
+		iterator.Set("next", func(c g.FunctionCall) g.Value {
+			promise, resolve, reject := vm.NewPromise()
+			pulls.pull(func() {
+				v, err, ok := next()
+				ctx.queueTask(func() {
+					switch {
+					case !ok:
+						resolve(asyncIteratorResult(vm, g.Undefined(), true))
+					case err != nil:
+						pulls.pull(stop)
+						reject(vm.NewGoError(err))
+					default:
+						settlePromise(vm, resolve, reject, func() g.Value {
+							return asyncIteratorResult(vm, encode(v), false)
+						})
+					}
+				})
+			})
+			return vm.ToValue(promise)
+		})
+		iterator.Set("return", func(c g.FunctionCall) g.Value {
+			pulls.pull(stop)
+			promise, resolve, _ := vm.NewPromise()
+			resolve(asyncIteratorResult(vm, c.Argument(0), true))
+			return vm.ToValue(promise)
+		})
+		if sym, ok := vm.Get("Symbol").ToObject(vm).Get("asyncIterator").(*g.Symbol); ok {
+			iterator.SetSymbol(sym, func(c g.FunctionCall) g.Value {
+				return c.This
+			})
+		}
+		return iterator
+	}
+}
+
+// asyncIteratorResult creates an iterator result object.
+func asyncIteratorResult(vm *g.Runtime, value g.Value, done bool) *g.Object {
+	result := vm.NewObject()
+	result.Set("value", value)
+	result.Set("done", done)
+	return result
+}
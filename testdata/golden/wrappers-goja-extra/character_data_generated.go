@@ -0,0 +1,135 @@
+// This file is generated. Do not edit.
+
+package gojahost
+
+import (
+	g "github.com/dop251/goja"
+	dom "github.com/gost-dom/browser/dom"
+)
+
+type characterDataWrapper struct {
+	baseInstanceWrapper[dom.CharacterData]
+}
+
+func newCharacterDataWrapper(instance *GojaContext) wrapper {
+	return characterDataWrapper{newBaseInstanceWrapper[dom.CharacterData](instance)}
+}
+func (w characterDataWrapper) initializePrototype(prototype *g.Object, vm *g.Runtime) {
+	nonDocumentTypeChildNodeWrapper := newNonDocumentTypeChildNodeWrapper(w.ctx, "CharacterData")
+	childNodeWrapper := newChildNodeWrapper(w.ctx, "CharacterData")
+	prototype.Set("substringData", w.substringData)
+	prototype.Set("appendData", w.appendData)
+	prototype.Set("insertData", w.insertData)
+	prototype.Set("deleteData", w.deleteData)
+	prototype.Set("replaceData", w.replaceData)
+	prototype.Set("before", childNodeWrapper.before)
+	prototype.Set("after", childNodeWrapper.after)
+	prototype.Set("replaceWith", childNodeWrapper.replaceWith)
+	prototype.Set("remove", w.remove)
+	prototype.DefineAccessorProperty("data", w.ctx.vm.ToValue(w.data), w.ctx.vm.ToValue(w.setData), g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("length", w.ctx.vm.ToValue(w.length), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("previousElementSibling", w.ctx.vm.ToValue(nonDocumentTypeChildNodeWrapper.previousElementSibling), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("nextElementSibling", w.ctx.vm.ToValue(nonDocumentTypeChildNodeWrapper.nextElementSibling), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+}
+
+func (w characterDataWrapper) substringData(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.CharacterData)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("CharacterData.substringData: Illegal invocation"))
+	}
+	offset := decodeIDLUnsignedLong(w.ctx.vm)(c.Arguments[0])
+	count := decodeIDLUnsignedLong(w.ctx.vm)(c.Arguments[1])
+	result, err := instance.SubstringData(offset, count)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return w.toDOMString(result)
+}
+
+func (w characterDataWrapper) appendData(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.CharacterData)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("CharacterData.appendData: Illegal invocation"))
+	}
+	data := w.decodeDOMString(c.Arguments[0])
+	err := instance.AppendData(data)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return nil
+}
+
+func (w characterDataWrapper) insertData(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.CharacterData)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("CharacterData.insertData: Illegal invocation"))
+	}
+	offset := decodeIDLUnsignedLong(w.ctx.vm)(c.Arguments[0])
+	data := w.decodeDOMString(c.Arguments[1])
+	err := instance.InsertData(offset, data)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return nil
+}
+
+func (w characterDataWrapper) deleteData(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.CharacterData)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("CharacterData.deleteData: Illegal invocation"))
+	}
+	offset := decodeIDLUnsignedLong(w.ctx.vm)(c.Arguments[0])
+	count := decodeIDLUnsignedLong(w.ctx.vm)(c.Arguments[1])
+	err := instance.DeleteData(offset, count)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return nil
+}
+
+func (w characterDataWrapper) replaceData(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.CharacterData)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("CharacterData.replaceData: Illegal invocation"))
+	}
+	offset := decodeIDLUnsignedLong(w.ctx.vm)(c.Arguments[0])
+	count := decodeIDLUnsignedLong(w.ctx.vm)(c.Arguments[1])
+	data := w.decodeDOMString(c.Arguments[2])
+	err := instance.ReplaceData(offset, count, data)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return nil
+}
+
+func (w characterDataWrapper) remove(c g.FunctionCall) g.Value {
+	panic(notImplemented("CharacterData", "remove"))
+}
+
+func (w characterDataWrapper) data(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.CharacterData)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("CharacterData.data: Illegal invocation"))
+	}
+	result := instance.Data()
+	return w.toDOMString(result)
+}
+
+func (w characterDataWrapper) setData(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.CharacterData)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("CharacterData.setData: Illegal invocation"))
+	}
+	val := w.decodeDOMString(c.Arguments[0])
+	instance.SetData(val)
+	return nil
+}
+
+func (w characterDataWrapper) length(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.CharacterData)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("CharacterData.length: Illegal invocation"))
+	}
+	result := instance.Length()
+	return w.toUnsignedLong(result)
+}
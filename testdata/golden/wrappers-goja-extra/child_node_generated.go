@@ -0,0 +1,79 @@
+// This file is generated. Do not edit.
+
+package gojahost
+
+import (
+	g "github.com/dop251/goja"
+	dom "github.com/gost-dom/browser/dom"
+)
+
+type childNodeWrapper struct {
+	baseInstanceWrapper[dom.ChildNode]
+	interfaceName string
+}
+
+func newChildNodeWrapper(instance *GojaContext, interfaceName string) childNodeWrapper {
+	return childNodeWrapper{newBaseInstanceWrapper[dom.ChildNode](instance), interfaceName}
+}
+
+// isIncluder returns whether the value implements the interface including
+// the mixin, named by interfaceName.
+func (w childNodeWrapper) isIncluder(value any) bool {
+	switch w.interfaceName {
+	case "CharacterData":
+		_, ok := value.(dom.CharacterData)
+		return ok
+	}
+	return false
+}
+
+func (w childNodeWrapper) before(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.ChildNode)
+	if !ok || !w.isIncluder(instance) {
+		panic(w.ctx.vm.NewTypeError(w.interfaceName + ".before: Illegal invocation"))
+	}
+	nodes := decodeVariadicArgs(c.Arguments, 0, w.decode)
+	err := instance.Before(nodes...)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return nil
+}
+
+func (w childNodeWrapper) after(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.ChildNode)
+	if !ok || !w.isIncluder(instance) {
+		panic(w.ctx.vm.NewTypeError(w.interfaceName + ".after: Illegal invocation"))
+	}
+	nodes := decodeVariadicArgs(c.Arguments, 0, w.decode)
+	err := instance.After(nodes...)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return nil
+}
+
+func (w childNodeWrapper) replaceWith(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.ChildNode)
+	if !ok || !w.isIncluder(instance) {
+		panic(w.ctx.vm.NewTypeError(w.interfaceName + ".replaceWith: Illegal invocation"))
+	}
+	nodes := decodeVariadicArgs(c.Arguments, 0, w.decode)
+	err := instance.ReplaceWith(nodes...)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return nil
+}
+
+func (w childNodeWrapper) remove(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.ChildNode)
+	if !ok || !w.isIncluder(instance) {
+		panic(w.ctx.vm.NewTypeError(w.interfaceName + ".remove: Illegal invocation"))
+	}
+	err := instance.Remove()
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+// This file is generated. Do not edit.
+
+package gojahost
+
+import (
+	g "github.com/dop251/goja"
+	html "github.com/gost-dom/browser/html"
+)
+
+type hTMLLabelElementWrapper struct {
+	baseInstanceWrapper[html.HTMLLabelElement]
+}
+
+func newHTMLLabelElementWrapper(instance *GojaContext) wrapper {
+	return hTMLLabelElementWrapper{newBaseInstanceWrapper[html.HTMLLabelElement](instance)}
+}
+func (w hTMLLabelElementWrapper) initializePrototype(prototype *g.Object, vm *g.Runtime) {
+	prototype.DefineAccessorProperty("form", w.ctx.vm.ToValue(w.form), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("htmlFor", w.ctx.vm.ToValue(w.htmlFor), w.ctx.vm.ToValue(w.setHtmlFor), g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("control", w.ctx.vm.ToValue(w.control), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+}
+
+func (w hTMLLabelElementWrapper) form(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.HTMLLabelElement)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("HTMLLabelElement.form: Illegal invocation"))
+	}
+	result := instance.Form()
+	if result == nil {
+		return g.Null()
+	}
+	return w.toHTMLFormElement(result)
+}
+
+func (w hTMLLabelElementWrapper) htmlFor(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.HTMLLabelElement)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("HTMLLabelElement.htmlFor: Illegal invocation"))
+	}
+	result := instance.HtmlFor()
+	return w.toDOMString(result)
+}
+
+func (w hTMLLabelElementWrapper) setHtmlFor(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.HTMLLabelElement)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("HTMLLabelElement.setHtmlFor: Illegal invocation"))
+	}
+	val := w.decodeDOMString(c.Arguments[0])
+	instance.SetHtmlFor(val)
+	return nil
+}
+
+func (w hTMLLabelElementWrapper) control(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.HTMLLabelElement)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("HTMLLabelElement.control: Illegal invocation"))
+	}
+	result := instance.Control()
+	if result == nil {
+		return g.Null()
+	}
+	return w.toHTMLElement(result)
+}
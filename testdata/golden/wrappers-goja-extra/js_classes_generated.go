@@ -0,0 +1,10 @@
+// This file is generated. Do not edit.
+
+package gojahost
+
+func init() {
+	installClass("CharacterData", "Node", newCharacterDataWrapper)
+	installClass("HTMLLabelElement", "HTMLElement", newHTMLLabelElementWrapper)
+	installClass("NavigationCurrentEntryChangeEvent", "Event", newNavigationCurrentEntryChangeEventWrapper)
+	installClass("ReadableStream", "", newReadableStreamWrapper)
+}
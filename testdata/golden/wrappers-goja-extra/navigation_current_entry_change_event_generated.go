@@ -0,0 +1,38 @@
+// This file is generated. Do not edit.
+
+package gojahost
+
+import (
+	g "github.com/dop251/goja"
+	html "github.com/gost-dom/browser/html"
+)
+
+type navigationCurrentEntryChangeEventWrapper struct {
+	baseInstanceWrapper[html.NavigationCurrentEntryChangeEvent]
+}
+
+func newNavigationCurrentEntryChangeEventWrapper(instance *GojaContext) wrapper {
+	return navigationCurrentEntryChangeEventWrapper{newBaseInstanceWrapper[html.NavigationCurrentEntryChangeEvent](instance)}
+}
+func (w navigationCurrentEntryChangeEventWrapper) initializePrototype(prototype *g.Object, vm *g.Runtime) {
+	prototype.DefineAccessorProperty("navigationType", w.ctx.vm.ToValue(w.navigationType), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("from", w.ctx.vm.ToValue(w.from), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+}
+
+func (w navigationCurrentEntryChangeEventWrapper) navigationType(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.NavigationCurrentEntryChangeEvent)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("NavigationCurrentEntryChangeEvent.navigationType: Illegal invocation"))
+	}
+	result := instance.NavigationType()
+	return w.toNullableNavigationType(result)
+}
+
+func (w navigationCurrentEntryChangeEventWrapper) from(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.NavigationCurrentEntryChangeEvent)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("NavigationCurrentEntryChangeEvent.from: Illegal invocation"))
+	}
+	result := instance.From()
+	return w.toNavigationHistoryEntry(result)
+}
@@ -0,0 +1,52 @@
+// This file is generated. Do not edit.
+
+package gojahost
+
+import (
+	g "github.com/dop251/goja"
+	dom "github.com/gost-dom/browser/dom"
+)
+
+type nonDocumentTypeChildNodeWrapper struct {
+	baseInstanceWrapper[dom.NonDocumentTypeChildNode]
+	interfaceName string
+}
+
+func newNonDocumentTypeChildNodeWrapper(instance *GojaContext, interfaceName string) nonDocumentTypeChildNodeWrapper {
+	return nonDocumentTypeChildNodeWrapper{newBaseInstanceWrapper[dom.NonDocumentTypeChildNode](instance), interfaceName}
+}
+
+// isIncluder returns whether the value implements the interface including
+// the mixin, named by interfaceName.
+func (w nonDocumentTypeChildNodeWrapper) isIncluder(value any) bool {
+	switch w.interfaceName {
+	case "CharacterData":
+		_, ok := value.(dom.CharacterData)
+		return ok
+	}
+	return false
+}
+
+func (w nonDocumentTypeChildNodeWrapper) previousElementSibling(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.NonDocumentTypeChildNode)
+	if !ok || !w.isIncluder(instance) {
+		panic(w.ctx.vm.NewTypeError(w.interfaceName + ".previousElementSibling: Illegal invocation"))
+	}
+	result := instance.PreviousElementSibling()
+	if result == nil {
+		return g.Null()
+	}
+	return w.toElement(result)
+}
+
+func (w nonDocumentTypeChildNodeWrapper) nextElementSibling(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.NonDocumentTypeChildNode)
+	if !ok || !w.isIncluder(instance) {
+		panic(w.ctx.vm.NewTypeError(w.interfaceName + ".nextElementSibling: Illegal invocation"))
+	}
+	result := instance.NextElementSibling()
+	if result == nil {
+		return g.Null()
+	}
+	return w.toElement(result)
+}
@@ -0,0 +1,116 @@
+// This file is generated. Do not edit.
+
+package gojahost
+
+import (
+	g "github.com/dop251/goja"
+	html "github.com/gost-dom/browser/html"
+)
+
+type readableStreamWrapper struct {
+	baseInstanceWrapper[html.ReadableStream]
+}
+
+func newReadableStreamWrapper(instance *GojaContext) wrapper {
+	return readableStreamWrapper{newBaseInstanceWrapper[html.ReadableStream](instance)}
+}
+func (w readableStreamWrapper) initializePrototype(prototype *g.Object, vm *g.Runtime) {
+	prototype.Set("cancel", w.cancel)
+	prototype.Set("getReader", w.getReader)
+	prototype.Set("pipeThrough", w.pipeThrough)
+	prototype.Set("pipeTo", w.pipeTo)
+	prototype.Set("tee", w.tee)
+	prototype.Set("values", w.values)
+	if sym, ok := w.ctx.vm.Get("Symbol").ToObject(w.ctx.vm).Get("asyncIterator").(*g.Symbol); ok {
+		prototype.SetSymbol(sym, prototype.Get("values"))
+	}
+	prototype.DefineAccessorProperty("locked", w.ctx.vm.ToValue(w.locked), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+}
+
+func (w readableStreamWrapper) cancel(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.ReadableStream)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("ReadableStream.cancel: Illegal invocation"))
+	}
+	reason := w.decodeany(c.Argument(0))
+	result, err := instance.Cancel(reason)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return toVoidPromise(w.ctx)(result)
+}
+
+func (w readableStreamWrapper) getReader(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.ReadableStream)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("ReadableStream.getReader: Illegal invocation"))
+	}
+	options := w.decodeReadableStreamGetReaderOptions(c.Argument(0))
+	result, err := instance.GetReader(options)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return w.toReadableStreamReader(result)
+}
+
+func (w readableStreamWrapper) pipeThrough(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.ReadableStream)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("ReadableStream.pipeThrough: Illegal invocation"))
+	}
+	transform := w.decodeReadableWritablePair(c.Arguments[0])
+	options := w.decodeStreamPipeOptions(c.Argument(1))
+	result, err := instance.PipeThrough(transform, options)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return w.toReadableStream(result)
+}
+
+func (w readableStreamWrapper) pipeTo(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.ReadableStream)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("ReadableStream.pipeTo: Illegal invocation"))
+	}
+	destination := w.decodeWritableStream(c.Arguments[0])
+	options := w.decodeStreamPipeOptions(c.Argument(1))
+	result, err := instance.PipeTo(destination, options)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return toVoidPromise(w.ctx)(result)
+}
+
+func (w readableStreamWrapper) tee(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.ReadableStream)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("ReadableStream.tee: Illegal invocation"))
+	}
+	result, err := instance.Tee()
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return toSequence(w.ctx.vm, w.toReadableStream)(result)
+}
+
+func (w readableStreamWrapper) values(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.ReadableStream)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("ReadableStream.values: Illegal invocation"))
+	}
+	options := w.decodeReadableStreamIteratorOptions(c.Argument(0))
+	result, err := instance.Values(options)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return toAsyncIterator(w.ctx, w.toAny)(result)
+}
+
+func (w readableStreamWrapper) locked(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.ReadableStream)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("ReadableStream.locked: Illegal invocation"))
+	}
+	result := instance.Locked()
+	return w.toBoolean(result)
+}
@@ -0,0 +1,139 @@
+// This file is generated. Do not edit.
+
+package gojahost
+
+import (
+	g "github.com/dop251/goja"
+	"slices"
+)
+
+func isArrayBuffer(v g.Value) bool {
+	_, ok := v.Export().(g.ArrayBuffer)
+	return ok
+}
+
+func isArrayBufferView(vm *g.Runtime, v g.Value) bool {
+	isView, _ := g.AssertFunction(vm.Get("ArrayBuffer").ToObject(vm).Get("isView"))
+	result, err := isView(nil, v)
+	return err == nil && result.ToBoolean()
+}
+
+func isUint8Array(vm *g.Runtime, v g.Value) bool {
+	return vm.InstanceOf(v, vm.Get("Uint8Array").ToObject(vm))
+}
+
+// bufferSourceBytes returns a copy of the bytes of an ArrayBuffer, or of the
+// range of the buffer viewed by an ArrayBufferView.
+func bufferSourceBytes(vm *g.Runtime, v g.Value) []byte {
+	if buffer, ok := v.Export().(g.ArrayBuffer); ok {
+		if buffer.Detached() {
+			panic(vm.NewTypeError("The ArrayBuffer is detached"))
+		}
+		return slices.Clone(buffer.Bytes())
+	}
+	obj := v.ToObject(vm)
+	buffer, _ := obj.Get("buffer").Export().(g.ArrayBuffer)
+	if buffer.Detached() {
+		panic(vm.NewTypeError("The ArrayBuffer is detached"))
+	}
+	offset := obj.Get("byteOffset").ToInteger()
+	length := obj.Get("byteLength").ToInteger()
+	return slices.Clone(buffer.Bytes()[offset : offset+length])
+}
+
+func decodeIDLArrayBuffer(vm *g.Runtime) func(g.Value) []byte {
+	return func(v g.Value) []byte {
+		if !isArrayBuffer(v) {
+			panic(vm.NewTypeError("Value is not of type 'ArrayBuffer'"))
+		}
+		return bufferSourceBytes(vm, v)
+	}
+}
+
+func decodeIDLArrayBufferAllowShared(vm *g.Runtime) func(g.Value) []byte {
+	return func(v g.Value) []byte {
+		if !isArrayBuffer(v) {
+			panic(vm.NewTypeError("Value is not of type 'ArrayBuffer'"))
+		}
+		return bufferSourceBytes(vm, v)
+	}
+}
+
+func decodeIDLArrayBufferView(vm *g.Runtime) func(g.Value) []byte {
+	return func(v g.Value) []byte {
+		if !isArrayBufferView(vm, v) {
+			panic(vm.NewTypeError("Value is not of type 'ArrayBufferView'"))
+		}
+		return bufferSourceBytes(vm, v)
+	}
+}
+
+func decodeIDLArrayBufferViewAllowShared(vm *g.Runtime) func(g.Value) []byte {
+	return func(v g.Value) []byte {
+		if !isArrayBufferView(vm, v) {
+			panic(vm.NewTypeError("Value is not of type 'ArrayBufferView'"))
+		}
+		return bufferSourceBytes(vm, v)
+	}
+}
+
+func decodeIDLBufferSource(vm *g.Runtime) func(g.Value) []byte {
+	return func(v g.Value) []byte {
+		if !(isArrayBuffer(v) || isArrayBufferView(vm, v)) {
+			panic(vm.NewTypeError("Value is not of type 'BufferSource'"))
+		}
+		return bufferSourceBytes(vm, v)
+	}
+}
+
+func decodeIDLBufferSourceAllowShared(vm *g.Runtime) func(g.Value) []byte {
+	return func(v g.Value) []byte {
+		if !(isArrayBuffer(v) || isArrayBufferView(vm, v)) {
+			panic(vm.NewTypeError("Value is not of type 'BufferSource'"))
+		}
+		return bufferSourceBytes(vm, v)
+	}
+}
+
+func decodeIDLAllowSharedBufferSource(vm *g.Runtime) func(g.Value) []byte {
+	return func(v g.Value) []byte {
+		if !(isArrayBuffer(v) || isArrayBufferView(vm, v)) {
+			panic(vm.NewTypeError("Value is not of type 'AllowSharedBufferSource'"))
+		}
+		return bufferSourceBytes(vm, v)
+	}
+}
+
+func decodeIDLUint8Array(vm *g.Runtime) func(g.Value) []byte {
+	return func(v g.Value) []byte {
+		if !isUint8Array(vm, v) {
+			panic(vm.NewTypeError("Value is not of type 'Uint8Array'"))
+		}
+		return bufferSourceBytes(vm, v)
+	}
+}
+
+func decodeIDLUint8ArrayAllowShared(vm *g.Runtime) func(g.Value) []byte {
+	return func(v g.Value) []byte {
+		if !isUint8Array(vm, v) {
+			panic(vm.NewTypeError("Value is not of type 'Uint8Array'"))
+		}
+		return bufferSourceBytes(vm, v)
+	}
+}
+
+func toIDLArrayBuffer(vm *g.Runtime) func([]byte) g.Value {
+	return func(data []byte) g.Value {
+		return vm.ToValue(vm.NewArrayBuffer(slices.Clone(data)))
+	}
+}
+
+func toIDLUint8Array(vm *g.Runtime) func([]byte) g.Value {
+	return func(data []byte) g.Value {
+		array, err := vm.New(vm.Get("Uint8Array"), vm.ToValue(vm.NewArrayBuffer(slices.Clone(data))))
+		if err != nil {
+			panic(err)
+		}
+		return array
+	}
+}
@@ -0,0 +1,245 @@
+// This file is generated. Do not edit.
+
+package gojahost
+
+import (
+	g "github.com/dop251/goja"
+	dom "github.com/gost-dom/browser/dom"
+)
+
+type eventWrapper struct {
+	baseInstanceWrapper[dom.Event]
+}
+
+func newEventWrapper(instance *GojaContext) wrapper {
+	return eventWrapper{newBaseInstanceWrapper[dom.Event](instance)}
+}
+func (w eventWrapper) initializePrototype(prototype *g.Object, vm *g.Runtime) {
+	prototype.Set("composedPath", w.composedPath)
+	prototype.Set("stopPropagation", w.stopPropagation)
+	prototype.Set("stopImmediatePropagation", w.stopImmediatePropagation)
+	prototype.Set("preventDefault", w.preventDefault)
+	prototype.Set("initEvent", w.initEvent)
+	prototype.DefineAccessorProperty("type", w.ctx.vm.ToValue(w.type_), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("target", w.ctx.vm.ToValue(w.target), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("srcElement", w.ctx.vm.ToValue(w.srcElement), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("currentTarget", w.ctx.vm.ToValue(w.currentTarget), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("eventPhase", w.ctx.vm.ToValue(w.eventPhase), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("cancelBubble", w.ctx.vm.ToValue(w.cancelBubble), w.ctx.vm.ToValue(w.setCancelBubble), g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("bubbles", w.ctx.vm.ToValue(w.bubbles), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("cancelable", w.ctx.vm.ToValue(w.cancelable), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("returnValue", w.ctx.vm.ToValue(w.returnValue), w.ctx.vm.ToValue(w.setReturnValue), g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("defaultPrevented", w.ctx.vm.ToValue(w.defaultPrevented), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("composed", w.ctx.vm.ToValue(w.composed), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("isTrusted", w.ctx.vm.ToValue(w.isTrusted), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("timeStamp", w.ctx.vm.ToValue(w.timeStamp), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+}
+
+func (w eventWrapper) composedPath(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Event)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Event.composedPath: Illegal invocation"))
+	}
+	result, err := instance.ComposedPath()
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return toSequence(w.ctx.vm, w.toEventTarget)(result)
+}
+
+func (w eventWrapper) stopPropagation(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Event)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Event.stopPropagation: Illegal invocation"))
+	}
+	err := instance.StopPropagation()
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return nil
+}
+
+func (w eventWrapper) stopImmediatePropagation(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Event)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Event.stopImmediatePropagation: Illegal invocation"))
+	}
+	err := instance.StopImmediatePropagation()
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return nil
+}
+
+func (w eventWrapper) preventDefault(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Event)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Event.preventDefault: Illegal invocation"))
+	}
+	err := instance.PreventDefault()
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return nil
+}
+
+func (w eventWrapper) initEvent(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Event)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Event.initEvent: Illegal invocation"))
+	}
+	type_ := w.decodeDOMString(c.Arguments[0])
+	bubbles := w.decodeboolean(c.Argument(1))
+	cancelable := w.decodeboolean(c.Argument(2))
+	err := instance.InitEvent(type_, bubbles, cancelable)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return nil
+}
+
+func (w eventWrapper) type_(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Event)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Event.type: Illegal invocation"))
+	}
+	result := instance.Type()
+	return w.toDOMString(result)
+}
+
+func (w eventWrapper) target(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Event)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Event.target: Illegal invocation"))
+	}
+	result := instance.Target()
+	if result == nil {
+		return g.Null()
+	}
+	return w.toEventTarget(result)
+}
+
+func (w eventWrapper) srcElement(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Event)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Event.srcElement: Illegal invocation"))
+	}
+	result := instance.SrcElement()
+	if result == nil {
+		return g.Null()
+	}
+	return w.toEventTarget(result)
+}
+
+func (w eventWrapper) currentTarget(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Event)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Event.currentTarget: Illegal invocation"))
+	}
+	result := instance.CurrentTarget()
+	if result == nil {
+		return g.Null()
+	}
+	return w.toEventTarget(result)
+}
+
+func (w eventWrapper) eventPhase(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Event)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Event.eventPhase: Illegal invocation"))
+	}
+	result := instance.EventPhase()
+	return w.toUnsignedShort(result)
+}
+
+func (w eventWrapper) cancelBubble(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Event)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Event.cancelBubble: Illegal invocation"))
+	}
+	result := instance.CancelBubble()
+	return w.toBoolean(result)
+}
+
+func (w eventWrapper) setCancelBubble(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Event)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Event.setCancelBubble: Illegal invocation"))
+	}
+	val := w.decodeboolean(c.Arguments[0])
+	instance.SetCancelBubble(val)
+	return nil
+}
+
+func (w eventWrapper) bubbles(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Event)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Event.bubbles: Illegal invocation"))
+	}
+	result := instance.Bubbles()
+	return w.toBoolean(result)
+}
+
+func (w eventWrapper) cancelable(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Event)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Event.cancelable: Illegal invocation"))
+	}
+	result := instance.Cancelable()
+	return w.toBoolean(result)
+}
+
+func (w eventWrapper) returnValue(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Event)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Event.returnValue: Illegal invocation"))
+	}
+	result := instance.ReturnValue()
+	return w.toBoolean(result)
+}
+
+func (w eventWrapper) setReturnValue(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Event)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Event.setReturnValue: Illegal invocation"))
+	}
+	val := w.decodeboolean(c.Arguments[0])
+	instance.SetReturnValue(val)
+	return nil
+}
+
+func (w eventWrapper) defaultPrevented(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Event)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Event.defaultPrevented: Illegal invocation"))
+	}
+	result := instance.DefaultPrevented()
+	return w.toBoolean(result)
+}
+
+func (w eventWrapper) composed(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Event)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Event.composed: Illegal invocation"))
+	}
+	result := instance.Composed()
+	return w.toBoolean(result)
+}
+
+func (w eventWrapper) isTrusted(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Event)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Event.isTrusted: Illegal invocation"))
+	}
+	result := instance.IsTrusted()
+	return w.toBoolean(result)
+}
+
+func (w eventWrapper) timeStamp(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Event)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Event.timeStamp: Illegal invocation"))
+	}
+	result := instance.TimeStamp()
+	return w.toDOMHighResTimeStamp(result)
+}
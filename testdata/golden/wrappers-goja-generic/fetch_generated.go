@@ -0,0 +1,103 @@
+// This file is generated. Do not edit.
+
+package gojahost
+
+import (
+	g "github.com/dop251/goja"
+	html "github.com/gost-dom/browser/html"
+)
+
+type headersWrapper struct {
+	baseInstanceWrapper[html.Headers]
+}
+
+func newHeadersWrapper(instance *GojaContext) wrapper {
+	return headersWrapper{newBaseInstanceWrapper[html.Headers](instance)}
+}
+func (w headersWrapper) initializePrototype(prototype *g.Object, vm *g.Runtime) {
+	prototype.Set("append", w.append)
+	prototype.Set("delete", w.delete)
+	prototype.Set("get", w.get)
+	prototype.Set("getSetCookie", w.getSetCookie)
+	prototype.Set("has", w.has)
+	prototype.Set("set", w.set)
+}
+
+func (w headersWrapper) append(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.Headers)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Headers.append: Illegal invocation"))
+	}
+	name := w.decodeByteString(c.Arguments[0])
+	value := w.decodeByteString(c.Arguments[1])
+	err := instance.Append(name, value)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return nil
+}
+
+func (w headersWrapper) delete(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.Headers)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Headers.delete: Illegal invocation"))
+	}
+	name := w.decodeByteString(c.Arguments[0])
+	err := instance.Delete(name)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return nil
+}
+
+func (w headersWrapper) get(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.Headers)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Headers.get: Illegal invocation"))
+	}
+	name := w.decodeByteString(c.Arguments[0])
+	result, err := instance.Get(name)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return w.toNullableByteString(result)
+}
+
+func (w headersWrapper) getSetCookie(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.Headers)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Headers.getSetCookie: Illegal invocation"))
+	}
+	result, err := instance.GetSetCookie()
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return toSequence(w.ctx.vm, w.toByteString)(result)
+}
+
+func (w headersWrapper) has(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.Headers)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Headers.has: Illegal invocation"))
+	}
+	name := w.decodeByteString(c.Arguments[0])
+	result, err := instance.Has(name)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return w.toBoolean(result)
+}
+
+func (w headersWrapper) set(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.Headers)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Headers.set: Illegal invocation"))
+	}
+	name := w.decodeByteString(c.Arguments[0])
+	value := w.decodeByteString(c.Arguments[1])
+	err := instance.Set(name, value)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+// This file is generated. Do not edit.
+
+package gojahost
+
+func init() {
+	installClass("Event", "", newEventWrapper)
+	installClass("Headers", "", newHeadersWrapper)
+	installClass("URLSearchParams", "", newURLSearchParamsWrapper)
+}
@@ -0,0 +1,53 @@
+// This file is generated. Do not edit.
+
+package gojahost
+
+import g "github.com/dop251/goja"
+
+// toPromise returns an encoder converting a function to a JS promise, settled
+// with the result of the function encoded using encode.
+func toPromise[T any](ctx *GojaContext, encode func(T) g.Value) func(func() (T, error)) g.Value {
+	return func(f func() (T, error)) g.Value {
+		vm := ctx.vm
+		promise, resolve, reject := vm.NewPromise()
+		go func() {
+			v, err := f()
+			ctx.queueTask(func() {
+				if err != nil {
+					reject(vm.NewGoError(err))
+					return
+				}
+				settlePromise(vm, resolve, reject, func() g.Value {
+					return encode(v)
+				})
+			})
+		}()
+		return vm.ToValue(promise)
+	}
+}
+
+// toVoidPromise returns an encoder converting a function without a result to a
+// JS promise, resolved with undefined.
+func toVoidPromise(ctx *GojaContext) func(func() error) g.Value {
+	encode := toPromise(ctx, func(struct{}) g.Value {
+		return g.Undefined()
+	})
+	return func(f func() error) g.Value {
+		return encode(func() (struct{}, error) {
+			return struct{}{}, f()
+		})
+	}
+}
+
+// settlePromise resolves the promise with the value returned from f, or rejects
+// it with the exception thrown by f.
+func settlePromise(vm *g.Runtime, resolve, reject func(any) error, f func() g.Value) {
+	var v g.Value
+	if ex := vm.Try(func() {
+		v = f()
+	}); ex != nil {
+		reject(ex.Value())
+		return
+	}
+	resolve(v)
+}
@@ -0,0 +1,139 @@
+// This file is generated. Do not edit.
+
+package gojahost
+
+import (
+	g "github.com/dop251/goja"
+	html "github.com/gost-dom/browser/html"
+)
+
+type uRLSearchParamsWrapper struct {
+	baseInstanceWrapper[html.URLSearchParams]
+}
+
+func newURLSearchParamsWrapper(instance *GojaContext) wrapper {
+	return uRLSearchParamsWrapper{newBaseInstanceWrapper[html.URLSearchParams](instance)}
+}
+func (w uRLSearchParamsWrapper) initializePrototype(prototype *g.Object, vm *g.Runtime) {
+	prototype.Set("append", w.append)
+	prototype.Set("delete", w.delete)
+	prototype.Set("get", w.get)
+	prototype.Set("getAll", w.getAll)
+	prototype.Set("has", w.has)
+	prototype.Set("set", w.set)
+	prototype.Set("sort", w.sort)
+	prototype.Set("toString", w.toString)
+	prototype.DefineAccessorProperty("size", w.ctx.vm.ToValue(w.size), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+}
+
+func (w uRLSearchParamsWrapper) append(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.URLSearchParams)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("URLSearchParams.append: Illegal invocation"))
+	}
+	name := w.decodeUSVString(c.Arguments[0])
+	value := w.decodeUSVString(c.Arguments[1])
+	err := instance.Append(name, value)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return nil
+}
+
+func (w uRLSearchParamsWrapper) delete(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.URLSearchParams)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("URLSearchParams.delete: Illegal invocation"))
+	}
+	name := w.decodeUSVString(c.Arguments[0])
+	value := w.decodeUSVString(c.Argument(1))
+	err := instance.Delete(name, value)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return nil
+}
+
+func (w uRLSearchParamsWrapper) get(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.URLSearchParams)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("URLSearchParams.get: Illegal invocation"))
+	}
+	name := w.decodeUSVString(c.Arguments[0])
+	result, err := instance.Get(name)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return w.toNullableUSVString(result)
+}
+
+func (w uRLSearchParamsWrapper) getAll(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.URLSearchParams)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("URLSearchParams.getAll: Illegal invocation"))
+	}
+	name := w.decodeUSVString(c.Arguments[0])
+	result, err := instance.GetAll(name)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return toSequence(w.ctx.vm, w.toUSVString)(result)
+}
+
+func (w uRLSearchParamsWrapper) has(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.URLSearchParams)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("URLSearchParams.has: Illegal invocation"))
+	}
+	name := w.decodeUSVString(c.Arguments[0])
+	value := w.decodeUSVString(c.Argument(1))
+	result, err := instance.Has(name, value)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return w.toBoolean(result)
+}
+
+func (w uRLSearchParamsWrapper) set(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.URLSearchParams)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("URLSearchParams.set: Illegal invocation"))
+	}
+	name := w.decodeUSVString(c.Arguments[0])
+	value := w.decodeUSVString(c.Arguments[1])
+	err := instance.Set(name, value)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return nil
+}
+
+func (w uRLSearchParamsWrapper) sort(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.URLSearchParams)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("URLSearchParams.sort: Illegal invocation"))
+	}
+	err := instance.Sort()
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return nil
+}
+
+func (w uRLSearchParamsWrapper) toString(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.URLSearchParams)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("URLSearchParams.toString: Illegal invocation"))
+	}
+	result := instance.ToString()
+	return w.toDOMString(result)
+}
+
+func (w uRLSearchParamsWrapper) size(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(html.URLSearchParams)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("URLSearchParams.size: Illegal invocation"))
+	}
+	result := instance.Size()
+	return w.toUnsignedLong(result)
+}
@@ -0,0 +1,151 @@
+// This file is generated. Do not edit.
+
+package gojahost
+
+import (
+	g "github.com/dop251/goja"
+	console "github.com/gost-dom/browser/console"
+)
+
+func init() {
+	installNamespace("console", newConsoleWrapper)
+}
+
+type consoleWrapper struct {
+	baseNamespaceWrapper
+}
+
+func newConsoleWrapper(instance *GojaContext) namespaceWrapper {
+	return consoleWrapper{newBaseNamespaceWrapper(instance)}
+}
+func (w consoleWrapper) initializeNamespace(namespace *g.Object, vm *g.Runtime) {
+	namespace.Set("assert", w.assert)
+	namespace.Set("clear", w.clear)
+	namespace.Set("debug", w.debug)
+	namespace.Set("error", w.error)
+	namespace.Set("info", w.info)
+	namespace.Set("log", w.log)
+	namespace.Set("table", w.table)
+	namespace.Set("trace", w.trace)
+	namespace.Set("warn", w.warn)
+	namespace.Set("dir", w.dir)
+	namespace.Set("dirxml", w.dirxml)
+	namespace.Set("count", w.count)
+	namespace.Set("countReset", w.countReset)
+	namespace.Set("group", w.group)
+	namespace.Set("groupCollapsed", w.groupCollapsed)
+	namespace.Set("groupEnd", w.groupEnd)
+	namespace.Set("time", w.time)
+	namespace.Set("timeLog", w.timeLog)
+	namespace.Set("timeEnd", w.timeEnd)
+}
+
+func (w consoleWrapper) assert(c g.FunctionCall) g.Value {
+	condition := decodeArgWithDefault(c.Arguments, 0, w.defaultCondition, w.decodeboolean)
+	data := decodeVariadicArgs(c.Arguments, 1, w.decodeany)
+	console.Assert(condition, data...)
+	return nil
+}
+
+func (w consoleWrapper) clear(c g.FunctionCall) g.Value {
+	console.Clear()
+	return nil
+}
+
+func (w consoleWrapper) debug(c g.FunctionCall) g.Value {
+	data := decodeVariadicArgs(c.Arguments, 0, w.decodeany)
+	console.Debug(data...)
+	return nil
+}
+
+func (w consoleWrapper) error(c g.FunctionCall) g.Value {
+	data := decodeVariadicArgs(c.Arguments, 0, w.decodeany)
+	console.Error(data...)
+	return nil
+}
+
+func (w consoleWrapper) info(c g.FunctionCall) g.Value {
+	data := decodeVariadicArgs(c.Arguments, 0, w.decodeany)
+	console.Info(data...)
+	return nil
+}
+
+func (w consoleWrapper) log(c g.FunctionCall) g.Value {
+	data := decodeVariadicArgs(c.Arguments, 0, w.decodeany)
+	console.Log(data...)
+	return nil
+}
+
+func (w consoleWrapper) table(c g.FunctionCall) g.Value {
+	panic(notImplemented("console", "table"))
+}
+
+func (w consoleWrapper) trace(c g.FunctionCall) g.Value {
+	data := decodeVariadicArgs(c.Arguments, 0, w.decodeany)
+	console.Trace(data...)
+	return nil
+}
+
+func (w consoleWrapper) warn(c g.FunctionCall) g.Value {
+	data := decodeVariadicArgs(c.Arguments, 0, w.decodeany)
+	console.Warn(data...)
+	return nil
+}
+
+func (w consoleWrapper) dir(c g.FunctionCall) g.Value {
+	panic(notImplemented("console", "dir"))
+}
+
+func (w consoleWrapper) dirxml(c g.FunctionCall) g.Value {
+	data := decodeVariadicArgs(c.Arguments, 0, w.decodeany)
+	console.Dirxml(data...)
+	return nil
+}
+
+func (w consoleWrapper) count(c g.FunctionCall) g.Value {
+	label := decodeArgWithDefault(c.Arguments, 0, w.defaultLabel, w.decodeDOMString)
+	console.Count(label)
+	return nil
+}
+
+func (w consoleWrapper) countReset(c g.FunctionCall) g.Value {
+	label := decodeArgWithDefault(c.Arguments, 0, w.defaultLabel, w.decodeDOMString)
+	console.CountReset(label)
+	return nil
+}
+
+func (w consoleWrapper) group(c g.FunctionCall) g.Value {
+	data := decodeVariadicArgs(c.Arguments, 0, w.decodeany)
+	console.Group(data...)
+	return nil
+}
+
+func (w consoleWrapper) groupCollapsed(c g.FunctionCall) g.Value {
+	data := decodeVariadicArgs(c.Arguments, 0, w.decodeany)
+	console.GroupCollapsed(data...)
+	return nil
+}
+
+func (w consoleWrapper) groupEnd(c g.FunctionCall) g.Value {
+	console.GroupEnd()
+	return nil
+}
+
+func (w consoleWrapper) time(c g.FunctionCall) g.Value {
+	label := decodeArgWithDefault(c.Arguments, 0, w.defaultLabel, w.decodeDOMString)
+	console.Time(label)
+	return nil
+}
+
+func (w consoleWrapper) timeLog(c g.FunctionCall) g.Value {
+	label := decodeArgWithDefault(c.Arguments, 0, w.defaultLabel, w.decodeDOMString)
+	data := decodeVariadicArgs(c.Arguments, 1, w.decodeany)
+	console.TimeLog(label, data...)
+	return nil
+}
+
+func (w consoleWrapper) timeEnd(c g.FunctionCall) g.Value {
+	label := decodeArgWithDefault(c.Arguments, 0, w.defaultLabel, w.decodeDOMString)
+	console.TimeEnd(label)
+	return nil
+}
@@ -0,0 +1,7 @@
+// This file is generated. Do not edit.
+
+package gojahost
+
+func init() {
+	installClass("Node", "EventTarget", newNodeWrapper)
+}
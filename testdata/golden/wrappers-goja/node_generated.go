@@ -0,0 +1,196 @@
+// This file is generated. Do not edit.
+
+package gojahost
+
+import (
+	g "github.com/dop251/goja"
+	dom "github.com/gost-dom/browser/dom"
+)
+
+type nodeWrapper struct {
+	baseInstanceWrapper[dom.Node]
+}
+
+func newNodeWrapper(instance *GojaContext) wrapper {
+	return nodeWrapper{newBaseInstanceWrapper[dom.Node](instance)}
+}
+func (w nodeWrapper) initializePrototype(prototype *g.Object, vm *g.Runtime) {
+	prototype.Set("getRootNode", w.getRootNode)
+	prototype.Set("cloneNode", w.cloneNode)
+	prototype.Set("isSameNode", w.isSameNode)
+	prototype.Set("contains", w.contains)
+	prototype.Set("insertBefore", w.insertBefore)
+	prototype.Set("appendChild", w.appendChild)
+	prototype.Set("removeChild", w.removeChild)
+	prototype.DefineAccessorProperty("nodeType", w.ctx.vm.ToValue(w.nodeType), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("nodeName", w.ctx.vm.ToValue(w.nodeName), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("isConnected", w.ctx.vm.ToValue(w.isConnected), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("ownerDocument", w.ctx.vm.ToValue(w.ownerDocument), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("parentElement", w.ctx.vm.ToValue(w.parentElement), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("childNodes", w.ctx.vm.ToValue(w.childNodes), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("firstChild", w.ctx.vm.ToValue(w.firstChild), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("previousSibling", w.ctx.vm.ToValue(w.previousSibling), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+	prototype.DefineAccessorProperty("nextSibling", w.ctx.vm.ToValue(w.nextSibling), nil, g.FLAG_TRUE, g.FLAG_TRUE)
+}
+
+func (w nodeWrapper) getRootNode(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Node)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Node.getRootNode: Illegal invocation"))
+	}
+	options := decodeArgWithDefault(c.Arguments, 0, w.defaultGetRootNodeOptions, w.decodeGetRootNodeOptions)
+	result := instance.GetRootNode(options)
+	return w.toNode(result)
+}
+
+func (w nodeWrapper) cloneNode(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Node)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Node.cloneNode: Illegal invocation"))
+	}
+	subtree := decodeArgWithDefault(c.Arguments, 0, w.defaultboolean, w.decodeboolean)
+	result := instance.CloneNode(subtree)
+	return w.toNode(result)
+}
+
+func (w nodeWrapper) isSameNode(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Node)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Node.isSameNode: Illegal invocation"))
+	}
+	otherNode := decodeNullable(c.Arguments[0], w.decodeNode)
+	result := instance.IsSameNode(otherNode)
+	return w.toBoolean(result)
+}
+
+func (w nodeWrapper) contains(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Node)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Node.contains: Illegal invocation"))
+	}
+	other := decodeNullable(c.Arguments[0], w.decodeNode)
+	result := instance.Contains(other)
+	return w.toBoolean(result)
+}
+
+func (w nodeWrapper) insertBefore(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Node)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Node.insertBefore: Illegal invocation"))
+	}
+	node := w.decodeNode(c.Arguments[0])
+	child := decodeNullable(c.Arguments[1], w.decodeNode)
+	result, err := instance.InsertBefore(node, child)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return w.toNode(result)
+}
+
+func (w nodeWrapper) appendChild(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Node)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Node.appendChild: Illegal invocation"))
+	}
+	node := w.decodeNode(c.Arguments[0])
+	result, err := instance.AppendChild(node)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return w.toNode(result)
+}
+
+func (w nodeWrapper) removeChild(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Node)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Node.removeChild: Illegal invocation"))
+	}
+	child := w.decodeNode(c.Arguments[0])
+	result, err := instance.RemoveChild(child)
+	if err != nil {
+		panic(mapError(w.ctx, err))
+	}
+	return w.toNode(result)
+}
+
+func (w nodeWrapper) nodeName(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Node)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Node.nodeName: Illegal invocation"))
+	}
+	result := instance.NodeName()
+	return w.toDOMString(result)
+}
+
+func (w nodeWrapper) isConnected(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Node)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Node.isConnected: Illegal invocation"))
+	}
+	result := instance.IsConnected()
+	return w.toBoolean(result)
+}
+
+func (w nodeWrapper) ownerDocument(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Node)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Node.ownerDocument: Illegal invocation"))
+	}
+	result := instance.OwnerDocument()
+	if result == nil {
+		return g.Null()
+	}
+	return w.toDocument(result)
+}
+
+func (w nodeWrapper) parentElement(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Node)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Node.parentElement: Illegal invocation"))
+	}
+	result := instance.ParentElement()
+	if result == nil {
+		return g.Null()
+	}
+	return w.toElement(result)
+}
+
+func (w nodeWrapper) childNodes(c g.FunctionCall) g.Value {
+	panic(notImplemented("Node", "childNodes"))
+}
+
+func (w nodeWrapper) firstChild(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Node)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Node.firstChild: Illegal invocation"))
+	}
+	result := instance.FirstChild()
+	if result == nil {
+		return g.Null()
+	}
+	return w.toNode(result)
+}
+
+func (w nodeWrapper) previousSibling(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Node)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Node.previousSibling: Illegal invocation"))
+	}
+	result := instance.PreviousSibling()
+	if result == nil {
+		return g.Null()
+	}
+	return w.toNode(result)
+}
+
+func (w nodeWrapper) nextSibling(c g.FunctionCall) g.Value {
+	instance, ok := c.This.Export().(dom.Node)
+	if !ok {
+		panic(w.ctx.vm.NewTypeError("Node.nextSibling: Illegal invocation"))
+	}
+	result := instance.NextSibling()
+	if result == nil {
+		return g.Null()
+	}
+	return w.toNode(result)
+}
@@ -0,0 +1,80 @@
+// This file is generated. Do not edit.
+
+package sobekhost
+
+import (
+	sobek "github.com/grafana/sobek"
+	"iter"
+)
+
+// pullQueue runs the functions pulling values from the sequence of an async
+// iterator, one at a time, in the order they were added. The queue is only
+// used from the JS thread.
+type pullQueue struct {
+	done chan struct{}
+}
+
+// pull runs f in a new goroutine when the previous function has returned.
+func (q *pullQueue) pull(f func()) {
+	prev := q.done
+	done := make(chan struct{})
+	q.done = done
+	go func() {
+		defer close(done)
+		if prev != nil {
+			<-prev
+		}
+		f()
+	}()
+}
+
+// toAsyncIterator returns an encoder converting a sequence to a JS async iterator,
+// encoding each value of the sequence using encode.
+func toAsyncIterator[T any](ctx *SobekContext, encode func(T) sobek.Value) func(iter.Seq2[T, error]) sobek.Value {
+	return func(seq iter.Seq2[T, error]) sobek.Value {
+		vm := ctx.vm
+		next, stop := iter.Pull2(seq)
+		var pulls pullQueue
+		iterator := vm.NewObject()
+		iterator.Set("next", func(c sobek.FunctionCall) sobek.Value {
+			promise, resolve, reject := vm.NewPromise()
+			pulls.pull(func() {
+				v, err, ok := next()
+				ctx.queueTask(func() {
+					switch {
+					case !ok:
+						resolve(asyncIteratorResult(vm, sobek.Undefined(), true))
+					case err != nil:
+						pulls.pull(stop)
+						reject(vm.NewGoError(err))
+					default:
+						settlePromise(vm, resolve, reject, func() sobek.Value {
+							return asyncIteratorResult(vm, encode(v), false)
+						})
+					}
+				})
+			})
+			return vm.ToValue(promise)
+		})
+		iterator.Set("return", func(c sobek.FunctionCall) sobek.Value {
+			pulls.pull(stop)
+			promise, resolve, _ := vm.NewPromise()
+			resolve(asyncIteratorResult(vm, c.Argument(0), true))
+			return vm.ToValue(promise)
+		})
+		if sym, ok := vm.Get("Symbol").ToObject(vm).Get("asyncIterator").(*sobek.Symbol); ok {
+			iterator.SetSymbol(sym, func(c sobek.FunctionCall) sobek.Value {
+				return c.This
+			})
+		}
+		return iterator
+	}
+}
+
+// asyncIteratorResult creates an iterator result object.
+func asyncIteratorResult(vm *sobek.Runtime, value sobek.Value, done bool) *sobek.Object {
+	result := vm.NewObject()
+	result.Set("value", value)
+	result.Set("done", done)
+	return result
+}
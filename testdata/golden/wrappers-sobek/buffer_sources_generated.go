@@ -0,0 +1,139 @@
+// This file is generated. Do not edit.
+
+package sobekhost
+
+import (
+	sobek "github.com/grafana/sobek"
+	"slices"
+)
+
+func isArrayBuffer(v sobek.Value) bool {
+	_, ok := v.Export().(sobek.ArrayBuffer)
+	return ok
+}
+
+func isArrayBufferView(vm *sobek.Runtime, v sobek.Value) bool {
+	isView, _ := sobek.AssertFunction(vm.Get("ArrayBuffer").ToObject(vm).Get("isView"))
+	result, err := isView(nil, v)
+	return err == nil && result.ToBoolean()
+}
+
+func isUint8Array(vm *sobek.Runtime, v sobek.Value) bool {
+	return vm.InstanceOf(v, vm.Get("Uint8Array").ToObject(vm))
+}
+
+// bufferSourceBytes returns a copy of the bytes of an ArrayBuffer, or of the
+// range of the buffer viewed by an ArrayBufferView.
+func bufferSourceBytes(vm *sobek.Runtime, v sobek.Value) []byte {
+	if buffer, ok := v.Export().(sobek.ArrayBuffer); ok {
+		if buffer.Detached() {
+			panic(vm.NewTypeError("The ArrayBuffer is detached"))
+		}
+		return slices.Clone(buffer.Bytes())
+	}
+	obj := v.ToObject(vm)
+	buffer, _ := obj.Get("buffer").Export().(sobek.ArrayBuffer)
+	if buffer.Detached() {
+		panic(vm.NewTypeError("The ArrayBuffer is detached"))
+	}
+	offset := obj.Get("byteOffset").ToInteger()
+	length := obj.Get("byteLength").ToInteger()
+	return slices.Clone(buffer.Bytes()[offset : offset+length])
+}
+
+func decodeIDLArrayBuffer(vm *sobek.Runtime) func(sobek.Value) []byte {
+	return func(v sobek.Value) []byte {
+		if !isArrayBuffer(v) {
+			panic(vm.NewTypeError("Value is not of type 'ArrayBuffer'"))
+		}
+		return bufferSourceBytes(vm, v)
+	}
+}
+
+func decodeIDLArrayBufferAllowShared(vm *sobek.Runtime) func(sobek.Value) []byte {
+	return func(v sobek.Value) []byte {
+		if !isArrayBuffer(v) {
+			panic(vm.NewTypeError("Value is not of type 'ArrayBuffer'"))
+		}
+		return bufferSourceBytes(vm, v)
+	}
+}
+
+func decodeIDLArrayBufferView(vm *sobek.Runtime) func(sobek.Value) []byte {
+	return func(v sobek.Value) []byte {
+		if !isArrayBufferView(vm, v) {
+			panic(vm.NewTypeError("Value is not of type 'ArrayBufferView'"))
+		}
+		return bufferSourceBytes(vm, v)
+	}
+}
+
+func decodeIDLArrayBufferViewAllowShared(vm *sobek.Runtime) func(sobek.Value) []byte {
+	return func(v sobek.Value) []byte {
+		if !isArrayBufferView(vm, v) {
+			panic(vm.NewTypeError("Value is not of type 'ArrayBufferView'"))
+		}
+		return bufferSourceBytes(vm, v)
+	}
+}
+
+func decodeIDLBufferSource(vm *sobek.Runtime) func(sobek.Value) []byte {
+	return func(v sobek.Value) []byte {
+		if !(isArrayBuffer(v) || isArrayBufferView(vm, v)) {
+			panic(vm.NewTypeError("Value is not of type 'BufferSource'"))
+		}
+		return bufferSourceBytes(vm, v)
+	}
+}
+
+func decodeIDLBufferSourceAllowShared(vm *sobek.Runtime) func(sobek.Value) []byte {
+	return func(v sobek.Value) []byte {
+		if !(isArrayBuffer(v) || isArrayBufferView(vm, v)) {
+			panic(vm.NewTypeError("Value is not of type 'BufferSource'"))
+		}
+		return bufferSourceBytes(vm, v)
+	}
+}
+
+func decodeIDLAllowSharedBufferSource(vm *sobek.Runtime) func(sobek.Value) []byte {
+	return func(v sobek.Value) []byte {
+		if !(isArrayBuffer(v) || isArrayBufferView(vm, v)) {
+			panic(vm.NewTypeError("Value is not of type 'AllowSharedBufferSource'"))
+		}
+		return bufferSourceBytes(vm, v)
+	}
+}
+
+func decodeIDLUint8Array(vm *sobek.Runtime) func(sobek.Value) []byte {
+	return func(v sobek.Value) []byte {
+		if !isUint8Array(vm, v) {
+			panic(vm.NewTypeError("Value is not of type 'Uint8Array'"))
+		}
+		return bufferSourceBytes(vm, v)
+	}
+}
+
+func decodeIDLUint8ArrayAllowShared(vm *sobek.Runtime) func(sobek.Value) []byte {
+	return func(v sobek.Value) []byte {
+		if !isUint8Array(vm, v) {
+			panic(vm.NewTypeError("Value is not of type 'Uint8Array'"))
+		}
+		return bufferSourceBytes(vm, v)
+	}
+}
+
+func toIDLArrayBuffer(vm *sobek.Runtime) func([]byte) sobek.Value {
+	return func(data []byte) sobek.Value {
+		return vm.ToValue(vm.NewArrayBuffer(slices.Clone(data)))
+	}
+}
+
+func toIDLUint8Array(vm *sobek.Runtime) func([]byte) sobek.Value {
+	return func(data []byte) sobek.Value {
+		array, err := vm.New(vm.Get("Uint8Array"), vm.ToValue(vm.NewArrayBuffer(slices.Clone(data))))
+		if err != nil {
+			panic(err)
+		}
+		return array
+	}
+}
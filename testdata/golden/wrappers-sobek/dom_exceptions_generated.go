@@ -0,0 +1,27 @@
+// This file is generated. Do not edit.
+
+package sobekhost
+
+import (
+	"errors"
+	dom "github.com/gost-dom/browser/dom"
+	html "github.com/gost-dom/browser/html"
+)
+
+// mapError converts an error returned from Go code to a DOMException if
+// the error has a known mapping. Other errors are returned unchanged.
+func mapError(ctx *SobekContext, err error) any {
+	if errors.Is(err, dom.ErrHierarchyRequest) {
+		return newDOMException(ctx, err.Error(), "HierarchyRequestError", 3)
+	}
+	if errors.Is(err, dom.ErrNotFound) {
+		return newDOMException(ctx, err.Error(), "NotFoundError", 8)
+	}
+	if target := new(dom.SyntaxError); errors.As(err, target) {
+		return newDOMException(ctx, err.Error(), "SyntaxError", 12)
+	}
+	if errors.Is(err, html.ErrInvalidState) {
+		return newDOMException(ctx, err.Error(), "InvalidStateError", 11)
+	}
+	return err
+}
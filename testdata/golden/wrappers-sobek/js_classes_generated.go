@@ -0,0 +1,7 @@
+// This file is generated. Do not edit.
+
+package sobekhost
+
+func init() {
+	installClass("Node", "EventTarget", newNodeWrapper)
+}
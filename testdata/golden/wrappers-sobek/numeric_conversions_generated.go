@@ -0,0 +1,347 @@
+// This file is generated. Do not edit.
+
+package sobekhost
+
+import (
+	"fmt"
+	sobek "github.com/grafana/sobek"
+	"math"
+)
+
+// convertToInt implements the WebIDL ConvertToInt abstract operation, converting
+// the JS number x to an integer type of bitLength bits.
+//
+// See also: https://webidl.spec.whatwg.org/#abstract-opdef-converttoint
+func convertToInt(x float64, typeName string, bitLength int, signed bool, enforceRange bool, clamp bool) (float64, error) {
+	var lowerBound, upperBound float64
+	if bitLength == 64 {
+		upperBound = math.Pow(2, 53) - 1
+		if signed {
+			lowerBound = -upperBound
+		}
+	} else if signed {
+		lowerBound = -math.Pow(2, float64(bitLength-1))
+		upperBound = math.Pow(2, float64(bitLength-1)) - 1
+	} else {
+		upperBound = math.Pow(2, float64(bitLength)) - 1
+	}
+	if enforceRange {
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return 0, fmt.Errorf("Value is not a finite '%s'", typeName)
+		}
+		x = math.Trunc(x)
+		if x < lowerBound || x > upperBound {
+			return 0, fmt.Errorf("Value is outside the '%s' value range", typeName)
+		}
+		return x, nil
+	}
+	if clamp && !math.IsNaN(x) {
+		return math.RoundToEven(min(max(x, lowerBound), upperBound)), nil
+	}
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return 0, nil
+	}
+	m := math.Pow(2, float64(bitLength))
+	x = math.Mod(math.Trunc(x), m)
+	if x < 0 {
+		x += m
+	}
+	if signed && x >= m/2 {
+		x -= m
+	}
+	return x, nil
+}
+
+// convertToFloat converts the JS number x to an IDL float or double. Single
+// precision values are rounded to the nearest float32.
+//
+// See also: https://webidl.spec.whatwg.org/#es-float
+func convertToFloat(x float64, typeName string, single bool, unrestricted bool) (float64, error) {
+	if single {
+		x = float64(float32(x))
+	}
+	if !unrestricted && (math.IsNaN(x) || math.IsInf(x, 0)) {
+		return 0, fmt.Errorf("Value is not a finite '%s'", typeName)
+	}
+	return x, nil
+}
+
+func decodeIDLByte(vm *sobek.Runtime) func(sobek.Value) int {
+	return func(v sobek.Value) int {
+		x, err := convertToInt(v.ToFloat(), "byte", 8, true, false, false)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return int(x)
+	}
+}
+
+func decodeIDLByteEnforceRange(vm *sobek.Runtime) func(sobek.Value) int {
+	return func(v sobek.Value) int {
+		x, err := convertToInt(v.ToFloat(), "byte", 8, true, true, false)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return int(x)
+	}
+}
+
+func decodeIDLByteClamp(vm *sobek.Runtime) func(sobek.Value) int {
+	return func(v sobek.Value) int {
+		x, err := convertToInt(v.ToFloat(), "byte", 8, true, false, true)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return int(x)
+	}
+}
+
+func decodeIDLOctet(vm *sobek.Runtime) func(sobek.Value) int {
+	return func(v sobek.Value) int {
+		x, err := convertToInt(v.ToFloat(), "octet", 8, false, false, false)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return int(x)
+	}
+}
+
+func decodeIDLOctetEnforceRange(vm *sobek.Runtime) func(sobek.Value) int {
+	return func(v sobek.Value) int {
+		x, err := convertToInt(v.ToFloat(), "octet", 8, false, true, false)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return int(x)
+	}
+}
+
+func decodeIDLOctetClamp(vm *sobek.Runtime) func(sobek.Value) int {
+	return func(v sobek.Value) int {
+		x, err := convertToInt(v.ToFloat(), "octet", 8, false, false, true)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return int(x)
+	}
+}
+
+func decodeIDLShort(vm *sobek.Runtime) func(sobek.Value) int {
+	return func(v sobek.Value) int {
+		x, err := convertToInt(v.ToFloat(), "short", 16, true, false, false)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return int(x)
+	}
+}
+
+func decodeIDLShortEnforceRange(vm *sobek.Runtime) func(sobek.Value) int {
+	return func(v sobek.Value) int {
+		x, err := convertToInt(v.ToFloat(), "short", 16, true, true, false)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return int(x)
+	}
+}
+
+func decodeIDLShortClamp(vm *sobek.Runtime) func(sobek.Value) int {
+	return func(v sobek.Value) int {
+		x, err := convertToInt(v.ToFloat(), "short", 16, true, false, true)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return int(x)
+	}
+}
+
+func decodeIDLUnsignedShort(vm *sobek.Runtime) func(sobek.Value) int {
+	return func(v sobek.Value) int {
+		x, err := convertToInt(v.ToFloat(), "unsigned short", 16, false, false, false)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return int(x)
+	}
+}
+
+func decodeIDLUnsignedShortEnforceRange(vm *sobek.Runtime) func(sobek.Value) int {
+	return func(v sobek.Value) int {
+		x, err := convertToInt(v.ToFloat(), "unsigned short", 16, false, true, false)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return int(x)
+	}
+}
+
+func decodeIDLUnsignedShortClamp(vm *sobek.Runtime) func(sobek.Value) int {
+	return func(v sobek.Value) int {
+		x, err := convertToInt(v.ToFloat(), "unsigned short", 16, false, false, true)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return int(x)
+	}
+}
+
+func decodeIDLLong(vm *sobek.Runtime) func(sobek.Value) int {
+	return func(v sobek.Value) int {
+		x, err := convertToInt(v.ToFloat(), "long", 32, true, false, false)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return int(x)
+	}
+}
+
+func decodeIDLLongEnforceRange(vm *sobek.Runtime) func(sobek.Value) int {
+	return func(v sobek.Value) int {
+		x, err := convertToInt(v.ToFloat(), "long", 32, true, true, false)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return int(x)
+	}
+}
+
+func decodeIDLLongClamp(vm *sobek.Runtime) func(sobek.Value) int {
+	return func(v sobek.Value) int {
+		x, err := convertToInt(v.ToFloat(), "long", 32, true, false, true)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return int(x)
+	}
+}
+
+func decodeIDLUnsignedLong(vm *sobek.Runtime) func(sobek.Value) int {
+	return func(v sobek.Value) int {
+		x, err := convertToInt(v.ToFloat(), "unsigned long", 32, false, false, false)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return int(x)
+	}
+}
+
+func decodeIDLUnsignedLongEnforceRange(vm *sobek.Runtime) func(sobek.Value) int {
+	return func(v sobek.Value) int {
+		x, err := convertToInt(v.ToFloat(), "unsigned long", 32, false, true, false)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return int(x)
+	}
+}
+
+func decodeIDLUnsignedLongClamp(vm *sobek.Runtime) func(sobek.Value) int {
+	return func(v sobek.Value) int {
+		x, err := convertToInt(v.ToFloat(), "unsigned long", 32, false, false, true)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return int(x)
+	}
+}
+
+func decodeIDLLongLong(vm *sobek.Runtime) func(sobek.Value) int64 {
+	return func(v sobek.Value) int64 {
+		x, err := convertToInt(v.ToFloat(), "long long", 64, true, false, false)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return int64(x)
+	}
+}
+
+func decodeIDLLongLongEnforceRange(vm *sobek.Runtime) func(sobek.Value) int64 {
+	return func(v sobek.Value) int64 {
+		x, err := convertToInt(v.ToFloat(), "long long", 64, true, true, false)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return int64(x)
+	}
+}
+
+func decodeIDLLongLongClamp(vm *sobek.Runtime) func(sobek.Value) int64 {
+	return func(v sobek.Value) int64 {
+		x, err := convertToInt(v.ToFloat(), "long long", 64, true, false, true)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return int64(x)
+	}
+}
+
+func decodeIDLUnsignedLongLong(vm *sobek.Runtime) func(sobek.Value) uint64 {
+	return func(v sobek.Value) uint64 {
+		x, err := convertToInt(v.ToFloat(), "unsigned long long", 64, false, false, false)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return uint64(x)
+	}
+}
+
+func decodeIDLUnsignedLongLongEnforceRange(vm *sobek.Runtime) func(sobek.Value) uint64 {
+	return func(v sobek.Value) uint64 {
+		x, err := convertToInt(v.ToFloat(), "unsigned long long", 64, false, true, false)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return uint64(x)
+	}
+}
+
+func decodeIDLUnsignedLongLongClamp(vm *sobek.Runtime) func(sobek.Value) uint64 {
+	return func(v sobek.Value) uint64 {
+		x, err := convertToInt(v.ToFloat(), "unsigned long long", 64, false, false, true)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return uint64(x)
+	}
+}
+
+func decodeIDLFloat(vm *sobek.Runtime) func(sobek.Value) float32 {
+	return func(v sobek.Value) float32 {
+		x, err := convertToFloat(v.ToFloat(), "float", true, false)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return float32(x)
+	}
+}
+
+func decodeIDLUnrestrictedFloat(vm *sobek.Runtime) func(sobek.Value) float32 {
+	return func(v sobek.Value) float32 {
+		x, err := convertToFloat(v.ToFloat(), "unrestricted float", true, true)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return float32(x)
+	}
+}
+
+func decodeIDLDouble(vm *sobek.Runtime) func(sobek.Value) float64 {
+	return func(v sobek.Value) float64 {
+		x, err := convertToFloat(v.ToFloat(), "double", false, false)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return float64(x)
+	}
+}
+
+func decodeIDLUnrestrictedDouble(vm *sobek.Runtime) func(sobek.Value) float64 {
+	return func(v sobek.Value) float64 {
+		x, err := convertToFloat(v.ToFloat(), "unrestricted double", false, true)
+		if err != nil {
+			panic(vm.NewTypeError(err.Error()))
+		}
+		return float64(x)
+	}
+}
@@ -0,0 +1,53 @@
+// This file is generated. Do not edit.
+
+package sobekhost
+
+import sobek "github.com/grafana/sobek"
+
+// toPromise returns an encoder converting a function to a JS promise, settled
+// with the result of the function encoded using encode.
+func toPromise[T any](ctx *SobekContext, encode func(T) sobek.Value) func(func() (T, error)) sobek.Value {
+	return func(f func() (T, error)) sobek.Value {
+		vm := ctx.vm
+		promise, resolve, reject := vm.NewPromise()
+		go func() {
+			v, err := f()
+			ctx.queueTask(func() {
+				if err != nil {
+					reject(vm.NewGoError(err))
+					return
+				}
+				settlePromise(vm, resolve, reject, func() sobek.Value {
+					return encode(v)
+				})
+			})
+		}()
+		return vm.ToValue(promise)
+	}
+}
+
+// toVoidPromise returns an encoder converting a function without a result to a
+// JS promise, resolved with undefined.
+func toVoidPromise(ctx *SobekContext) func(func() error) sobek.Value {
+	encode := toPromise(ctx, func(struct{}) sobek.Value {
+		return sobek.Undefined()
+	})
+	return func(f func() error) sobek.Value {
+		return encode(func() (struct{}, error) {
+			return struct{}{}, f()
+		})
+	}
+}
+
+// settlePromise resolves the promise with the value returned from f, or rejects
+// it with the exception thrown by f.
+func settlePromise(vm *sobek.Runtime, resolve, reject func(any) error, f func() sobek.Value) {
+	var v sobek.Value
+	if ex := vm.Try(func() {
+		v = f()
+	}); ex != nil {
+		reject(ex.Value())
+		return
+	}
+	resolve(v)
+}
@@ -0,0 +1,93 @@
+// This file is generated. Do not edit.
+
+package sobekhost
+
+import (
+	sobek "github.com/grafana/sobek"
+	"maps"
+	"slices"
+	"strconv"
+)
+
+// decodeSequence returns a decoder converting a JS iterable to a slice,
+// decoding each value using decode.
+func decodeSequence[T any](vm *sobek.Runtime, decode func(sobek.Value) T) func(sobek.Value) []T {
+	return func(v sobek.Value) []T {
+		obj, ok := v.(*sobek.Object)
+		if !ok {
+			panic(vm.NewTypeError("The value is not iterable"))
+		}
+		if _, ok := sobek.AssertFunction(obj.GetSymbol(sobek.SymIterator)); !ok {
+			panic(vm.NewTypeError("The value is not iterable"))
+		}
+		// Array.from iterates the value using Symbol.iterator
+		from, _ := sobek.AssertFunction(vm.Get("Array").ToObject(vm).Get("from"))
+		array, err := from(nil, v)
+		if err != nil {
+			panic(err)
+		}
+		items := array.ToObject(vm)
+		result := make([]T, items.Get("length").ToInteger())
+		for i := range result {
+			result[i] = decode(items.Get(strconv.Itoa(i)))
+		}
+		return result
+	}
+}
+
+// decodeRecord returns a decoder converting the own enumerable properties of a
+// JS object to a map, decoding the keys and values using decodeKey and
+// decodeValue.
+func decodeRecord[K comparable, V any](vm *sobek.Runtime, decodeKey func(sobek.Value) K, decodeValue func(sobek.Value) V) func(sobek.Value) map[K]V {
+	return func(v sobek.Value) map[K]V {
+		obj, ok := v.(*sobek.Object)
+		if !ok {
+			panic(vm.NewTypeError("The value is not an object"))
+		}
+		result := make(map[K]V)
+		// Keys returns the names of the own enumerable properties
+		for _, name := range obj.Keys() {
+			key := decodeKey(vm.ToValue(name))
+			result[key] = decodeValue(obj.Get(name))
+		}
+		return result
+	}
+}
+
+// toSequence returns an encoder converting a slice to a JS array, encoding each
+// value using encode.
+func toSequence[T any](vm *sobek.Runtime, encode func(T) sobek.Value) func([]T) sobek.Value {
+	return func(values []T) sobek.Value {
+		items := make([]any, len(values))
+		for i, v := range values {
+			items[i] = encode(v)
+		}
+		return vm.NewArray(items...)
+	}
+}
+
+// toFrozenArray returns an encoder converting a slice to a frozen JS array,
+// encoding each value using encode.
+func toFrozenArray[T any](vm *sobek.Runtime, encode func(T) sobek.Value) func([]T) sobek.Value {
+	toArray := toSequence(vm, encode)
+	return func(values []T) sobek.Value {
+		freeze, _ := sobek.AssertFunction(vm.Get("Object").ToObject(vm).Get("freeze"))
+		array, err := freeze(nil, toArray(values))
+		if err != nil {
+			panic(err)
+		}
+		return array
+	}
+}
+
+// toRecord returns an encoder converting a map to a JS object, encoding each
+// value using encode.
+func toRecord[V any](vm *sobek.Runtime, encode func(V) sobek.Value) func(map[string]V) sobek.Value {
+	return func(values map[string]V) sobek.Value {
+		obj := vm.NewObject()
+		for _, key := range slices.Sorted(maps.Keys(values)) {
+			obj.Set(key, encode(values[key]))
+		}
+		return obj
+	}
+}
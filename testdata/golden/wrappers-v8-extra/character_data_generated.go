@@ -0,0 +1,248 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	dom "github.com/gost-dom/browser/dom"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+func createCharacterDataPrototype(scriptHost *V8ScriptHost) *v8.FunctionTemplate {
+	iso := scriptHost.iso
+	wrapper := newCharacterDataV8Wrapper(scriptHost)
+	nonDocumentTypeChildNodeWrapper := newNonDocumentTypeChildNodeV8Wrapper(scriptHost, "CharacterData")
+	childNodeWrapper := newChildNodeV8Wrapper(scriptHost, "CharacterData")
+	constructor := v8.NewFunctionTemplateWithError(iso, wrapper.Constructor)
+
+	instanceTmpl := constructor.InstanceTemplate()
+	instanceTmpl.SetInternalFieldCount(1)
+
+	prototypeTmpl := constructor.PrototypeTemplate()
+	prototypeTmpl.Set("substringData", v8.NewFunctionTemplateWithError(iso, wrapper.substringData))
+	prototypeTmpl.Set("appendData", v8.NewFunctionTemplateWithError(iso, wrapper.appendData))
+	prototypeTmpl.Set("insertData", v8.NewFunctionTemplateWithError(iso, wrapper.insertData))
+	prototypeTmpl.Set("deleteData", v8.NewFunctionTemplateWithError(iso, wrapper.deleteData))
+	prototypeTmpl.Set("replaceData", v8.NewFunctionTemplateWithError(iso, wrapper.replaceData))
+	prototypeTmpl.Set("before", v8.NewFunctionTemplateWithError(iso, childNodeWrapper.before))
+	prototypeTmpl.Set("after", v8.NewFunctionTemplateWithError(iso, childNodeWrapper.after))
+	prototypeTmpl.Set("replaceWith", v8.NewFunctionTemplateWithError(iso, childNodeWrapper.replaceWith))
+	prototypeTmpl.Set("remove", v8.NewFunctionTemplateWithError(iso, wrapper.remove))
+
+	prototypeTmpl.SetAccessorProperty("data",
+		v8.NewFunctionTemplateWithError(iso, wrapper.data),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setData),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("length",
+		v8.NewFunctionTemplateWithError(iso, wrapper.length),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("previousElementSibling",
+		v8.NewFunctionTemplateWithError(iso, nonDocumentTypeChildNodeWrapper.previousElementSibling),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("nextElementSibling",
+		v8.NewFunctionTemplateWithError(iso, nonDocumentTypeChildNodeWrapper.nextElementSibling),
+		nil,
+		v8.None)
+
+	return constructor
+}
+
+func (d characterDataV8Wrapper) Constructor(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	return nil, v8.NewTypeError(d.scriptHost.iso, "Illegal Constructor")
+}
+
+func (d characterDataV8Wrapper) substringData(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := d.mustGetContext(info)
+	log.Debug("V8 Function call: CharacterData.substringData")
+	args := newArgumentHelper(d.scriptHost, info)
+	wrapped, err := d.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.CharacterData)
+	if !ok {
+		return nil, v8.NewTypeError(d.scriptHost.iso, "CharacterData.substringData: Illegal invocation")
+	}
+	offset, err1 := tryParseArg(args, 0, decodeIDLUnsignedLong)
+	count, err2 := tryParseArg(args, 1, decodeIDLUnsignedLong)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		result, callErr := instance.SubstringData(offset, count)
+		if callErr != nil {
+			return nil, mapError(d.scriptHost, callErr)
+		} else {
+			return d.toDOMString(ctx, result)
+		}
+	}
+	return nil, errors.New("CharacterData.substringData: Missing arguments")
+}
+
+func (d characterDataV8Wrapper) appendData(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: CharacterData.appendData")
+	args := newArgumentHelper(d.scriptHost, info)
+	wrapped, err := d.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.CharacterData)
+	if !ok {
+		return nil, v8.NewTypeError(d.scriptHost.iso, "CharacterData.appendData: Illegal invocation")
+	}
+	data, err1 := tryParseArg(args, 0, d.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		callErr := instance.AppendData(data)
+		if callErr != nil {
+			return nil, mapError(d.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	return nil, errors.New("CharacterData.appendData: Missing arguments")
+}
+
+func (d characterDataV8Wrapper) insertData(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: CharacterData.insertData")
+	args := newArgumentHelper(d.scriptHost, info)
+	wrapped, err := d.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.CharacterData)
+	if !ok {
+		return nil, v8.NewTypeError(d.scriptHost.iso, "CharacterData.insertData: Illegal invocation")
+	}
+	offset, err1 := tryParseArg(args, 0, decodeIDLUnsignedLong)
+	data, err2 := tryParseArg(args, 1, d.decodeDOMString)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		callErr := instance.InsertData(offset, data)
+		if callErr != nil {
+			return nil, mapError(d.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	return nil, errors.New("CharacterData.insertData: Missing arguments")
+}
+
+func (d characterDataV8Wrapper) deleteData(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: CharacterData.deleteData")
+	args := newArgumentHelper(d.scriptHost, info)
+	wrapped, err := d.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.CharacterData)
+	if !ok {
+		return nil, v8.NewTypeError(d.scriptHost.iso, "CharacterData.deleteData: Illegal invocation")
+	}
+	offset, err1 := tryParseArg(args, 0, decodeIDLUnsignedLong)
+	count, err2 := tryParseArg(args, 1, decodeIDLUnsignedLong)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		callErr := instance.DeleteData(offset, count)
+		if callErr != nil {
+			return nil, mapError(d.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	return nil, errors.New("CharacterData.deleteData: Missing arguments")
+}
+
+func (d characterDataV8Wrapper) replaceData(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: CharacterData.replaceData")
+	args := newArgumentHelper(d.scriptHost, info)
+	wrapped, err := d.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.CharacterData)
+	if !ok {
+		return nil, v8.NewTypeError(d.scriptHost.iso, "CharacterData.replaceData: Illegal invocation")
+	}
+	offset, err1 := tryParseArg(args, 0, decodeIDLUnsignedLong)
+	count, err2 := tryParseArg(args, 1, decodeIDLUnsignedLong)
+	data, err3 := tryParseArg(args, 2, d.decodeDOMString)
+	if args.noOfReadArguments >= 3 {
+		err := errors.Join(err1, err2, err3)
+		if err != nil {
+			return nil, err
+		}
+		callErr := instance.ReplaceData(offset, count, data)
+		if callErr != nil {
+			return nil, mapError(d.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	return nil, errors.New("CharacterData.replaceData: Missing arguments")
+}
+
+func (d characterDataV8Wrapper) remove(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: CharacterData.remove")
+	return nil, notImplemented("CharacterData", "remove")
+}
+
+func (d characterDataV8Wrapper) data(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := d.mustGetContext(info)
+	log.Debug("V8 Function call: CharacterData.data")
+	wrapped, err := d.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.CharacterData)
+	if !ok {
+		return nil, v8.NewTypeError(d.scriptHost.iso, "CharacterData.data: Illegal invocation")
+	}
+	result := instance.Data()
+	return d.toDOMString(ctx, result)
+}
+
+func (d characterDataV8Wrapper) setData(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: CharacterData.setData")
+	args := newArgumentHelper(d.scriptHost, info)
+	wrapped, err := d.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.CharacterData)
+	if !ok {
+		return nil, v8.NewTypeError(d.scriptHost.iso, "CharacterData.setData: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, d.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetData(val)
+		return nil, nil
+	}
+	return nil, errors.New("CharacterData.setData: Missing arguments")
+}
+
+func (d characterDataV8Wrapper) length(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := d.mustGetContext(info)
+	log.Debug("V8 Function call: CharacterData.length")
+	wrapped, err := d.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.CharacterData)
+	if !ok {
+		return nil, v8.NewTypeError(d.scriptHost.iso, "CharacterData.length: Illegal invocation")
+	}
+	result := instance.Length()
+	return d.toUnsignedLong(ctx, result)
+}
@@ -0,0 +1,133 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	dom "github.com/gost-dom/browser/dom"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+type childNodeV8Wrapper struct {
+	nodeV8WrapperBase[dom.ChildNode]
+	interfaceName string
+}
+
+func newChildNodeV8Wrapper(scriptHost *V8ScriptHost, interfaceName string) *childNodeV8Wrapper {
+	return &childNodeV8Wrapper{newNodeV8WrapperBase[dom.ChildNode](scriptHost), interfaceName}
+}
+
+// isIncluder returns whether the value implements the interface including
+// the mixin, named by interfaceName.
+func (n childNodeV8Wrapper) isIncluder(value any) bool {
+	switch n.interfaceName {
+	case "CharacterData":
+		_, ok := value.(dom.CharacterData)
+		return ok
+	}
+	return false
+}
+
+func (n childNodeV8Wrapper) before(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: ChildNode.before")
+	args := newArgumentHelper(n.scriptHost, info)
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.ChildNode)
+	if !ok || !n.isIncluder(instance) {
+		return nil, v8.NewTypeError(n.scriptHost.iso, n.interfaceName+".before: Illegal invocation")
+	}
+	nodes, err1 := tryParseVariadicArg(args, 0, n.decodeNode, n.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		callErr := instance.Before(nodes...)
+		if callErr != nil {
+			return nil, mapError(n.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	callErr := instance.Before()
+	if callErr != nil {
+		return nil, mapError(n.scriptHost, callErr)
+	}
+	return nil, nil
+}
+
+func (n childNodeV8Wrapper) after(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: ChildNode.after")
+	args := newArgumentHelper(n.scriptHost, info)
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.ChildNode)
+	if !ok || !n.isIncluder(instance) {
+		return nil, v8.NewTypeError(n.scriptHost.iso, n.interfaceName+".after: Illegal invocation")
+	}
+	nodes, err1 := tryParseVariadicArg(args, 0, n.decodeNode, n.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		callErr := instance.After(nodes...)
+		if callErr != nil {
+			return nil, mapError(n.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	callErr := instance.After()
+	if callErr != nil {
+		return nil, mapError(n.scriptHost, callErr)
+	}
+	return nil, nil
+}
+
+func (n childNodeV8Wrapper) replaceWith(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: ChildNode.replaceWith")
+	args := newArgumentHelper(n.scriptHost, info)
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.ChildNode)
+	if !ok || !n.isIncluder(instance) {
+		return nil, v8.NewTypeError(n.scriptHost.iso, n.interfaceName+".replaceWith: Illegal invocation")
+	}
+	nodes, err1 := tryParseVariadicArg(args, 0, n.decodeNode, n.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		callErr := instance.ReplaceWith(nodes...)
+		if callErr != nil {
+			return nil, mapError(n.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	callErr := instance.ReplaceWith()
+	if callErr != nil {
+		return nil, mapError(n.scriptHost, callErr)
+	}
+	return nil, nil
+}
+
+func (n childNodeV8Wrapper) remove(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: ChildNode.remove")
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.ChildNode)
+	if !ok || !n.isIncluder(instance) {
+		return nil, v8.NewTypeError(n.scriptHost.iso, n.interfaceName+".remove: Illegal invocation")
+	}
+	callErr := instance.Remove()
+	if callErr != nil {
+		return nil, mapError(n.scriptHost, callErr)
+	}
+	return nil, nil
+}
@@ -0,0 +1,113 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	html "github.com/gost-dom/browser/html"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+func createHTMLLabelElementPrototype(scriptHost *V8ScriptHost) *v8.FunctionTemplate {
+	iso := scriptHost.iso
+	wrapper := newHTMLLabelElementV8Wrapper(scriptHost)
+	constructor := v8.NewFunctionTemplateWithError(iso, wrapper.Constructor)
+
+	instanceTmpl := constructor.InstanceTemplate()
+	instanceTmpl.SetInternalFieldCount(1)
+
+	prototypeTmpl := constructor.PrototypeTemplate()
+
+	prototypeTmpl.SetAccessorProperty("form",
+		v8.NewFunctionTemplateWithError(iso, wrapper.form),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("htmlFor",
+		v8.NewFunctionTemplateWithError(iso, wrapper.htmlFor),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setHtmlFor),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("control",
+		v8.NewFunctionTemplateWithError(iso, wrapper.control),
+		nil,
+		v8.None)
+
+	return constructor
+}
+
+func (e hTMLLabelElementV8Wrapper) Constructor(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	return nil, v8.NewTypeError(e.scriptHost.iso, "Illegal Constructor")
+}
+
+func (e hTMLLabelElementV8Wrapper) form(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: HTMLLabelElement.form")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLLabelElement)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "HTMLLabelElement.form: Illegal invocation")
+	}
+	result := instance.Form()
+	if result == nil {
+		return v8.Null(e.scriptHost.iso), nil
+	}
+	return ctx.getInstanceForNode(result)
+}
+
+func (e hTMLLabelElementV8Wrapper) htmlFor(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: HTMLLabelElement.htmlFor")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLLabelElement)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "HTMLLabelElement.htmlFor: Illegal invocation")
+	}
+	result := instance.HtmlFor()
+	return e.toDOMString(ctx, result)
+}
+
+func (e hTMLLabelElementV8Wrapper) setHtmlFor(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLLabelElement.setHtmlFor")
+	args := newArgumentHelper(e.scriptHost, info)
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLLabelElement)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "HTMLLabelElement.setHtmlFor: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, e.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetHtmlFor(val)
+		return nil, nil
+	}
+	return nil, errors.New("HTMLLabelElement.setHtmlFor: Missing arguments")
+}
+
+func (e hTMLLabelElementV8Wrapper) control(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: HTMLLabelElement.control")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLLabelElement)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "HTMLLabelElement.control: Illegal invocation")
+	}
+	result := instance.Control()
+	if result == nil {
+		return v8.Null(e.scriptHost.iso), nil
+	}
+	return ctx.getInstanceForNode(result)
+}
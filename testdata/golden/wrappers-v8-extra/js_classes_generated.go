@@ -0,0 +1,10 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+func init() {
+	registerJSClass("CharacterData", "Node", createCharacterDataPrototype)
+	registerJSClass("HTMLLabelElement", "HTMLElement", createHTMLLabelElementPrototype)
+	registerJSClass("NavigationCurrentEntryChangeEvent", "Event", createNavigationCurrentEntryChangeEventPrototype)
+	registerJSClass("ReadableStream", "", createReadableStreamPrototype)
+}
@@ -0,0 +1,77 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	html "github.com/gost-dom/browser/html"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+func createNavigationCurrentEntryChangeEventPrototype(scriptHost *V8ScriptHost) *v8.FunctionTemplate {
+	iso := scriptHost.iso
+	wrapper := newNavigationCurrentEntryChangeEventV8Wrapper(scriptHost)
+	constructor := v8.NewFunctionTemplateWithError(iso, wrapper.Constructor)
+
+	instanceTmpl := constructor.InstanceTemplate()
+	instanceTmpl.SetInternalFieldCount(1)
+
+	prototypeTmpl := constructor.PrototypeTemplate()
+
+	prototypeTmpl.SetAccessorProperty("navigationType",
+		v8.NewFunctionTemplateWithError(iso, wrapper.navigationType),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("from",
+		v8.NewFunctionTemplateWithError(iso, wrapper.from),
+		nil,
+		v8.None)
+
+	return constructor
+}
+
+func (e navigationCurrentEntryChangeEventV8Wrapper) Constructor(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	args := newArgumentHelper(e.scriptHost, info)
+	type_, err1 := tryParseArg(args, 0, e.decodeDOMString)
+	eventInitDict, err2 := tryParseArg(args, 1, e.decodeNavigationCurrentEntryChangeEventInit)
+	ctx := e.mustGetContext(info)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		return e.CreateInstance(ctx, info.This(), type_, eventInitDict)
+	}
+	return nil, errors.New("NavigationCurrentEntryChangeEvent.constructor: Missing arguments")
+}
+
+func (e navigationCurrentEntryChangeEventV8Wrapper) navigationType(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: NavigationCurrentEntryChangeEvent.navigationType")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.NavigationCurrentEntryChangeEvent)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "NavigationCurrentEntryChangeEvent.navigationType: Illegal invocation")
+	}
+	result := instance.NavigationType()
+	return e.toNullableNavigationType(ctx, result)
+}
+
+func (e navigationCurrentEntryChangeEventV8Wrapper) from(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: NavigationCurrentEntryChangeEvent.from")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.NavigationCurrentEntryChangeEvent)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "NavigationCurrentEntryChangeEvent.from: Illegal invocation")
+	}
+	result := instance.From()
+	return e.toNavigationHistoryEntry(ctx, result)
+}
@@ -0,0 +1,65 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	dom "github.com/gost-dom/browser/dom"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+type nonDocumentTypeChildNodeV8Wrapper struct {
+	nodeV8WrapperBase[dom.NonDocumentTypeChildNode]
+	interfaceName string
+}
+
+func newNonDocumentTypeChildNodeV8Wrapper(scriptHost *V8ScriptHost, interfaceName string) *nonDocumentTypeChildNodeV8Wrapper {
+	return &nonDocumentTypeChildNodeV8Wrapper{newNodeV8WrapperBase[dom.NonDocumentTypeChildNode](scriptHost), interfaceName}
+}
+
+// isIncluder returns whether the value implements the interface including
+// the mixin, named by interfaceName.
+func (n nonDocumentTypeChildNodeV8Wrapper) isIncluder(value any) bool {
+	switch n.interfaceName {
+	case "CharacterData":
+		_, ok := value.(dom.CharacterData)
+		return ok
+	}
+	return false
+}
+
+func (n nonDocumentTypeChildNodeV8Wrapper) previousElementSibling(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: NonDocumentTypeChildNode.previousElementSibling")
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.NonDocumentTypeChildNode)
+	if !ok || !n.isIncluder(instance) {
+		return nil, v8.NewTypeError(n.scriptHost.iso, n.interfaceName+".previousElementSibling: Illegal invocation")
+	}
+	result := instance.PreviousElementSibling()
+	if result == nil {
+		return v8.Null(n.scriptHost.iso), nil
+	}
+	return ctx.getInstanceForNode(result)
+}
+
+func (n nonDocumentTypeChildNodeV8Wrapper) nextElementSibling(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: NonDocumentTypeChildNode.nextElementSibling")
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.NonDocumentTypeChildNode)
+	if !ok || !n.isIncluder(instance) {
+		return nil, v8.NewTypeError(n.scriptHost.iso, n.interfaceName+".nextElementSibling: Illegal invocation")
+	}
+	result := instance.NextElementSibling()
+	if result == nil {
+		return v8.Null(n.scriptHost.iso), nil
+	}
+	return ctx.getInstanceForNode(result)
+}
@@ -0,0 +1,43 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import "fmt"
+
+// NotImplementedMember identifies a member of a wrapped interface that is
+// not implemented.
+type NotImplementedMember struct {
+	Interface string
+	Member    string
+}
+
+// NotImplementedMembers contains all members that are not implemented, and
+// throw an error when called from JavaScript.
+var NotImplementedMembers = []NotImplementedMember{
+	{"CharacterData", "remove"},
+}
+
+// NotImplementedError is the error returned when JavaScript calls a member
+// that is not implemented.
+type NotImplementedError struct {
+	NotImplementedMember
+}
+
+func (e NotImplementedError) Error() string {
+	return fmt.Sprintf("%s.%s: Not implemented. Create an issue: %s", e.Interface, e.Member, "https://github.com/gost-dom/browser/issues")
+}
+
+// OnNotImplemented is called when JavaScript calls a member that is not
+// implemented, e.g., to log or count which missing APIs scripts use. Set it
+// before running scripts.
+var OnNotImplemented func(NotImplementedMember)
+
+// notImplemented reports the call to a member that is not implemented to
+// OnNotImplemented, and returns the error to throw.
+func notImplemented(intf string, member string) error {
+	m := NotImplementedMember{intf, member}
+	if OnNotImplemented != nil {
+		OnNotImplemented(m)
+	}
+	return NotImplementedError{m}
+}
@@ -0,0 +1,291 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"fmt"
+	v8 "github.com/tommie/v8go"
+	"math"
+)
+
+// convertToInt implements the WebIDL ConvertToInt abstract operation, converting
+// the JS number x to an integer type of bitLength bits.
+//
+// See also: https://webidl.spec.whatwg.org/#abstract-opdef-converttoint
+func convertToInt(x float64, typeName string, bitLength int, signed bool, enforceRange bool, clamp bool) (float64, error) {
+	var lowerBound, upperBound float64
+	if bitLength == 64 {
+		upperBound = math.Pow(2, 53) - 1
+		if signed {
+			lowerBound = -upperBound
+		}
+	} else if signed {
+		lowerBound = -math.Pow(2, float64(bitLength-1))
+		upperBound = math.Pow(2, float64(bitLength-1)) - 1
+	} else {
+		upperBound = math.Pow(2, float64(bitLength)) - 1
+	}
+	if enforceRange {
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return 0, fmt.Errorf("Value is not a finite '%s'", typeName)
+		}
+		x = math.Trunc(x)
+		if x < lowerBound || x > upperBound {
+			return 0, fmt.Errorf("Value is outside the '%s' value range", typeName)
+		}
+		return x, nil
+	}
+	if clamp && !math.IsNaN(x) {
+		return math.RoundToEven(min(max(x, lowerBound), upperBound)), nil
+	}
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return 0, nil
+	}
+	m := math.Pow(2, float64(bitLength))
+	x = math.Mod(math.Trunc(x), m)
+	if x < 0 {
+		x += m
+	}
+	if signed && x >= m/2 {
+		x -= m
+	}
+	return x, nil
+}
+
+// convertToFloat converts the JS number x to an IDL float or double. Single
+// precision values are rounded to the nearest float32.
+//
+// See also: https://webidl.spec.whatwg.org/#es-float
+func convertToFloat(x float64, typeName string, single bool, unrestricted bool) (float64, error) {
+	if single {
+		x = float64(float32(x))
+	}
+	if !unrestricted && (math.IsNaN(x) || math.IsInf(x, 0)) {
+		return 0, fmt.Errorf("Value is not a finite '%s'", typeName)
+	}
+	return x, nil
+}
+
+func decodeIDLByte(ctx *V8ScriptContext, val *v8.Value) (int, error) {
+	x, err := convertToInt(val.Number(), "byte", 8, true, false, false)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return int(x), nil
+}
+
+func decodeIDLByteEnforceRange(ctx *V8ScriptContext, val *v8.Value) (int, error) {
+	x, err := convertToInt(val.Number(), "byte", 8, true, true, false)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return int(x), nil
+}
+
+func decodeIDLByteClamp(ctx *V8ScriptContext, val *v8.Value) (int, error) {
+	x, err := convertToInt(val.Number(), "byte", 8, true, false, true)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return int(x), nil
+}
+
+func decodeIDLOctet(ctx *V8ScriptContext, val *v8.Value) (int, error) {
+	x, err := convertToInt(val.Number(), "octet", 8, false, false, false)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return int(x), nil
+}
+
+func decodeIDLOctetEnforceRange(ctx *V8ScriptContext, val *v8.Value) (int, error) {
+	x, err := convertToInt(val.Number(), "octet", 8, false, true, false)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return int(x), nil
+}
+
+func decodeIDLOctetClamp(ctx *V8ScriptContext, val *v8.Value) (int, error) {
+	x, err := convertToInt(val.Number(), "octet", 8, false, false, true)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return int(x), nil
+}
+
+func decodeIDLShort(ctx *V8ScriptContext, val *v8.Value) (int, error) {
+	x, err := convertToInt(val.Number(), "short", 16, true, false, false)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return int(x), nil
+}
+
+func decodeIDLShortEnforceRange(ctx *V8ScriptContext, val *v8.Value) (int, error) {
+	x, err := convertToInt(val.Number(), "short", 16, true, true, false)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return int(x), nil
+}
+
+func decodeIDLShortClamp(ctx *V8ScriptContext, val *v8.Value) (int, error) {
+	x, err := convertToInt(val.Number(), "short", 16, true, false, true)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return int(x), nil
+}
+
+func decodeIDLUnsignedShort(ctx *V8ScriptContext, val *v8.Value) (int, error) {
+	x, err := convertToInt(val.Number(), "unsigned short", 16, false, false, false)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return int(x), nil
+}
+
+func decodeIDLUnsignedShortEnforceRange(ctx *V8ScriptContext, val *v8.Value) (int, error) {
+	x, err := convertToInt(val.Number(), "unsigned short", 16, false, true, false)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return int(x), nil
+}
+
+func decodeIDLUnsignedShortClamp(ctx *V8ScriptContext, val *v8.Value) (int, error) {
+	x, err := convertToInt(val.Number(), "unsigned short", 16, false, false, true)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return int(x), nil
+}
+
+func decodeIDLLong(ctx *V8ScriptContext, val *v8.Value) (int, error) {
+	x, err := convertToInt(val.Number(), "long", 32, true, false, false)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return int(x), nil
+}
+
+func decodeIDLLongEnforceRange(ctx *V8ScriptContext, val *v8.Value) (int, error) {
+	x, err := convertToInt(val.Number(), "long", 32, true, true, false)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return int(x), nil
+}
+
+func decodeIDLLongClamp(ctx *V8ScriptContext, val *v8.Value) (int, error) {
+	x, err := convertToInt(val.Number(), "long", 32, true, false, true)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return int(x), nil
+}
+
+func decodeIDLUnsignedLong(ctx *V8ScriptContext, val *v8.Value) (int, error) {
+	x, err := convertToInt(val.Number(), "unsigned long", 32, false, false, false)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return int(x), nil
+}
+
+func decodeIDLUnsignedLongEnforceRange(ctx *V8ScriptContext, val *v8.Value) (int, error) {
+	x, err := convertToInt(val.Number(), "unsigned long", 32, false, true, false)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return int(x), nil
+}
+
+func decodeIDLUnsignedLongClamp(ctx *V8ScriptContext, val *v8.Value) (int, error) {
+	x, err := convertToInt(val.Number(), "unsigned long", 32, false, false, true)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return int(x), nil
+}
+
+func decodeIDLLongLong(ctx *V8ScriptContext, val *v8.Value) (int64, error) {
+	x, err := convertToInt(val.Number(), "long long", 64, true, false, false)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return int64(x), nil
+}
+
+func decodeIDLLongLongEnforceRange(ctx *V8ScriptContext, val *v8.Value) (int64, error) {
+	x, err := convertToInt(val.Number(), "long long", 64, true, true, false)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return int64(x), nil
+}
+
+func decodeIDLLongLongClamp(ctx *V8ScriptContext, val *v8.Value) (int64, error) {
+	x, err := convertToInt(val.Number(), "long long", 64, true, false, true)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return int64(x), nil
+}
+
+func decodeIDLUnsignedLongLong(ctx *V8ScriptContext, val *v8.Value) (uint64, error) {
+	x, err := convertToInt(val.Number(), "unsigned long long", 64, false, false, false)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return uint64(x), nil
+}
+
+func decodeIDLUnsignedLongLongEnforceRange(ctx *V8ScriptContext, val *v8.Value) (uint64, error) {
+	x, err := convertToInt(val.Number(), "unsigned long long", 64, false, true, false)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return uint64(x), nil
+}
+
+func decodeIDLUnsignedLongLongClamp(ctx *V8ScriptContext, val *v8.Value) (uint64, error) {
+	x, err := convertToInt(val.Number(), "unsigned long long", 64, false, false, true)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return uint64(x), nil
+}
+
+func decodeIDLFloat(ctx *V8ScriptContext, val *v8.Value) (float32, error) {
+	x, err := convertToFloat(val.Number(), "float", true, false)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return float32(x), nil
+}
+
+func decodeIDLUnrestrictedFloat(ctx *V8ScriptContext, val *v8.Value) (float32, error) {
+	x, err := convertToFloat(val.Number(), "unrestricted float", true, true)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return float32(x), nil
+}
+
+func decodeIDLDouble(ctx *V8ScriptContext, val *v8.Value) (float64, error) {
+	x, err := convertToFloat(val.Number(), "double", false, false)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return float64(x), nil
+}
+
+func decodeIDLUnrestrictedDouble(ctx *V8ScriptContext, val *v8.Value) (float64, error) {
+	x, err := convertToFloat(val.Number(), "unrestricted double", false, true)
+	if err != nil {
+		return 0, v8.NewTypeError(ctx.host.iso, err.Error())
+	}
+	return float64(x), nil
+}
@@ -0,0 +1,266 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	html "github.com/gost-dom/browser/html"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+func createReadableStreamPrototype(scriptHost *V8ScriptHost) *v8.FunctionTemplate {
+	iso := scriptHost.iso
+	wrapper := newReadableStreamV8Wrapper(scriptHost)
+	constructor := v8.NewFunctionTemplateWithError(iso, wrapper.Constructor)
+
+	instanceTmpl := constructor.InstanceTemplate()
+	instanceTmpl.SetInternalFieldCount(1)
+
+	prototypeTmpl := constructor.PrototypeTemplate()
+	prototypeTmpl.Set("cancel", v8.NewFunctionTemplateWithError(iso, wrapper.cancel))
+	prototypeTmpl.Set("getReader", v8.NewFunctionTemplateWithError(iso, wrapper.getReader))
+	prototypeTmpl.Set("pipeThrough", v8.NewFunctionTemplateWithError(iso, wrapper.pipeThrough))
+	prototypeTmpl.Set("pipeTo", v8.NewFunctionTemplateWithError(iso, wrapper.pipeTo))
+	prototypeTmpl.Set("tee", v8.NewFunctionTemplateWithError(iso, wrapper.tee))
+	prototypeTmpl.Set("values", v8.NewFunctionTemplateWithError(iso, wrapper.values))
+	prototypeTmpl.SetSymbol(v8.SymbolAsyncIterator(iso), v8.NewFunctionTemplateWithError(iso, wrapper.values))
+
+	prototypeTmpl.SetAccessorProperty("locked",
+		v8.NewFunctionTemplateWithError(iso, wrapper.locked),
+		nil,
+		v8.None)
+
+	return constructor
+}
+
+func (s readableStreamV8Wrapper) Constructor(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	args := newArgumentHelper(s.scriptHost, info)
+	underlyingSource, err1 := tryParseArg(args, 0, s.decodeObject)
+	strategy, err2 := tryParseArg(args, 1, s.decodeQueuingStrategy)
+	ctx := s.mustGetContext(info)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		return s.CreateInstanceUnderlyingSourceStrategy(ctx, info.This(), underlyingSource, strategy)
+	}
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		return s.CreateInstanceUnderlyingSource(ctx, info.This(), underlyingSource)
+	}
+	return s.CreateInstance(ctx, info.This())
+}
+
+func (s readableStreamV8Wrapper) cancel(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := s.mustGetContext(info)
+	log.Debug("V8 Function call: ReadableStream.cancel")
+	args := newArgumentHelper(s.scriptHost, info)
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.ReadableStream)
+	if !ok {
+		return nil, v8.NewTypeError(s.scriptHost.iso, "ReadableStream.cancel: Illegal invocation")
+	}
+	reason, err1 := tryParseArg(args, 0, s.decodeAny)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.CancelReason(reason)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		} else {
+			return toVoidPromise(ctx, result)
+		}
+	}
+	result, callErr := instance.Cancel()
+	if callErr != nil {
+		return nil, mapError(s.scriptHost, callErr)
+	} else {
+		return toVoidPromise(ctx, result)
+	}
+}
+
+func (s readableStreamV8Wrapper) getReader(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := s.mustGetContext(info)
+	log.Debug("V8 Function call: ReadableStream.getReader")
+	args := newArgumentHelper(s.scriptHost, info)
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.ReadableStream)
+	if !ok {
+		return nil, v8.NewTypeError(s.scriptHost.iso, "ReadableStream.getReader: Illegal invocation")
+	}
+	options, err1 := tryParseArg(args, 0, s.decodeReadableStreamGetReaderOptions)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.GetReaderOptions(options)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		} else {
+			return s.toReadableStreamReader(ctx, result)
+		}
+	}
+	result, callErr := instance.GetReader()
+	if callErr != nil {
+		return nil, mapError(s.scriptHost, callErr)
+	} else {
+		return s.toReadableStreamReader(ctx, result)
+	}
+}
+
+func (s readableStreamV8Wrapper) pipeThrough(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := s.mustGetContext(info)
+	log.Debug("V8 Function call: ReadableStream.pipeThrough")
+	args := newArgumentHelper(s.scriptHost, info)
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.ReadableStream)
+	if !ok {
+		return nil, v8.NewTypeError(s.scriptHost.iso, "ReadableStream.pipeThrough: Illegal invocation")
+	}
+	transform, err1 := tryParseArg(args, 0, s.decodeReadableWritablePair)
+	options, err2 := tryParseArg(args, 1, s.decodeStreamPipeOptions)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		result, callErr := instance.PipeThroughOptions(transform, options)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		} else {
+			return s.toReadableStream(ctx, result)
+		}
+	}
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.PipeThrough(transform)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		} else {
+			return s.toReadableStream(ctx, result)
+		}
+	}
+	return nil, errors.New("ReadableStream.pipeThrough: Missing arguments")
+}
+
+func (s readableStreamV8Wrapper) pipeTo(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := s.mustGetContext(info)
+	log.Debug("V8 Function call: ReadableStream.pipeTo")
+	args := newArgumentHelper(s.scriptHost, info)
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.ReadableStream)
+	if !ok {
+		return nil, v8.NewTypeError(s.scriptHost.iso, "ReadableStream.pipeTo: Illegal invocation")
+	}
+	destination, err1 := tryParseArg(args, 0, s.decodeWritableStream)
+	options, err2 := tryParseArg(args, 1, s.decodeStreamPipeOptions)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		result, callErr := instance.PipeToOptions(destination, options)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		} else {
+			return toVoidPromise(ctx, result)
+		}
+	}
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.PipeTo(destination)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		} else {
+			return toVoidPromise(ctx, result)
+		}
+	}
+	return nil, errors.New("ReadableStream.pipeTo: Missing arguments")
+}
+
+func (s readableStreamV8Wrapper) tee(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := s.mustGetContext(info)
+	log.Debug("V8 Function call: ReadableStream.tee")
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.ReadableStream)
+	if !ok {
+		return nil, v8.NewTypeError(s.scriptHost.iso, "ReadableStream.tee: Illegal invocation")
+	}
+	result, callErr := instance.Tee()
+	if callErr != nil {
+		return nil, mapError(s.scriptHost, callErr)
+	} else {
+		return toSequence(s.toReadableStream)(ctx, result)
+	}
+}
+
+func (s readableStreamV8Wrapper) values(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := s.mustGetContext(info)
+	log.Debug("V8 Function call: ReadableStream.values")
+	args := newArgumentHelper(s.scriptHost, info)
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.ReadableStream)
+	if !ok {
+		return nil, v8.NewTypeError(s.scriptHost.iso, "ReadableStream.values: Illegal invocation")
+	}
+	options, err1 := tryParseArg(args, 0, s.decodeReadableStreamIteratorOptions)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.ValuesOptions(options)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		} else {
+			return toAsyncIterator(s.toAny)(ctx, result)
+		}
+	}
+	result, callErr := instance.Values()
+	if callErr != nil {
+		return nil, mapError(s.scriptHost, callErr)
+	} else {
+		return toAsyncIterator(s.toAny)(ctx, result)
+	}
+}
+
+func (s readableStreamV8Wrapper) locked(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := s.mustGetContext(info)
+	log.Debug("V8 Function call: ReadableStream.locked")
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.ReadableStream)
+	if !ok {
+		return nil, v8.NewTypeError(s.scriptHost.iso, "ReadableStream.locked: Illegal invocation")
+	}
+	result := instance.Locked()
+	return s.toBoolean(ctx, result)
+}
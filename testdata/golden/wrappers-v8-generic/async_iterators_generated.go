@@ -0,0 +1,202 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	v8 "github.com/tommie/v8go"
+	"iter"
+)
+
+// asyncIterator is the state of a JS async iterator. next pulls the next value
+// from the sequence, and returns the function encoding it in the script
+// context, also returning if the iterator is done.
+type asyncIterator struct {
+	next  func() func() (*v8.Value, bool, error)
+	stop  func()
+	pulls pullQueue
+}
+
+// asyncIterators contains the async iterators of a script context that
+// haven't finished, by the ID in the internal field of the iterator object.
+type asyncIterators struct {
+	lastID    uint32
+	iterators map[uint32]*asyncIterator
+}
+
+// dispose stops the sequences of the iterators that haven't finished.
+func (i *asyncIterators) dispose() {
+	for _, iterator := range i.iterators {
+		iterator.pulls.pull(iterator.stop)
+	}
+	clear(i.iterators)
+}
+
+// addAsyncIterator adds the iterator to the script context, and returns its ID.
+func addAsyncIterator(ctx *V8ScriptContext, iterator *asyncIterator) uint32 {
+	iterators := &ctx.asyncIterators
+	if iterators.iterators == nil {
+		iterators.iterators = make(map[uint32]*asyncIterator)
+		ctx.addDisposer(iterators)
+	}
+	iterators.lastID++
+	iterators.iterators[iterators.lastID] = iterator
+	return iterators.lastID
+}
+
+// removeAsyncIterator stops the sequence of the iterator, after the pending
+// pulls, and removes it from the script context.
+func removeAsyncIterator(ctx *V8ScriptContext, id uint32) {
+	if iterator, ok := ctx.asyncIterators.iterators[id]; ok {
+		iterator.pulls.pull(iterator.stop)
+		delete(ctx.asyncIterators.iterators, id)
+	}
+}
+
+// pullQueue runs the functions pulling values from the sequence of an async
+// iterator, one at a time, in the order they were added. The queue is only
+// used from the JS thread.
+type pullQueue struct {
+	done chan struct{}
+}
+
+// pull runs f in a new goroutine when the previous function has returned.
+func (q *pullQueue) pull(f func()) {
+	prev := q.done
+	done := make(chan struct{})
+	q.done = done
+	go func() {
+		defer close(done)
+		if prev != nil {
+			<-prev
+		}
+		f()
+	}()
+}
+
+// toAsyncIterator returns an encoder converting a sequence to a JS async iterator,
+// encoding each value of the sequence using encode.
+func toAsyncIterator[T any](encode func(*V8ScriptContext, T) (*v8.Value, error)) func(*V8ScriptContext, iter.Seq2[T, error]) (*v8.Value, error) {
+	return func(ctx *V8ScriptContext, seq iter.Seq2[T, error]) (*v8.Value, error) {
+		iterator, err := asyncIteratorTemplate(ctx.host).NewInstance(ctx.v8ctx)
+		if err != nil {
+			return nil, err
+		}
+		next, stop := iter.Pull2(seq)
+		id := addAsyncIterator(ctx, &asyncIterator{
+			next: func() func() (*v8.Value, bool, error) {
+				value, err, ok := next()
+				return func() (*v8.Value, bool, error) {
+					if !ok {
+						return v8.Undefined(ctx.host.iso), true, nil
+					}
+					if err != nil {
+						return nil, true, err
+					}
+					encoded, err := encode(ctx, value)
+					return encoded, err != nil, err
+				}
+			},
+			stop: stop,
+		})
+		if err := iterator.SetInternalField(0, id); err != nil {
+			removeAsyncIterator(ctx, id)
+			return nil, err
+		}
+		return iterator.Value, nil
+	}
+}
+
+// asyncIteratorTemplate returns the template of async iterator objects, created
+// once for each script host. The functions find the iterator in the script
+// context by the ID in the internal field of the receiver.
+func asyncIteratorTemplate(host *V8ScriptHost) *v8.ObjectTemplate {
+	if host.asyncIteratorTemplate != nil {
+		return host.asyncIteratorTemplate
+	}
+	iso := host.iso
+	host.asyncIteratorTemplate = v8.NewObjectTemplate(iso)
+	host.asyncIteratorTemplate.SetInternalFieldCount(1)
+	host.asyncIteratorTemplate.Set("next", v8.NewFunctionTemplateWithError(iso, func(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+		ctx := host.mustGetContext(info.Context())
+		this := info.This()
+		if this.InternalFieldCount() == 0 || !this.GetInternalField(0).IsUint32() {
+			return nil, v8.NewTypeError(iso, "AsyncIterator.next: Illegal invocation")
+		}
+		id := this.GetInternalField(0).Uint32()
+		iterator, ok := ctx.asyncIterators.iterators[id]
+		if !ok {
+			return asyncIteratorResult(ctx, v8.Undefined(iso), true)
+		}
+		resolver, err := v8.NewPromiseResolver(ctx.v8ctx)
+		if err != nil {
+			return nil, err
+		}
+		iterator.pulls.pull(func() {
+			settle := iterator.next()
+			ctx.queueTask(func() {
+				value, done, err := settle()
+				if done {
+					removeAsyncIterator(ctx, id)
+				}
+				settleAsyncIteratorResult(ctx, resolver, value, done, err)
+			})
+		})
+		return resolver.GetPromise().Value, nil
+	}))
+	host.asyncIteratorTemplate.Set("return", v8.NewFunctionTemplateWithError(iso, func(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+		ctx := host.mustGetContext(info.Context())
+		this := info.This()
+		if this.InternalFieldCount() == 0 || !this.GetInternalField(0).IsUint32() {
+			return nil, v8.NewTypeError(iso, "AsyncIterator.return: Illegal invocation")
+		}
+		id := this.GetInternalField(0).Uint32()
+		removeAsyncIterator(ctx, id)
+		value := v8.Undefined(iso)
+		if args := info.Args(); len(args) > 0 {
+			value = args[0]
+		}
+		return asyncIteratorResult(ctx, value, true)
+	}))
+	host.asyncIteratorTemplate.SetSymbol(v8.SymbolAsyncIterator(iso), v8.NewFunctionTemplateWithError(iso, func(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+		return info.This().Value, nil
+	}))
+	return host.asyncIteratorTemplate
+}
+
+// newIteratorResult creates an iterator result object.
+func newIteratorResult(ctx *V8ScriptContext, value *v8.Value, done bool) (*v8.Object, error) {
+	result, err := v8.NewObjectTemplate(ctx.host.iso).NewInstance(ctx.v8ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := result.Set("value", value); err != nil {
+		return nil, err
+	}
+	if err := result.Set("done", done); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// settleAsyncIteratorResult resolves the promise with an iterator result
+// object, or rejects it with an Error if reason is not nil.
+func settleAsyncIteratorResult(ctx *V8ScriptContext, resolver *v8.PromiseResolver, value *v8.Value, done bool, reason error) {
+	if reason == nil {
+		var result *v8.Object
+		if result, reason = newIteratorResult(ctx, value, done); reason == nil {
+			resolver.Resolve(result.Value)
+			return
+		}
+	}
+	rejectPromise(ctx, resolver, reason)
+}
+
+// asyncIteratorResult returns a promise resolved with an iterator result object.
+func asyncIteratorResult(ctx *V8ScriptContext, value *v8.Value, done bool) (*v8.Value, error) {
+	resolver, err := v8.NewPromiseResolver(ctx.v8ctx)
+	if err != nil {
+		return nil, err
+	}
+	settleAsyncIteratorResult(ctx, resolver, value, done, nil)
+	return resolver.GetPromise().Value, nil
+}
@@ -0,0 +1,460 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	dom "github.com/gost-dom/browser/dom"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+type eventV8Wrapper struct {
+	nodeV8WrapperBase[dom.Event]
+}
+
+func newEventV8Wrapper(scriptHost *V8ScriptHost) *eventV8Wrapper {
+	return &eventV8Wrapper{newNodeV8WrapperBase[dom.Event](scriptHost)}
+}
+
+func createEventPrototype(scriptHost *V8ScriptHost) *v8.FunctionTemplate {
+	iso := scriptHost.iso
+	wrapper := newEventV8Wrapper(scriptHost)
+	constructor := v8.NewFunctionTemplateWithError(iso, wrapper.Constructor)
+
+	instanceTmpl := constructor.InstanceTemplate()
+	instanceTmpl.SetInternalFieldCount(1)
+
+	prototypeTmpl := constructor.PrototypeTemplate()
+	prototypeTmpl.Set("composedPath", v8.NewFunctionTemplateWithError(iso, wrapper.composedPath))
+	prototypeTmpl.Set("stopPropagation", v8.NewFunctionTemplateWithError(iso, wrapper.stopPropagation))
+	prototypeTmpl.Set("stopImmediatePropagation", v8.NewFunctionTemplateWithError(iso, wrapper.stopImmediatePropagation))
+	prototypeTmpl.Set("preventDefault", v8.NewFunctionTemplateWithError(iso, wrapper.preventDefault))
+	prototypeTmpl.Set("initEvent", v8.NewFunctionTemplateWithError(iso, wrapper.initEvent))
+
+	prototypeTmpl.SetAccessorProperty("type",
+		v8.NewFunctionTemplateWithError(iso, wrapper.type_),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("target",
+		v8.NewFunctionTemplateWithError(iso, wrapper.target),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("srcElement",
+		v8.NewFunctionTemplateWithError(iso, wrapper.srcElement),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("currentTarget",
+		v8.NewFunctionTemplateWithError(iso, wrapper.currentTarget),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("eventPhase",
+		v8.NewFunctionTemplateWithError(iso, wrapper.eventPhase),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("cancelBubble",
+		v8.NewFunctionTemplateWithError(iso, wrapper.cancelBubble),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setCancelBubble),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("bubbles",
+		v8.NewFunctionTemplateWithError(iso, wrapper.bubbles),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("cancelable",
+		v8.NewFunctionTemplateWithError(iso, wrapper.cancelable),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("returnValue",
+		v8.NewFunctionTemplateWithError(iso, wrapper.returnValue),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setReturnValue),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("defaultPrevented",
+		v8.NewFunctionTemplateWithError(iso, wrapper.defaultPrevented),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("composed",
+		v8.NewFunctionTemplateWithError(iso, wrapper.composed),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("isTrusted",
+		v8.NewFunctionTemplateWithError(iso, wrapper.isTrusted),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("timeStamp",
+		v8.NewFunctionTemplateWithError(iso, wrapper.timeStamp),
+		nil,
+		v8.None)
+
+	return constructor
+}
+
+func (e eventV8Wrapper) Constructor(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	return nil, notImplemented("Event", "constructor")
+}
+
+func (e eventV8Wrapper) composedPath(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: Event.composedPath")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Event)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Event.composedPath: Illegal invocation")
+	}
+	result, callErr := instance.ComposedPath()
+	if callErr != nil {
+		return nil, mapError(e.scriptHost, callErr)
+	} else {
+		return toSequence(e.toEventTarget)(ctx, result)
+	}
+}
+
+func (e eventV8Wrapper) stopPropagation(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Event.stopPropagation")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Event)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Event.stopPropagation: Illegal invocation")
+	}
+	callErr := instance.StopPropagation()
+	if callErr != nil {
+		return nil, mapError(e.scriptHost, callErr)
+	}
+	return nil, nil
+}
+
+func (e eventV8Wrapper) stopImmediatePropagation(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Event.stopImmediatePropagation")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Event)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Event.stopImmediatePropagation: Illegal invocation")
+	}
+	callErr := instance.StopImmediatePropagation()
+	if callErr != nil {
+		return nil, mapError(e.scriptHost, callErr)
+	}
+	return nil, nil
+}
+
+func (e eventV8Wrapper) preventDefault(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Event.preventDefault")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Event)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Event.preventDefault: Illegal invocation")
+	}
+	callErr := instance.PreventDefault()
+	if callErr != nil {
+		return nil, mapError(e.scriptHost, callErr)
+	}
+	return nil, nil
+}
+
+func (e eventV8Wrapper) initEvent(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Event.initEvent")
+	args := newArgumentHelper(e.scriptHost, info)
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Event)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Event.initEvent: Illegal invocation")
+	}
+	type_, err1 := tryParseArg(args, 0, e.decodeDOMString)
+	bubbles, err2 := tryParseArg(args, 1, e.decodeBoolean)
+	cancelable, err3 := tryParseArg(args, 2, e.decodeBoolean)
+	if args.noOfReadArguments >= 3 {
+		err := errors.Join(err1, err2, err3)
+		if err != nil {
+			return nil, err
+		}
+		callErr := instance.InitEventBubblesCancelable(type_, bubbles, cancelable)
+		if callErr != nil {
+			return nil, mapError(e.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		callErr := instance.InitEventBubbles(type_, bubbles)
+		if callErr != nil {
+			return nil, mapError(e.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		callErr := instance.InitEvent(type_)
+		if callErr != nil {
+			return nil, mapError(e.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	return nil, errors.New("Event.initEvent: Missing arguments")
+}
+
+func (e eventV8Wrapper) type_(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: Event.type")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Event)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Event.type: Illegal invocation")
+	}
+	result := instance.Type()
+	return e.toDOMString(ctx, result)
+}
+
+func (e eventV8Wrapper) target(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: Event.target")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Event)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Event.target: Illegal invocation")
+	}
+	result := instance.Target()
+	if result == nil {
+		return v8.Null(e.scriptHost.iso), nil
+	}
+	return e.toEventTarget(ctx, result)
+}
+
+func (e eventV8Wrapper) srcElement(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: Event.srcElement")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Event)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Event.srcElement: Illegal invocation")
+	}
+	result := instance.SrcElement()
+	if result == nil {
+		return v8.Null(e.scriptHost.iso), nil
+	}
+	return e.toEventTarget(ctx, result)
+}
+
+func (e eventV8Wrapper) currentTarget(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: Event.currentTarget")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Event)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Event.currentTarget: Illegal invocation")
+	}
+	result := instance.CurrentTarget()
+	if result == nil {
+		return v8.Null(e.scriptHost.iso), nil
+	}
+	return e.toEventTarget(ctx, result)
+}
+
+func (e eventV8Wrapper) eventPhase(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: Event.eventPhase")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Event)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Event.eventPhase: Illegal invocation")
+	}
+	result := instance.EventPhase()
+	return e.toUnsignedShort(ctx, result)
+}
+
+func (e eventV8Wrapper) cancelBubble(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: Event.cancelBubble")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Event)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Event.cancelBubble: Illegal invocation")
+	}
+	result := instance.CancelBubble()
+	return e.toBoolean(ctx, result)
+}
+
+func (e eventV8Wrapper) setCancelBubble(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Event.setCancelBubble")
+	args := newArgumentHelper(e.scriptHost, info)
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Event)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Event.setCancelBubble: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, e.decodeBoolean)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetCancelBubble(val)
+		return nil, nil
+	}
+	return nil, errors.New("Event.setCancelBubble: Missing arguments")
+}
+
+func (e eventV8Wrapper) bubbles(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: Event.bubbles")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Event)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Event.bubbles: Illegal invocation")
+	}
+	result := instance.Bubbles()
+	return e.toBoolean(ctx, result)
+}
+
+func (e eventV8Wrapper) cancelable(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: Event.cancelable")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Event)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Event.cancelable: Illegal invocation")
+	}
+	result := instance.Cancelable()
+	return e.toBoolean(ctx, result)
+}
+
+func (e eventV8Wrapper) returnValue(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: Event.returnValue")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Event)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Event.returnValue: Illegal invocation")
+	}
+	result := instance.ReturnValue()
+	return e.toBoolean(ctx, result)
+}
+
+func (e eventV8Wrapper) setReturnValue(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Event.setReturnValue")
+	args := newArgumentHelper(e.scriptHost, info)
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Event)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Event.setReturnValue: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, e.decodeBoolean)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetReturnValue(val)
+		return nil, nil
+	}
+	return nil, errors.New("Event.setReturnValue: Missing arguments")
+}
+
+func (e eventV8Wrapper) defaultPrevented(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: Event.defaultPrevented")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Event)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Event.defaultPrevented: Illegal invocation")
+	}
+	result := instance.DefaultPrevented()
+	return e.toBoolean(ctx, result)
+}
+
+func (e eventV8Wrapper) composed(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: Event.composed")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Event)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Event.composed: Illegal invocation")
+	}
+	result := instance.Composed()
+	return e.toBoolean(ctx, result)
+}
+
+func (e eventV8Wrapper) isTrusted(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: Event.isTrusted")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Event)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Event.isTrusted: Illegal invocation")
+	}
+	result := instance.IsTrusted()
+	return e.toBoolean(ctx, result)
+}
+
+func (e eventV8Wrapper) timeStamp(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: Event.timeStamp")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Event)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Event.timeStamp: Illegal invocation")
+	}
+	result := instance.TimeStamp()
+	return e.toDOMHighResTimeStamp(ctx, result)
+}
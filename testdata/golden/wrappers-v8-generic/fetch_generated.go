@@ -0,0 +1,193 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	html "github.com/gost-dom/browser/html"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+type headersV8Wrapper struct {
+	nodeV8WrapperBase[html.Headers]
+}
+
+func newHeadersV8Wrapper(scriptHost *V8ScriptHost) *headersV8Wrapper {
+	return &headersV8Wrapper{newNodeV8WrapperBase[html.Headers](scriptHost)}
+}
+
+func createHeadersPrototype(scriptHost *V8ScriptHost) *v8.FunctionTemplate {
+	iso := scriptHost.iso
+	wrapper := newHeadersV8Wrapper(scriptHost)
+	constructor := v8.NewFunctionTemplateWithError(iso, wrapper.Constructor)
+
+	instanceTmpl := constructor.InstanceTemplate()
+	instanceTmpl.SetInternalFieldCount(1)
+
+	prototypeTmpl := constructor.PrototypeTemplate()
+	prototypeTmpl.Set("append", v8.NewFunctionTemplateWithError(iso, wrapper.append))
+	prototypeTmpl.Set("delete", v8.NewFunctionTemplateWithError(iso, wrapper.delete))
+	prototypeTmpl.Set("get", v8.NewFunctionTemplateWithError(iso, wrapper.get))
+	prototypeTmpl.Set("getSetCookie", v8.NewFunctionTemplateWithError(iso, wrapper.getSetCookie))
+	prototypeTmpl.Set("has", v8.NewFunctionTemplateWithError(iso, wrapper.has))
+	prototypeTmpl.Set("set", v8.NewFunctionTemplateWithError(iso, wrapper.set))
+
+	return constructor
+}
+
+func (h headersV8Wrapper) Constructor(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	return nil, notImplemented("Headers", "constructor")
+}
+
+func (h headersV8Wrapper) append(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Headers.append")
+	args := newArgumentHelper(h.scriptHost, info)
+	wrapped, err := h.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.Headers)
+	if !ok {
+		return nil, v8.NewTypeError(h.scriptHost.iso, "Headers.append: Illegal invocation")
+	}
+	name, err1 := tryParseArg(args, 0, h.decodeByteString)
+	value, err2 := tryParseArg(args, 1, h.decodeByteString)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		callErr := instance.Append(name, value)
+		if callErr != nil {
+			return nil, mapError(h.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	return nil, errors.New("Headers.append: Missing arguments")
+}
+
+func (h headersV8Wrapper) delete(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Headers.delete")
+	args := newArgumentHelper(h.scriptHost, info)
+	wrapped, err := h.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.Headers)
+	if !ok {
+		return nil, v8.NewTypeError(h.scriptHost.iso, "Headers.delete: Illegal invocation")
+	}
+	name, err1 := tryParseArg(args, 0, h.decodeByteString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		callErr := instance.Delete(name)
+		if callErr != nil {
+			return nil, mapError(h.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	return nil, errors.New("Headers.delete: Missing arguments")
+}
+
+func (h headersV8Wrapper) get(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := h.mustGetContext(info)
+	log.Debug("V8 Function call: Headers.get")
+	args := newArgumentHelper(h.scriptHost, info)
+	wrapped, err := h.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.Headers)
+	if !ok {
+		return nil, v8.NewTypeError(h.scriptHost.iso, "Headers.get: Illegal invocation")
+	}
+	name, err1 := tryParseArg(args, 0, h.decodeByteString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.Get(name)
+		if callErr != nil {
+			return nil, mapError(h.scriptHost, callErr)
+		} else {
+			return h.toNullableByteString(ctx, result)
+		}
+	}
+	return nil, errors.New("Headers.get: Missing arguments")
+}
+
+func (h headersV8Wrapper) getSetCookie(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := h.mustGetContext(info)
+	log.Debug("V8 Function call: Headers.getSetCookie")
+	wrapped, err := h.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.Headers)
+	if !ok {
+		return nil, v8.NewTypeError(h.scriptHost.iso, "Headers.getSetCookie: Illegal invocation")
+	}
+	result, callErr := instance.GetSetCookie()
+	if callErr != nil {
+		return nil, mapError(h.scriptHost, callErr)
+	} else {
+		return toSequence(h.toByteString)(ctx, result)
+	}
+}
+
+func (h headersV8Wrapper) has(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := h.mustGetContext(info)
+	log.Debug("V8 Function call: Headers.has")
+	args := newArgumentHelper(h.scriptHost, info)
+	wrapped, err := h.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.Headers)
+	if !ok {
+		return nil, v8.NewTypeError(h.scriptHost.iso, "Headers.has: Illegal invocation")
+	}
+	name, err1 := tryParseArg(args, 0, h.decodeByteString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.Has(name)
+		if callErr != nil {
+			return nil, mapError(h.scriptHost, callErr)
+		} else {
+			return h.toBoolean(ctx, result)
+		}
+	}
+	return nil, errors.New("Headers.has: Missing arguments")
+}
+
+func (h headersV8Wrapper) set(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Headers.set")
+	args := newArgumentHelper(h.scriptHost, info)
+	wrapped, err := h.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.Headers)
+	if !ok {
+		return nil, v8.NewTypeError(h.scriptHost.iso, "Headers.set: Illegal invocation")
+	}
+	name, err1 := tryParseArg(args, 0, h.decodeByteString)
+	value, err2 := tryParseArg(args, 1, h.decodeByteString)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		callErr := instance.Set(name, value)
+		if callErr != nil {
+			return nil, mapError(h.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	return nil, errors.New("Headers.set: Missing arguments")
+}
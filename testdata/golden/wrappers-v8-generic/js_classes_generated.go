@@ -0,0 +1,9 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+func init() {
+	registerJSClass("Event", "", createEventPrototype)
+	registerJSClass("Headers", "", createHeadersPrototype)
+	registerJSClass("URLSearchParams", "", createURLSearchParamsPrototype)
+}
@@ -0,0 +1,197 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	v8 "github.com/tommie/v8go"
+	"maps"
+	"slices"
+)
+
+// builtinFunction returns the function with the name of the global JS object,
+// e.g., Array.from.
+func builtinFunction(ctx *V8ScriptContext, object, name string) (*v8.Function, error) {
+	global, err := ctx.v8ctx.Global().Get(object)
+	if err != nil {
+		return nil, err
+	}
+	globalObj, err := global.AsObject()
+	if err != nil {
+		return nil, err
+	}
+	f, err := globalObj.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return f.AsFunction()
+}
+
+// arrayItems returns the items of a JS array.
+func arrayItems(array *v8.Value) ([]*v8.Value, error) {
+	obj, err := array.AsObject()
+	if err != nil {
+		return nil, err
+	}
+	length, err := obj.Get("length")
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*v8.Value, length.Uint32())
+	for i := range items {
+		if items[i], err = obj.GetIdx(uint32(i)); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+// decodeSequence returns a decoder converting a JS iterable to a slice,
+// decoding each value using decode.
+func decodeSequence[T any](decode func(*V8ScriptContext, *v8.Value) (T, error)) func(*V8ScriptContext, *v8.Value) ([]T, error) {
+	return func(ctx *V8ScriptContext, val *v8.Value) ([]T, error) {
+		if !val.IsObject() {
+			return nil, v8.NewTypeError(ctx.host.iso, "The value is not iterable")
+		}
+		obj, err := val.AsObject()
+		if err != nil {
+			return nil, err
+		}
+		method, err := obj.GetSymbol(v8.SymbolIterator(ctx.host.iso))
+		if err != nil {
+			return nil, err
+		}
+		if !method.IsFunction() {
+			return nil, v8.NewTypeError(ctx.host.iso, "The value is not iterable")
+		}
+		// Array.from iterates the value using Symbol.iterator
+		from, err := builtinFunction(ctx, "Array", "from")
+		if err != nil {
+			return nil, err
+		}
+		array, err := from.Call(v8.Undefined(ctx.host.iso), val)
+		if err != nil {
+			return nil, err
+		}
+		items, err := arrayItems(array)
+		if err != nil {
+			return nil, err
+		}
+		result := make([]T, len(items))
+		for i, item := range items {
+			if result[i], err = decode(ctx, item); err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	}
+}
+
+// decodeRecord returns a decoder converting the own enumerable properties of a
+// JS object to a map, decoding the keys and values using decodeKey and
+// decodeValue.
+func decodeRecord[K comparable, V any](decodeKey func(*V8ScriptContext, *v8.Value) (K, error), decodeValue func(*V8ScriptContext, *v8.Value) (V, error)) func(*V8ScriptContext, *v8.Value) (map[K]V, error) {
+	return func(ctx *V8ScriptContext, val *v8.Value) (map[K]V, error) {
+		if !val.IsObject() {
+			return nil, v8.NewTypeError(ctx.host.iso, "The value is not an object")
+		}
+		obj, err := val.AsObject()
+		if err != nil {
+			return nil, err
+		}
+		// Object.keys returns the names of the own enumerable properties
+		keys, err := builtinFunction(ctx, "Object", "keys")
+		if err != nil {
+			return nil, err
+		}
+		array, err := keys.Call(v8.Undefined(ctx.host.iso), val)
+		if err != nil {
+			return nil, err
+		}
+		names, err := arrayItems(array)
+		if err != nil {
+			return nil, err
+		}
+		result := make(map[K]V, len(names))
+		for _, name := range names {
+			key, err := decodeKey(ctx, name)
+			if err != nil {
+				return nil, err
+			}
+			value, err := obj.Get(name.String())
+			if err != nil {
+				return nil, err
+			}
+			if result[key], err = decodeValue(ctx, value); err != nil {
+				return nil, err
+			}
+		}
+		return result, nil
+	}
+}
+
+// toSequence returns an encoder converting a slice to a JS array, encoding each
+// value using encode.
+func toSequence[T any](encode func(*V8ScriptContext, T) (*v8.Value, error)) func(*V8ScriptContext, []T) (*v8.Value, error) {
+	return func(ctx *V8ScriptContext, values []T) (*v8.Value, error) {
+		constructor, err := ctx.v8ctx.Global().Get("Array")
+		if err != nil {
+			return nil, err
+		}
+		arrayCtor, err := constructor.AsFunction()
+		if err != nil {
+			return nil, err
+		}
+		array, err := arrayCtor.NewInstance()
+		if err != nil {
+			return nil, err
+		}
+		for i, v := range values {
+			encoded, err := encode(ctx, v)
+			if err != nil {
+				return nil, err
+			}
+			if err := array.SetIdx(uint32(i), encoded); err != nil {
+				return nil, err
+			}
+		}
+		return array.Value, nil
+	}
+}
+
+// toFrozenArray returns an encoder converting a slice to a frozen JS array,
+// encoding each value using encode.
+func toFrozenArray[T any](encode func(*V8ScriptContext, T) (*v8.Value, error)) func(*V8ScriptContext, []T) (*v8.Value, error) {
+	toArray := toSequence(encode)
+	return func(ctx *V8ScriptContext, values []T) (*v8.Value, error) {
+		array, err := toArray(ctx, values)
+		if err != nil {
+			return nil, err
+		}
+		freeze, err := builtinFunction(ctx, "Object", "freeze")
+		if err != nil {
+			return nil, err
+		}
+		return freeze.Call(v8.Undefined(ctx.host.iso), array)
+	}
+}
+
+// toRecord returns an encoder converting a map to a JS object, encoding each
+// value using encode.
+func toRecord[V any](encode func(*V8ScriptContext, V) (*v8.Value, error)) func(*V8ScriptContext, map[string]V) (*v8.Value, error) {
+	return func(ctx *V8ScriptContext, values map[string]V) (*v8.Value, error) {
+		obj, err := v8.NewObjectTemplate(ctx.host.iso).NewInstance(ctx.v8ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range slices.Sorted(maps.Keys(values)) {
+			encoded, err := encode(ctx, values[key])
+			if err != nil {
+				return nil, err
+			}
+			if err := obj.Set(key, encoded); err != nil {
+				return nil, err
+			}
+		}
+		return obj.Value, nil
+	}
+}
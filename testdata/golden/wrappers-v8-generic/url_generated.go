@@ -0,0 +1,280 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	html "github.com/gost-dom/browser/html"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+type uRLSearchParamsV8Wrapper struct {
+	nodeV8WrapperBase[html.URLSearchParams]
+}
+
+func newURLSearchParamsV8Wrapper(scriptHost *V8ScriptHost) *uRLSearchParamsV8Wrapper {
+	return &uRLSearchParamsV8Wrapper{newNodeV8WrapperBase[html.URLSearchParams](scriptHost)}
+}
+
+func createURLSearchParamsPrototype(scriptHost *V8ScriptHost) *v8.FunctionTemplate {
+	iso := scriptHost.iso
+	wrapper := newURLSearchParamsV8Wrapper(scriptHost)
+	constructor := v8.NewFunctionTemplateWithError(iso, wrapper.Constructor)
+
+	instanceTmpl := constructor.InstanceTemplate()
+	instanceTmpl.SetInternalFieldCount(1)
+
+	prototypeTmpl := constructor.PrototypeTemplate()
+	prototypeTmpl.Set("append", v8.NewFunctionTemplateWithError(iso, wrapper.append))
+	prototypeTmpl.Set("delete", v8.NewFunctionTemplateWithError(iso, wrapper.delete))
+	prototypeTmpl.Set("get", v8.NewFunctionTemplateWithError(iso, wrapper.get))
+	prototypeTmpl.Set("getAll", v8.NewFunctionTemplateWithError(iso, wrapper.getAll))
+	prototypeTmpl.Set("has", v8.NewFunctionTemplateWithError(iso, wrapper.has))
+	prototypeTmpl.Set("set", v8.NewFunctionTemplateWithError(iso, wrapper.set))
+	prototypeTmpl.Set("sort", v8.NewFunctionTemplateWithError(iso, wrapper.sort))
+	prototypeTmpl.Set("toString", v8.NewFunctionTemplateWithError(iso, wrapper.toString))
+
+	prototypeTmpl.SetAccessorProperty("size",
+		v8.NewFunctionTemplateWithError(iso, wrapper.size),
+		nil,
+		v8.None)
+
+	return constructor
+}
+
+func (p uRLSearchParamsV8Wrapper) Constructor(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	return nil, notImplemented("URLSearchParams", "constructor")
+}
+
+func (p uRLSearchParamsV8Wrapper) append(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: URLSearchParams.append")
+	args := newArgumentHelper(p.scriptHost, info)
+	wrapped, err := p.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.URLSearchParams)
+	if !ok {
+		return nil, v8.NewTypeError(p.scriptHost.iso, "URLSearchParams.append: Illegal invocation")
+	}
+	name, err1 := tryParseArg(args, 0, p.decodeUSVString)
+	value, err2 := tryParseArg(args, 1, p.decodeUSVString)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		callErr := instance.Append(name, value)
+		if callErr != nil {
+			return nil, mapError(p.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	return nil, errors.New("URLSearchParams.append: Missing arguments")
+}
+
+func (p uRLSearchParamsV8Wrapper) delete(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: URLSearchParams.delete")
+	args := newArgumentHelper(p.scriptHost, info)
+	wrapped, err := p.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.URLSearchParams)
+	if !ok {
+		return nil, v8.NewTypeError(p.scriptHost.iso, "URLSearchParams.delete: Illegal invocation")
+	}
+	name, err1 := tryParseArg(args, 0, p.decodeUSVString)
+	value, err2 := tryParseArg(args, 1, p.decodeUSVString)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		callErr := instance.DeleteValue(name, value)
+		if callErr != nil {
+			return nil, mapError(p.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		callErr := instance.Delete(name)
+		if callErr != nil {
+			return nil, mapError(p.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	return nil, errors.New("URLSearchParams.delete: Missing arguments")
+}
+
+func (p uRLSearchParamsV8Wrapper) get(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := p.mustGetContext(info)
+	log.Debug("V8 Function call: URLSearchParams.get")
+	args := newArgumentHelper(p.scriptHost, info)
+	wrapped, err := p.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.URLSearchParams)
+	if !ok {
+		return nil, v8.NewTypeError(p.scriptHost.iso, "URLSearchParams.get: Illegal invocation")
+	}
+	name, err1 := tryParseArg(args, 0, p.decodeUSVString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.Get(name)
+		if callErr != nil {
+			return nil, mapError(p.scriptHost, callErr)
+		} else {
+			return p.toNullableUSVString(ctx, result)
+		}
+	}
+	return nil, errors.New("URLSearchParams.get: Missing arguments")
+}
+
+func (p uRLSearchParamsV8Wrapper) getAll(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := p.mustGetContext(info)
+	log.Debug("V8 Function call: URLSearchParams.getAll")
+	args := newArgumentHelper(p.scriptHost, info)
+	wrapped, err := p.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.URLSearchParams)
+	if !ok {
+		return nil, v8.NewTypeError(p.scriptHost.iso, "URLSearchParams.getAll: Illegal invocation")
+	}
+	name, err1 := tryParseArg(args, 0, p.decodeUSVString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.GetAll(name)
+		if callErr != nil {
+			return nil, mapError(p.scriptHost, callErr)
+		} else {
+			return toSequence(p.toUSVString)(ctx, result)
+		}
+	}
+	return nil, errors.New("URLSearchParams.getAll: Missing arguments")
+}
+
+func (p uRLSearchParamsV8Wrapper) has(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := p.mustGetContext(info)
+	log.Debug("V8 Function call: URLSearchParams.has")
+	args := newArgumentHelper(p.scriptHost, info)
+	wrapped, err := p.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.URLSearchParams)
+	if !ok {
+		return nil, v8.NewTypeError(p.scriptHost.iso, "URLSearchParams.has: Illegal invocation")
+	}
+	name, err1 := tryParseArg(args, 0, p.decodeUSVString)
+	value, err2 := tryParseArg(args, 1, p.decodeUSVString)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		result, callErr := instance.HasValue(name, value)
+		if callErr != nil {
+			return nil, mapError(p.scriptHost, callErr)
+		} else {
+			return p.toBoolean(ctx, result)
+		}
+	}
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.Has(name)
+		if callErr != nil {
+			return nil, mapError(p.scriptHost, callErr)
+		} else {
+			return p.toBoolean(ctx, result)
+		}
+	}
+	return nil, errors.New("URLSearchParams.has: Missing arguments")
+}
+
+func (p uRLSearchParamsV8Wrapper) set(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: URLSearchParams.set")
+	args := newArgumentHelper(p.scriptHost, info)
+	wrapped, err := p.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.URLSearchParams)
+	if !ok {
+		return nil, v8.NewTypeError(p.scriptHost.iso, "URLSearchParams.set: Illegal invocation")
+	}
+	name, err1 := tryParseArg(args, 0, p.decodeUSVString)
+	value, err2 := tryParseArg(args, 1, p.decodeUSVString)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		callErr := instance.Set(name, value)
+		if callErr != nil {
+			return nil, mapError(p.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	return nil, errors.New("URLSearchParams.set: Missing arguments")
+}
+
+func (p uRLSearchParamsV8Wrapper) sort(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: URLSearchParams.sort")
+	wrapped, err := p.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.URLSearchParams)
+	if !ok {
+		return nil, v8.NewTypeError(p.scriptHost.iso, "URLSearchParams.sort: Illegal invocation")
+	}
+	callErr := instance.Sort()
+	if callErr != nil {
+		return nil, mapError(p.scriptHost, callErr)
+	}
+	return nil, nil
+}
+
+func (p uRLSearchParamsV8Wrapper) toString(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := p.mustGetContext(info)
+	log.Debug("V8 Function call: URLSearchParams.toString")
+	wrapped, err := p.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.URLSearchParams)
+	if !ok {
+		return nil, v8.NewTypeError(p.scriptHost.iso, "URLSearchParams.toString: Illegal invocation")
+	}
+	result := instance.ToString()
+	return p.toDOMString(ctx, result)
+}
+
+func (p uRLSearchParamsV8Wrapper) size(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := p.mustGetContext(info)
+	log.Debug("V8 Function call: URLSearchParams.size")
+	wrapped, err := p.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.URLSearchParams)
+	if !ok {
+		return nil, v8.NewTypeError(p.scriptHost.iso, "URLSearchParams.size: Illegal invocation")
+	}
+	result := instance.Size()
+	return p.toUnsignedLong(ctx, result)
+}
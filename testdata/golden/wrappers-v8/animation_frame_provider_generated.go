@@ -0,0 +1,82 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	html "github.com/gost-dom/browser/html"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+type animationFrameProviderV8Wrapper struct {
+	handleReffedObject[html.AnimationFrameProvider]
+	interfaceName string
+}
+
+func newAnimationFrameProviderV8Wrapper(scriptHost *V8ScriptHost, interfaceName string) *animationFrameProviderV8Wrapper {
+	return &animationFrameProviderV8Wrapper{newHandleReffedObject[html.AnimationFrameProvider](scriptHost), interfaceName}
+}
+
+// isIncluder returns whether the value implements the interface including
+// the mixin, named by interfaceName.
+func (p animationFrameProviderV8Wrapper) isIncluder(value any) bool {
+	switch p.interfaceName {
+	case "Window":
+		_, ok := value.(html.Window)
+		return ok
+	}
+	return false
+}
+
+func (p animationFrameProviderV8Wrapper) requestAnimationFrame(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := p.mustGetContext(info)
+	log.Debug("V8 Function call: AnimationFrameProvider.requestAnimationFrame")
+	args := newArgumentHelper(p.scriptHost, info)
+	wrapped, err := p.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.AnimationFrameProvider)
+	if !ok || !p.isIncluder(instance) {
+		return nil, v8.NewTypeError(p.scriptHost.iso, p.interfaceName+".requestAnimationFrame: Illegal invocation")
+	}
+	callback, err1 := tryParseArg(args, 0, p.decodeFrameRequestCallback)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.RequestAnimationFrame(callback)
+		if callErr != nil {
+			return nil, mapError(p.scriptHost, callErr)
+		} else {
+			return p.toUnsignedLong(ctx, result)
+		}
+	}
+	return nil, errors.New("AnimationFrameProvider.requestAnimationFrame: Missing arguments")
+}
+
+func (p animationFrameProviderV8Wrapper) cancelAnimationFrame(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: AnimationFrameProvider.cancelAnimationFrame")
+	args := newArgumentHelper(p.scriptHost, info)
+	wrapped, err := p.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.AnimationFrameProvider)
+	if !ok || !p.isIncluder(instance) {
+		return nil, v8.NewTypeError(p.scriptHost.iso, p.interfaceName+".cancelAnimationFrame: Illegal invocation")
+	}
+	handle, err1 := tryParseArg(args, 0, decodeIDLUnsignedLong)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		callErr := instance.CancelAnimationFrame(handle)
+		if callErr != nil {
+			return nil, mapError(p.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	return nil, errors.New("AnimationFrameProvider.cancelAnimationFrame: Missing arguments")
+}
@@ -0,0 +1,170 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import v8 "github.com/tommie/v8go"
+
+func uint8ArrayConstructor(ctx *V8ScriptContext) (*v8.Function, error) {
+	constructor, err := ctx.v8ctx.Global().Get("Uint8Array")
+	if err != nil {
+		return nil, err
+	}
+	return constructor.AsFunction()
+}
+
+// newUint8Array creates a Uint8Array with a copy of data. The bytes are set one
+// at a time, each a cgo call, as v8go doesn't expose the backing store.
+func newUint8Array(ctx *V8ScriptContext, data []byte) (*v8.Object, error) {
+	constructor, err := uint8ArrayConstructor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	length, err := v8.NewValue(ctx.host.iso, uint32(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	array, err := constructor.NewInstance(length)
+	if err != nil {
+		return nil, err
+	}
+	for i, b := range data {
+		if err := array.SetIdx(uint32(i), uint32(b)); err != nil {
+			return nil, err
+		}
+	}
+	return array, nil
+}
+
+// bufferSourceBytes returns a copy of the bytes of an ArrayBuffer, or of the range
+// of the buffer viewed by an ArrayBufferView. The bytes are read one at a time,
+// each a cgo call, as v8go doesn't expose the backing store.
+func bufferSourceBytes(ctx *V8ScriptContext, val *v8.Value, allowShared bool) ([]byte, error) {
+	obj, err := val.AsObject()
+	if err != nil {
+		return nil, err
+	}
+	buffer := val
+	if val.IsArrayBufferView() {
+		if buffer, err = obj.Get("buffer"); err != nil {
+			return nil, err
+		}
+	}
+	if buffer.IsSharedArrayBuffer() && !allowShared {
+		return nil, v8.NewTypeError(ctx.host.iso, "The ArrayBuffer must not be shared")
+	}
+	bufferObj, err := buffer.AsObject()
+	if err != nil {
+		return nil, err
+	}
+	detached, err := bufferObj.Get("detached")
+	if err != nil {
+		return nil, err
+	}
+	if detached.Boolean() {
+		return nil, v8.NewTypeError(ctx.host.iso, "The ArrayBuffer is detached")
+	}
+	offset, err := obj.Get("byteOffset")
+	if err != nil {
+		return nil, err
+	}
+	length, err := obj.Get("byteLength")
+	if err != nil {
+		return nil, err
+	}
+	constructor, err := uint8ArrayConstructor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	view, err := constructor.NewInstance(buffer, offset, length)
+	if err != nil {
+		return nil, err
+	}
+	bytes := make([]byte, length.Uint32())
+	for i := range bytes {
+		b, err := view.GetIdx(uint32(i))
+		if err != nil {
+			return nil, err
+		}
+		bytes[i] = byte(b.Uint32())
+	}
+	return bytes, nil
+}
+
+func decodeIDLArrayBuffer(ctx *V8ScriptContext, val *v8.Value) ([]byte, error) {
+	if !val.IsArrayBuffer() {
+		return nil, v8.NewTypeError(ctx.host.iso, "Value is not of type 'ArrayBuffer'")
+	}
+	return bufferSourceBytes(ctx, val, false)
+}
+
+func decodeIDLArrayBufferAllowShared(ctx *V8ScriptContext, val *v8.Value) ([]byte, error) {
+	if !(val.IsArrayBuffer() || val.IsSharedArrayBuffer()) {
+		return nil, v8.NewTypeError(ctx.host.iso, "Value is not of type 'ArrayBuffer'")
+	}
+	return bufferSourceBytes(ctx, val, true)
+}
+
+func decodeIDLArrayBufferView(ctx *V8ScriptContext, val *v8.Value) ([]byte, error) {
+	if !val.IsArrayBufferView() {
+		return nil, v8.NewTypeError(ctx.host.iso, "Value is not of type 'ArrayBufferView'")
+	}
+	return bufferSourceBytes(ctx, val, false)
+}
+
+func decodeIDLArrayBufferViewAllowShared(ctx *V8ScriptContext, val *v8.Value) ([]byte, error) {
+	if !val.IsArrayBufferView() {
+		return nil, v8.NewTypeError(ctx.host.iso, "Value is not of type 'ArrayBufferView'")
+	}
+	return bufferSourceBytes(ctx, val, true)
+}
+
+func decodeIDLBufferSource(ctx *V8ScriptContext, val *v8.Value) ([]byte, error) {
+	if !(val.IsArrayBuffer() || val.IsArrayBufferView()) {
+		return nil, v8.NewTypeError(ctx.host.iso, "Value is not of type 'BufferSource'")
+	}
+	return bufferSourceBytes(ctx, val, false)
+}
+
+func decodeIDLBufferSourceAllowShared(ctx *V8ScriptContext, val *v8.Value) ([]byte, error) {
+	if !(val.IsArrayBuffer() || val.IsSharedArrayBuffer() || val.IsArrayBufferView()) {
+		return nil, v8.NewTypeError(ctx.host.iso, "Value is not of type 'BufferSource'")
+	}
+	return bufferSourceBytes(ctx, val, true)
+}
+
+func decodeIDLAllowSharedBufferSource(ctx *V8ScriptContext, val *v8.Value) ([]byte, error) {
+	if !(val.IsArrayBuffer() || val.IsSharedArrayBuffer() || val.IsArrayBufferView()) {
+		return nil, v8.NewTypeError(ctx.host.iso, "Value is not of type 'AllowSharedBufferSource'")
+	}
+	return bufferSourceBytes(ctx, val, true)
+}
+
+func decodeIDLUint8Array(ctx *V8ScriptContext, val *v8.Value) ([]byte, error) {
+	if !val.IsUint8Array() {
+		return nil, v8.NewTypeError(ctx.host.iso, "Value is not of type 'Uint8Array'")
+	}
+	return bufferSourceBytes(ctx, val, false)
+}
+
+func decodeIDLUint8ArrayAllowShared(ctx *V8ScriptContext, val *v8.Value) ([]byte, error) {
+	if !val.IsUint8Array() {
+		return nil, v8.NewTypeError(ctx.host.iso, "Value is not of type 'Uint8Array'")
+	}
+	return bufferSourceBytes(ctx, val, true)
+}
+
+func toIDLArrayBuffer(ctx *V8ScriptContext, data []byte) (*v8.Value, error) {
+	array, err := newUint8Array(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return array.Get("buffer")
+}
+
+func toIDLUint8Array(ctx *V8ScriptContext, data []byte) (*v8.Value, error) {
+	array, err := newUint8Array(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	return array.Value, nil
+}
@@ -0,0 +1,308 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	console "github.com/gost-dom/browser/console"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+func init() {
+	registerJSNamespace("console", createConsoleNamespace)
+}
+
+type consoleV8Wrapper struct {
+	namespaceV8WrapperBase
+}
+
+func newConsoleV8Wrapper(scriptHost *V8ScriptHost) *consoleV8Wrapper {
+	return &consoleV8Wrapper{newNamespaceV8WrapperBase(scriptHost)}
+}
+
+func createConsoleNamespace(scriptHost *V8ScriptHost) *v8.ObjectTemplate {
+	iso := scriptHost.iso
+	wrapper := newConsoleV8Wrapper(scriptHost)
+	namespace := v8.NewObjectTemplate(iso)
+	namespace.Set("assert", v8.NewFunctionTemplateWithError(iso, wrapper.assert))
+	namespace.Set("clear", v8.NewFunctionTemplateWithError(iso, wrapper.clear))
+	namespace.Set("debug", v8.NewFunctionTemplateWithError(iso, wrapper.debug))
+	namespace.Set("error", v8.NewFunctionTemplateWithError(iso, wrapper.error))
+	namespace.Set("info", v8.NewFunctionTemplateWithError(iso, wrapper.info))
+	namespace.Set("log", v8.NewFunctionTemplateWithError(iso, wrapper.log))
+	namespace.Set("table", v8.NewFunctionTemplateWithError(iso, wrapper.table))
+	namespace.Set("trace", v8.NewFunctionTemplateWithError(iso, wrapper.trace))
+	namespace.Set("warn", v8.NewFunctionTemplateWithError(iso, wrapper.warn))
+	namespace.Set("dir", v8.NewFunctionTemplateWithError(iso, wrapper.dir))
+	namespace.Set("dirxml", v8.NewFunctionTemplateWithError(iso, wrapper.dirxml))
+	namespace.Set("count", v8.NewFunctionTemplateWithError(iso, wrapper.count))
+	namespace.Set("countReset", v8.NewFunctionTemplateWithError(iso, wrapper.countReset))
+	namespace.Set("group", v8.NewFunctionTemplateWithError(iso, wrapper.group))
+	namespace.Set("groupCollapsed", v8.NewFunctionTemplateWithError(iso, wrapper.groupCollapsed))
+	namespace.Set("groupEnd", v8.NewFunctionTemplateWithError(iso, wrapper.groupEnd))
+	namespace.Set("time", v8.NewFunctionTemplateWithError(iso, wrapper.time))
+	namespace.Set("timeLog", v8.NewFunctionTemplateWithError(iso, wrapper.timeLog))
+	namespace.Set("timeEnd", v8.NewFunctionTemplateWithError(iso, wrapper.timeEnd))
+
+	return namespace
+}
+
+func (c consoleV8Wrapper) assert(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: console.assert")
+	args := newArgumentHelper(c.scriptHost, info)
+	condition, err1 := tryParseArgWithDefault(args, 0, c.defaultCondition, c.decodeBoolean)
+	data, err2 := tryParseVariadicArg(args, 1, c.decodeAny)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		console.Assert(condition, data...)
+		return nil, nil
+	}
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		console.Assert(condition)
+		return nil, nil
+	}
+	return nil, errors.New("console.assert: Missing arguments")
+}
+
+func (c consoleV8Wrapper) clear(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: console.clear")
+	console.Clear()
+	return nil, nil
+}
+
+func (c consoleV8Wrapper) debug(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: console.debug")
+	args := newArgumentHelper(c.scriptHost, info)
+	data, err1 := tryParseVariadicArg(args, 0, c.decodeAny)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		console.Debug(data...)
+		return nil, nil
+	}
+	console.Debug()
+	return nil, nil
+}
+
+func (c consoleV8Wrapper) error(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: console.error")
+	args := newArgumentHelper(c.scriptHost, info)
+	data, err1 := tryParseVariadicArg(args, 0, c.decodeAny)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		console.Error(data...)
+		return nil, nil
+	}
+	console.Error()
+	return nil, nil
+}
+
+func (c consoleV8Wrapper) info(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: console.info")
+	args := newArgumentHelper(c.scriptHost, info)
+	data, err1 := tryParseVariadicArg(args, 0, c.decodeAny)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		console.Info(data...)
+		return nil, nil
+	}
+	console.Info()
+	return nil, nil
+}
+
+func (c consoleV8Wrapper) log(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: console.log")
+	args := newArgumentHelper(c.scriptHost, info)
+	data, err1 := tryParseVariadicArg(args, 0, c.decodeAny)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		console.Log(data...)
+		return nil, nil
+	}
+	console.Log()
+	return nil, nil
+}
+
+func (c consoleV8Wrapper) table(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: console.table")
+	return nil, notImplemented("console", "table")
+}
+
+func (c consoleV8Wrapper) trace(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: console.trace")
+	args := newArgumentHelper(c.scriptHost, info)
+	data, err1 := tryParseVariadicArg(args, 0, c.decodeAny)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		console.Trace(data...)
+		return nil, nil
+	}
+	console.Trace()
+	return nil, nil
+}
+
+func (c consoleV8Wrapper) warn(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: console.warn")
+	args := newArgumentHelper(c.scriptHost, info)
+	data, err1 := tryParseVariadicArg(args, 0, c.decodeAny)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		console.Warn(data...)
+		return nil, nil
+	}
+	console.Warn()
+	return nil, nil
+}
+
+func (c consoleV8Wrapper) dir(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: console.dir")
+	return nil, notImplemented("console", "dir")
+}
+
+func (c consoleV8Wrapper) dirxml(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: console.dirxml")
+	args := newArgumentHelper(c.scriptHost, info)
+	data, err1 := tryParseVariadicArg(args, 0, c.decodeAny)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		console.Dirxml(data...)
+		return nil, nil
+	}
+	console.Dirxml()
+	return nil, nil
+}
+
+func (c consoleV8Wrapper) count(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: console.count")
+	args := newArgumentHelper(c.scriptHost, info)
+	label, err1 := tryParseArgWithDefault(args, 0, c.defaultLabel, c.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		console.Count(label)
+		return nil, nil
+	}
+	return nil, errors.New("console.count: Missing arguments")
+}
+
+func (c consoleV8Wrapper) countReset(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: console.countReset")
+	args := newArgumentHelper(c.scriptHost, info)
+	label, err1 := tryParseArgWithDefault(args, 0, c.defaultLabel, c.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		console.CountReset(label)
+		return nil, nil
+	}
+	return nil, errors.New("console.countReset: Missing arguments")
+}
+
+func (c consoleV8Wrapper) group(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: console.group")
+	args := newArgumentHelper(c.scriptHost, info)
+	data, err1 := tryParseVariadicArg(args, 0, c.decodeAny)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		console.Group(data...)
+		return nil, nil
+	}
+	console.Group()
+	return nil, nil
+}
+
+func (c consoleV8Wrapper) groupCollapsed(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: console.groupCollapsed")
+	args := newArgumentHelper(c.scriptHost, info)
+	data, err1 := tryParseVariadicArg(args, 0, c.decodeAny)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		console.GroupCollapsed(data...)
+		return nil, nil
+	}
+	console.GroupCollapsed()
+	return nil, nil
+}
+
+func (c consoleV8Wrapper) groupEnd(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: console.groupEnd")
+	console.GroupEnd()
+	return nil, nil
+}
+
+func (c consoleV8Wrapper) time(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: console.time")
+	args := newArgumentHelper(c.scriptHost, info)
+	label, err1 := tryParseArgWithDefault(args, 0, c.defaultLabel, c.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		console.Time(label)
+		return nil, nil
+	}
+	return nil, errors.New("console.time: Missing arguments")
+}
+
+func (c consoleV8Wrapper) timeLog(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: console.timeLog")
+	args := newArgumentHelper(c.scriptHost, info)
+	label, err1 := tryParseArgWithDefault(args, 0, c.defaultLabel, c.decodeDOMString)
+	data, err2 := tryParseVariadicArg(args, 1, c.decodeAny)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		console.TimeLog(label, data...)
+		return nil, nil
+	}
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		console.TimeLog(label)
+		return nil, nil
+	}
+	return nil, errors.New("console.timeLog: Missing arguments")
+}
+
+func (c consoleV8Wrapper) timeEnd(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: console.timeEnd")
+	args := newArgumentHelper(c.scriptHost, info)
+	label, err1 := tryParseArgWithDefault(args, 0, c.defaultLabel, c.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		console.TimeEnd(label)
+		return nil, nil
+	}
+	return nil, errors.New("console.timeEnd: Missing arguments")
+}
@@ -0,0 +1,225 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	dom "github.com/gost-dom/browser/dom"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+func createDomTokenListPrototype(scriptHost *V8ScriptHost) *v8.FunctionTemplate {
+	iso := scriptHost.iso
+	wrapper := newDomTokenListV8Wrapper(scriptHost)
+	constructor := v8.NewFunctionTemplateWithError(iso, wrapper.Constructor)
+
+	instanceTmpl := constructor.InstanceTemplate()
+	instanceTmpl.SetInternalFieldCount(1)
+
+	prototypeTmpl := constructor.PrototypeTemplate()
+	prototypeTmpl.Set("item", v8.NewFunctionTemplateWithError(iso, wrapper.item))
+	prototypeTmpl.Set("contains", v8.NewFunctionTemplateWithError(iso, wrapper.contains))
+	prototypeTmpl.Set("add", v8.NewFunctionTemplateWithError(iso, wrapper.add))
+	prototypeTmpl.Set("remove", v8.NewFunctionTemplateWithError(iso, wrapper.remove))
+	prototypeTmpl.Set("toggle", v8.NewFunctionTemplateWithError(iso, wrapper.toggle))
+	prototypeTmpl.Set("replace", v8.NewFunctionTemplateWithError(iso, wrapper.replace))
+	prototypeTmpl.Set("supports", v8.NewFunctionTemplateWithError(iso, wrapper.supports))
+	prototypeTmpl.Set("toString", v8.NewFunctionTemplateWithError(iso, wrapper.value))
+
+	prototypeTmpl.SetAccessorProperty("length",
+		v8.NewFunctionTemplateWithError(iso, wrapper.length),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("value",
+		v8.NewFunctionTemplateWithError(iso, wrapper.value),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setValue),
+		v8.None)
+
+	wrapper.CustomInitialiser(constructor)
+	return constructor
+}
+
+func (u domTokenListV8Wrapper) Constructor(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	return nil, v8.NewTypeError(u.scriptHost.iso, "Illegal Constructor")
+}
+
+func (u domTokenListV8Wrapper) item(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: DOMTokenList.item")
+	args := newArgumentHelper(u.scriptHost, info)
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.DOMTokenList)
+	if !ok {
+		return nil, v8.NewTypeError(u.scriptHost.iso, "DOMTokenList.item: Illegal invocation")
+	}
+	index, err1 := tryParseArg(args, 0, decodeIDLUnsignedLong)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result := instance.Item(index)
+		return u.toNullableDOMString(ctx, result)
+	}
+	return nil, errors.New("DOMTokenList.item: Missing arguments")
+}
+
+func (u domTokenListV8Wrapper) contains(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: DOMTokenList.contains")
+	args := newArgumentHelper(u.scriptHost, info)
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.DOMTokenList)
+	if !ok {
+		return nil, v8.NewTypeError(u.scriptHost.iso, "DOMTokenList.contains: Illegal invocation")
+	}
+	token, err1 := tryParseArg(args, 0, u.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result := instance.Contains(token)
+		return u.toBoolean(ctx, result)
+	}
+	return nil, errors.New("DOMTokenList.contains: Missing arguments")
+}
+
+func (u domTokenListV8Wrapper) add(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: DOMTokenList.add")
+	args := newArgumentHelper(u.scriptHost, info)
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.DOMTokenList)
+	if !ok {
+		return nil, v8.NewTypeError(u.scriptHost.iso, "DOMTokenList.add: Illegal invocation")
+	}
+	tokens, err1 := tryParseVariadicArg(args, 0, u.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		callErr := instance.Add(tokens...)
+		if callErr != nil {
+			return nil, mapError(u.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	callErr := instance.Add()
+	if callErr != nil {
+		return nil, mapError(u.scriptHost, callErr)
+	}
+	return nil, nil
+}
+
+func (u domTokenListV8Wrapper) remove(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: DOMTokenList.remove")
+	args := newArgumentHelper(u.scriptHost, info)
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.DOMTokenList)
+	if !ok {
+		return nil, v8.NewTypeError(u.scriptHost.iso, "DOMTokenList.remove: Illegal invocation")
+	}
+	tokens, err1 := tryParseVariadicArg(args, 0, u.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.Remove(tokens...)
+		return nil, nil
+	}
+	instance.Remove()
+	return nil, nil
+}
+
+func (u domTokenListV8Wrapper) replace(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: DOMTokenList.replace")
+	args := newArgumentHelper(u.scriptHost, info)
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.DOMTokenList)
+	if !ok {
+		return nil, v8.NewTypeError(u.scriptHost.iso, "DOMTokenList.replace: Illegal invocation")
+	}
+	token, err1 := tryParseArg(args, 0, u.decodeDOMString)
+	newToken, err2 := tryParseArg(args, 1, u.decodeDOMString)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		result := instance.Replace(token, newToken)
+		return u.toBoolean(ctx, result)
+	}
+	return nil, errors.New("DOMTokenList.replace: Missing arguments")
+}
+
+func (u domTokenListV8Wrapper) supports(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: DOMTokenList.supports")
+	return nil, notImplemented("DOMTokenList", "supports")
+}
+
+func (u domTokenListV8Wrapper) length(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: DOMTokenList.length")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.DOMTokenList)
+	if !ok {
+		return nil, v8.NewTypeError(u.scriptHost.iso, "DOMTokenList.length: Illegal invocation")
+	}
+	result := instance.Length()
+	return u.toUnsignedLong(ctx, result)
+}
+
+func (u domTokenListV8Wrapper) value(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: DOMTokenList.value")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.DOMTokenList)
+	if !ok {
+		return nil, v8.NewTypeError(u.scriptHost.iso, "DOMTokenList.value: Illegal invocation")
+	}
+	result := instance.Value()
+	return u.toDOMString(ctx, result)
+}
+
+func (u domTokenListV8Wrapper) setValue(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: DOMTokenList.setValue")
+	args := newArgumentHelper(u.scriptHost, info)
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.DOMTokenList)
+	if !ok {
+		return nil, v8.NewTypeError(u.scriptHost.iso, "DOMTokenList.setValue: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, u.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetValue(val)
+		return nil, nil
+	}
+	return nil, errors.New("DOMTokenList.setValue: Missing arguments")
+}
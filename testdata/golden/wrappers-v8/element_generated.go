@@ -0,0 +1,515 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	dom "github.com/gost-dom/browser/dom"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+func createElementPrototype(scriptHost *V8ScriptHost) *v8.FunctionTemplate {
+	iso := scriptHost.iso
+	wrapper := newElementV8Wrapper(scriptHost)
+	parentNodeWrapper := newParentNodeV8Wrapper(scriptHost, "Element")
+	nonDocumentTypeChildNodeWrapper := newNonDocumentTypeChildNodeV8Wrapper(scriptHost, "Element")
+	childNodeWrapper := newChildNodeV8Wrapper(scriptHost, "Element")
+	slottableWrapper := newSlottableV8Wrapper(scriptHost, "Element")
+	constructor := v8.NewFunctionTemplateWithError(iso, wrapper.Constructor)
+
+	instanceTmpl := constructor.InstanceTemplate()
+	instanceTmpl.SetInternalFieldCount(1)
+
+	prototypeTmpl := constructor.PrototypeTemplate()
+	prototypeTmpl.Set("hasAttributes", v8.NewFunctionTemplateWithError(iso, wrapper.hasAttributes))
+	prototypeTmpl.Set("getAttributeNames", v8.NewFunctionTemplateWithError(iso, wrapper.getAttributeNames))
+	prototypeTmpl.Set("getAttribute", v8.NewFunctionTemplateWithError(iso, wrapper.getAttribute))
+	prototypeTmpl.Set("getAttributeNS", v8.NewFunctionTemplateWithError(iso, wrapper.getAttributeNS))
+	prototypeTmpl.Set("setAttribute", v8.NewFunctionTemplateWithError(iso, wrapper.setAttribute))
+	prototypeTmpl.Set("setAttributeNS", v8.NewFunctionTemplateWithError(iso, wrapper.setAttributeNS))
+	prototypeTmpl.Set("removeAttribute", v8.NewFunctionTemplateWithError(iso, wrapper.removeAttribute))
+	prototypeTmpl.Set("removeAttributeNS", v8.NewFunctionTemplateWithError(iso, wrapper.removeAttributeNS))
+	prototypeTmpl.Set("toggleAttribute", v8.NewFunctionTemplateWithError(iso, wrapper.toggleAttribute))
+	prototypeTmpl.Set("hasAttribute", v8.NewFunctionTemplateWithError(iso, wrapper.hasAttribute))
+	prototypeTmpl.Set("hasAttributeNS", v8.NewFunctionTemplateWithError(iso, wrapper.hasAttributeNS))
+	prototypeTmpl.Set("getAttributeNode", v8.NewFunctionTemplateWithError(iso, wrapper.getAttributeNode))
+	prototypeTmpl.Set("getAttributeNodeNS", v8.NewFunctionTemplateWithError(iso, wrapper.getAttributeNodeNS))
+	prototypeTmpl.Set("setAttributeNode", v8.NewFunctionTemplateWithError(iso, wrapper.setAttributeNode))
+	prototypeTmpl.Set("setAttributeNodeNS", v8.NewFunctionTemplateWithError(iso, wrapper.setAttributeNodeNS))
+	prototypeTmpl.Set("removeAttributeNode", v8.NewFunctionTemplateWithError(iso, wrapper.removeAttributeNode))
+	prototypeTmpl.Set("attachShadow", v8.NewFunctionTemplateWithError(iso, wrapper.attachShadow))
+	prototypeTmpl.Set("matches", v8.NewFunctionTemplateWithError(iso, wrapper.matches))
+	prototypeTmpl.Set("getElementsByTagName", v8.NewFunctionTemplateWithError(iso, wrapper.getElementsByTagName))
+	prototypeTmpl.Set("getElementsByTagNameNS", v8.NewFunctionTemplateWithError(iso, wrapper.getElementsByTagNameNS))
+	prototypeTmpl.Set("getElementsByClassName", v8.NewFunctionTemplateWithError(iso, wrapper.getElementsByClassName))
+	prototypeTmpl.Set("insertAdjacentElement", v8.NewFunctionTemplateWithError(iso, wrapper.insertAdjacentElement))
+	prototypeTmpl.Set("insertAdjacentText", v8.NewFunctionTemplateWithError(iso, wrapper.insertAdjacentText))
+	prototypeTmpl.Set("getClientRects", v8.NewFunctionTemplateWithError(iso, wrapper.getClientRects))
+	prototypeTmpl.Set("getBoundingClientRect", v8.NewFunctionTemplateWithError(iso, wrapper.getBoundingClientRect))
+	prototypeTmpl.Set("checkVisibility", v8.NewFunctionTemplateWithError(iso, wrapper.checkVisibility))
+	prototypeTmpl.Set("scrollIntoView", v8.NewFunctionTemplateWithError(iso, wrapper.scrollIntoView))
+	prototypeTmpl.Set("scroll", v8.NewFunctionTemplateWithError(iso, wrapper.scroll))
+	prototypeTmpl.Set("scrollTo", v8.NewFunctionTemplateWithError(iso, wrapper.scrollTo))
+	prototypeTmpl.Set("scrollBy", v8.NewFunctionTemplateWithError(iso, wrapper.scrollBy))
+	prototypeTmpl.Set("prepend", v8.NewFunctionTemplateWithError(iso, parentNodeWrapper.prepend))
+	prototypeTmpl.Set("append", v8.NewFunctionTemplateWithError(iso, parentNodeWrapper.append))
+	prototypeTmpl.Set("replaceChildren", v8.NewFunctionTemplateWithError(iso, parentNodeWrapper.replaceChildren))
+	prototypeTmpl.Set("querySelector", v8.NewFunctionTemplateWithError(iso, parentNodeWrapper.querySelector))
+	prototypeTmpl.Set("querySelectorAll", v8.NewFunctionTemplateWithError(iso, parentNodeWrapper.querySelectorAll))
+	prototypeTmpl.Set("before", v8.NewFunctionTemplateWithError(iso, childNodeWrapper.before))
+	prototypeTmpl.Set("after", v8.NewFunctionTemplateWithError(iso, childNodeWrapper.after))
+	prototypeTmpl.Set("replaceWith", v8.NewFunctionTemplateWithError(iso, childNodeWrapper.replaceWith))
+	prototypeTmpl.Set("remove", v8.NewFunctionTemplateWithError(iso, childNodeWrapper.remove))
+
+	prototypeTmpl.SetAccessorProperty("namespaceURI",
+		v8.NewFunctionTemplateWithError(iso, wrapper.namespaceURI),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("prefix",
+		v8.NewFunctionTemplateWithError(iso, wrapper.prefix),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("localName",
+		v8.NewFunctionTemplateWithError(iso, wrapper.localName),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("tagName",
+		v8.NewFunctionTemplateWithError(iso, wrapper.tagName),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("id",
+		v8.NewFunctionTemplateWithError(iso, wrapper.id),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setId),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("className",
+		v8.NewFunctionTemplateWithError(iso, wrapper.className),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setClassName),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("classList",
+		v8.NewFunctionTemplateWithError(iso, wrapper.classList),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("slot",
+		v8.NewFunctionTemplateWithError(iso, wrapper.slot),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setSlot),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("attributes",
+		v8.NewFunctionTemplateWithError(iso, wrapper.attributes),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("shadowRoot",
+		v8.NewFunctionTemplateWithError(iso, wrapper.shadowRoot),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("scrollTop",
+		v8.NewFunctionTemplateWithError(iso, wrapper.scrollTop),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setScrollTop),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("scrollLeft",
+		v8.NewFunctionTemplateWithError(iso, wrapper.scrollLeft),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setScrollLeft),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("scrollWidth",
+		v8.NewFunctionTemplateWithError(iso, wrapper.scrollWidth),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("scrollHeight",
+		v8.NewFunctionTemplateWithError(iso, wrapper.scrollHeight),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("clientTop",
+		v8.NewFunctionTemplateWithError(iso, wrapper.clientTop),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("clientLeft",
+		v8.NewFunctionTemplateWithError(iso, wrapper.clientLeft),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("clientWidth",
+		v8.NewFunctionTemplateWithError(iso, wrapper.clientWidth),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("clientHeight",
+		v8.NewFunctionTemplateWithError(iso, wrapper.clientHeight),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("currentCSSZoom",
+		v8.NewFunctionTemplateWithError(iso, wrapper.currentCSSZoom),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("children",
+		v8.NewFunctionTemplateWithError(iso, parentNodeWrapper.children),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("firstElementChild",
+		v8.NewFunctionTemplateWithError(iso, parentNodeWrapper.firstElementChild),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("lastElementChild",
+		v8.NewFunctionTemplateWithError(iso, parentNodeWrapper.lastElementChild),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("childElementCount",
+		v8.NewFunctionTemplateWithError(iso, parentNodeWrapper.childElementCount),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("previousElementSibling",
+		v8.NewFunctionTemplateWithError(iso, nonDocumentTypeChildNodeWrapper.previousElementSibling),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("nextElementSibling",
+		v8.NewFunctionTemplateWithError(iso, nonDocumentTypeChildNodeWrapper.nextElementSibling),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("assignedSlot",
+		v8.NewFunctionTemplateWithError(iso, slottableWrapper.assignedSlot),
+		nil,
+		v8.None)
+
+	wrapper.CustomInitialiser(constructor)
+	return constructor
+}
+
+func (e elementV8Wrapper) Constructor(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	return nil, v8.NewTypeError(e.scriptHost.iso, "Illegal Constructor")
+}
+
+func (e elementV8Wrapper) hasAttributes(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.hasAttributes")
+	return nil, notImplemented("Element", "hasAttributes")
+}
+
+func (e elementV8Wrapper) getAttributeNames(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.getAttributeNames")
+	return nil, notImplemented("Element", "getAttributeNames")
+}
+
+func (e elementV8Wrapper) getAttributeNS(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.getAttributeNS")
+	return nil, notImplemented("Element", "getAttributeNS")
+}
+
+func (e elementV8Wrapper) setAttribute(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.setAttribute")
+	args := newArgumentHelper(e.scriptHost, info)
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Element)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Element.setAttribute: Illegal invocation")
+	}
+	qualifiedName, err1 := tryParseArg(args, 0, e.decodeDOMString)
+	value, err2 := tryParseArg(args, 1, e.decodeDOMString)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		instance.SetAttribute(qualifiedName, value)
+		return nil, nil
+	}
+	return nil, errors.New("Element.setAttribute: Missing arguments")
+}
+
+func (e elementV8Wrapper) setAttributeNS(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.setAttributeNS")
+	return nil, notImplemented("Element", "setAttributeNS")
+}
+
+func (e elementV8Wrapper) removeAttribute(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.removeAttribute")
+	return nil, notImplemented("Element", "removeAttribute")
+}
+
+func (e elementV8Wrapper) removeAttributeNS(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.removeAttributeNS")
+	return nil, notImplemented("Element", "removeAttributeNS")
+}
+
+func (e elementV8Wrapper) toggleAttribute(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.toggleAttribute")
+	return nil, notImplemented("Element", "toggleAttribute")
+}
+
+func (e elementV8Wrapper) hasAttribute(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: Element.hasAttribute")
+	args := newArgumentHelper(e.scriptHost, info)
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Element)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Element.hasAttribute: Illegal invocation")
+	}
+	qualifiedName, err1 := tryParseArg(args, 0, e.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result := instance.HasAttribute(qualifiedName)
+		return e.toBoolean(ctx, result)
+	}
+	return nil, errors.New("Element.hasAttribute: Missing arguments")
+}
+
+func (e elementV8Wrapper) hasAttributeNS(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.hasAttributeNS")
+	return nil, notImplemented("Element", "hasAttributeNS")
+}
+
+func (e elementV8Wrapper) getAttributeNode(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.getAttributeNode")
+	return nil, notImplemented("Element", "getAttributeNode")
+}
+
+func (e elementV8Wrapper) getAttributeNodeNS(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.getAttributeNodeNS")
+	return nil, notImplemented("Element", "getAttributeNodeNS")
+}
+
+func (e elementV8Wrapper) setAttributeNode(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.setAttributeNode")
+	return nil, notImplemented("Element", "setAttributeNode")
+}
+
+func (e elementV8Wrapper) setAttributeNodeNS(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.setAttributeNodeNS")
+	return nil, notImplemented("Element", "setAttributeNodeNS")
+}
+
+func (e elementV8Wrapper) removeAttributeNode(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.removeAttributeNode")
+	return nil, notImplemented("Element", "removeAttributeNode")
+}
+
+func (e elementV8Wrapper) attachShadow(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.attachShadow")
+	return nil, notImplemented("Element", "attachShadow")
+}
+
+func (e elementV8Wrapper) matches(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: Element.matches")
+	args := newArgumentHelper(e.scriptHost, info)
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Element)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Element.matches: Illegal invocation")
+	}
+	selectors, err1 := tryParseArg(args, 0, e.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.Matches(selectors)
+		if callErr != nil {
+			return nil, mapError(e.scriptHost, callErr)
+		} else {
+			return e.toBoolean(ctx, result)
+		}
+	}
+	return nil, errors.New("Element.matches: Missing arguments")
+}
+
+func (e elementV8Wrapper) getElementsByTagName(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.getElementsByTagName")
+	return nil, notImplemented("Element", "getElementsByTagName")
+}
+
+func (e elementV8Wrapper) getElementsByTagNameNS(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.getElementsByTagNameNS")
+	return nil, notImplemented("Element", "getElementsByTagNameNS")
+}
+
+func (e elementV8Wrapper) getElementsByClassName(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.getElementsByClassName")
+	return nil, notImplemented("Element", "getElementsByClassName")
+}
+
+func (e elementV8Wrapper) insertAdjacentElement(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.insertAdjacentElement")
+	return nil, notImplemented("Element", "insertAdjacentElement")
+}
+
+func (e elementV8Wrapper) insertAdjacentText(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.insertAdjacentText")
+	return nil, notImplemented("Element", "insertAdjacentText")
+}
+
+func (e elementV8Wrapper) getClientRects(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.getClientRects")
+	return nil, notImplemented("Element", "getClientRects")
+}
+
+func (e elementV8Wrapper) getBoundingClientRect(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.getBoundingClientRect")
+	return nil, notImplemented("Element", "getBoundingClientRect")
+}
+
+func (e elementV8Wrapper) checkVisibility(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.checkVisibility")
+	return nil, notImplemented("Element", "checkVisibility")
+}
+
+func (e elementV8Wrapper) scrollIntoView(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.scrollIntoView")
+	return nil, notImplemented("Element", "scrollIntoView")
+}
+
+func (e elementV8Wrapper) scroll(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.scroll")
+	return nil, notImplemented("Element", "scroll")
+}
+
+func (e elementV8Wrapper) scrollTo(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.scrollTo")
+	return nil, notImplemented("Element", "scrollTo")
+}
+
+func (e elementV8Wrapper) scrollBy(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.scrollBy")
+	return nil, notImplemented("Element", "scrollBy")
+}
+
+func (e elementV8Wrapper) namespaceURI(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.namespaceURI")
+	return nil, notImplemented("Element", "namespaceURI")
+}
+
+func (e elementV8Wrapper) prefix(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.prefix")
+	return nil, notImplemented("Element", "prefix")
+}
+
+func (e elementV8Wrapper) localName(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.localName")
+	return nil, notImplemented("Element", "localName")
+}
+
+func (e elementV8Wrapper) tagName(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: Element.tagName")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Element)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Element.tagName: Illegal invocation")
+	}
+	result := instance.TagName()
+	return e.toDOMString(ctx, result)
+}
+
+func (e elementV8Wrapper) id(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.id")
+	return nil, notImplemented("Element", "id")
+}
+
+func (e elementV8Wrapper) setId(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.setId")
+	return nil, notImplemented("Element", "setId")
+}
+
+func (e elementV8Wrapper) className(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.className")
+	return nil, notImplemented("Element", "className")
+}
+
+func (e elementV8Wrapper) setClassName(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.setClassName")
+	return nil, notImplemented("Element", "setClassName")
+}
+
+func (e elementV8Wrapper) slot(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.slot")
+	return nil, notImplemented("Element", "slot")
+}
+
+func (e elementV8Wrapper) setSlot(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.setSlot")
+	return nil, notImplemented("Element", "setSlot")
+}
+
+func (e elementV8Wrapper) attributes(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: Element.attributes")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Element)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "Element.attributes: Illegal invocation")
+	}
+	result := instance.Attributes()
+	return e.toNamedNodeMap(ctx, result)
+}
+
+func (e elementV8Wrapper) shadowRoot(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.shadowRoot")
+	return nil, notImplemented("Element", "shadowRoot")
+}
+
+func (e elementV8Wrapper) scrollTop(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.scrollTop")
+	return nil, notImplemented("Element", "scrollTop")
+}
+
+func (e elementV8Wrapper) setScrollTop(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.setScrollTop")
+	return nil, notImplemented("Element", "setScrollTop")
+}
+
+func (e elementV8Wrapper) scrollLeft(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.scrollLeft")
+	return nil, notImplemented("Element", "scrollLeft")
+}
+
+func (e elementV8Wrapper) setScrollLeft(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.setScrollLeft")
+	return nil, notImplemented("Element", "setScrollLeft")
+}
+
+func (e elementV8Wrapper) scrollWidth(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.scrollWidth")
+	return nil, notImplemented("Element", "scrollWidth")
+}
+
+func (e elementV8Wrapper) scrollHeight(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.scrollHeight")
+	return nil, notImplemented("Element", "scrollHeight")
+}
+
+func (e elementV8Wrapper) clientTop(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.clientTop")
+	return nil, notImplemented("Element", "clientTop")
+}
+
+func (e elementV8Wrapper) clientLeft(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.clientLeft")
+	return nil, notImplemented("Element", "clientLeft")
+}
+
+func (e elementV8Wrapper) clientWidth(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.clientWidth")
+	return nil, notImplemented("Element", "clientWidth")
+}
+
+func (e elementV8Wrapper) clientHeight(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.clientHeight")
+	return nil, notImplemented("Element", "clientHeight")
+}
+
+func (e elementV8Wrapper) currentCSSZoom(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Element.currentCSSZoom")
+	return nil, notImplemented("Element", "currentCSSZoom")
+}
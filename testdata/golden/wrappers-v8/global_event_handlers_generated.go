@@ -0,0 +1,67 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	html "github.com/gost-dom/browser/html"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+type globalEventHandlersV8Wrapper struct {
+	handleReffedObject[html.GlobalEventHandlers]
+	interfaceName string
+}
+
+func newGlobalEventHandlersV8Wrapper(scriptHost *V8ScriptHost, interfaceName string) *globalEventHandlersV8Wrapper {
+	return &globalEventHandlersV8Wrapper{newHandleReffedObject[html.GlobalEventHandlers](scriptHost), interfaceName}
+}
+
+// isIncluder returns whether the value implements the interface including
+// the mixin, named by interfaceName.
+func (h globalEventHandlersV8Wrapper) isIncluder(value any) bool {
+	switch h.interfaceName {
+	case "Window":
+		_, ok := value.(html.Window)
+		return ok
+	}
+	return false
+}
+
+func (h globalEventHandlersV8Wrapper) onerror(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := h.mustGetContext(info)
+	log.Debug("V8 Function call: GlobalEventHandlers.onerror")
+	wrapped, err := h.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.GlobalEventHandlers)
+	if !ok || !h.isIncluder(instance) {
+		return nil, v8.NewTypeError(h.scriptHost.iso, h.interfaceName+".onerror: Illegal invocation")
+	}
+	result := instance.Onerror()
+	return h.toOnErrorEventHandler(ctx, result)
+}
+
+func (h globalEventHandlersV8Wrapper) setOnerror(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: GlobalEventHandlers.setOnerror")
+	args := newArgumentHelper(h.scriptHost, info)
+	wrapped, err := h.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.GlobalEventHandlers)
+	if !ok || !h.isIncluder(instance) {
+		return nil, v8.NewTypeError(h.scriptHost.iso, h.interfaceName+".setOnerror: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, h.decodeOnErrorEventHandler)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetOnerror(val)
+		return nil, nil
+	}
+	return nil, errors.New("GlobalEventHandlers.setOnerror: Missing arguments")
+}
@@ -0,0 +1,123 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	html "github.com/gost-dom/browser/html"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+type hTMLAnchorElementV8Wrapper struct {
+	nodeV8WrapperBase[html.HTMLAnchorElement]
+}
+
+func newHTMLAnchorElementV8Wrapper(scriptHost *V8ScriptHost) *hTMLAnchorElementV8Wrapper {
+	return &hTMLAnchorElementV8Wrapper{newNodeV8WrapperBase[html.HTMLAnchorElement](scriptHost)}
+}
+
+func createHTMLAnchorElementPrototype(scriptHost *V8ScriptHost) *v8.FunctionTemplate {
+	iso := scriptHost.iso
+	wrapper := newHTMLAnchorElementV8Wrapper(scriptHost)
+	hTMLHyperlinkElementUtilsWrapper := newHTMLHyperlinkElementUtilsV8Wrapper(scriptHost, "HTMLAnchorElement")
+	constructor := v8.NewFunctionTemplateWithError(iso, wrapper.Constructor)
+
+	instanceTmpl := constructor.InstanceTemplate()
+	instanceTmpl.SetInternalFieldCount(1)
+
+	prototypeTmpl := constructor.PrototypeTemplate()
+	prototypeTmpl.Set("toString", v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.href))
+
+	prototypeTmpl.SetAccessorProperty("target",
+		v8.NewFunctionTemplateWithError(iso, wrapper.target),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setTarget),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("href",
+		v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.href),
+		v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.setHref),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("origin",
+		v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.origin),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("protocol",
+		v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.protocol),
+		v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.setProtocol),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("username",
+		v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.username),
+		v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.setUsername),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("password",
+		v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.password),
+		v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.setPassword),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("host",
+		v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.host),
+		v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.setHost),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("hostname",
+		v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.hostname),
+		v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.setHostname),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("port",
+		v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.port),
+		v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.setPort),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("pathname",
+		v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.pathname),
+		v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.setPathname),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("search",
+		v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.search),
+		v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.setSearch),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("hash",
+		v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.hash),
+		v8.NewFunctionTemplateWithError(iso, hTMLHyperlinkElementUtilsWrapper.setHash),
+		v8.None)
+
+	return constructor
+}
+
+func (e hTMLAnchorElementV8Wrapper) Constructor(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	return nil, v8.NewTypeError(e.scriptHost.iso, "Illegal Constructor")
+}
+
+func (e hTMLAnchorElementV8Wrapper) target(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: HTMLAnchorElement.target")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLAnchorElement)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "HTMLAnchorElement.target: Illegal invocation")
+	}
+	result := instance.Target()
+	return e.toDOMString(ctx, result)
+}
+
+func (e hTMLAnchorElementV8Wrapper) setTarget(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLAnchorElement.setTarget")
+	args := newArgumentHelper(e.scriptHost, info)
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLAnchorElement)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "HTMLAnchorElement.setTarget: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, e.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetTarget(val)
+		return nil, nil
+	}
+	return nil, errors.New("HTMLAnchorElement.setTarget: Missing arguments")
+}
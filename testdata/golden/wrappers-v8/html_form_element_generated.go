@@ -0,0 +1,301 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	html "github.com/gost-dom/browser/html"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+type hTMLFormElementV8Wrapper struct {
+	nodeV8WrapperBase[html.HTMLFormElement]
+}
+
+func newHTMLFormElementV8Wrapper(scriptHost *V8ScriptHost) *hTMLFormElementV8Wrapper {
+	return &hTMLFormElementV8Wrapper{newNodeV8WrapperBase[html.HTMLFormElement](scriptHost)}
+}
+
+func createHTMLFormElementPrototype(scriptHost *V8ScriptHost) *v8.FunctionTemplate {
+	iso := scriptHost.iso
+	wrapper := newHTMLFormElementV8Wrapper(scriptHost)
+	constructor := v8.NewFunctionTemplateWithError(iso, wrapper.Constructor)
+
+	instanceTmpl := constructor.InstanceTemplate()
+	instanceTmpl.SetInternalFieldCount(1)
+
+	prototypeTmpl := constructor.PrototypeTemplate()
+	prototypeTmpl.Set("submit", v8.NewFunctionTemplateWithError(iso, wrapper.submit))
+	prototypeTmpl.Set("requestSubmit", v8.NewFunctionTemplateWithError(iso, wrapper.requestSubmit))
+	prototypeTmpl.Set("reset", v8.NewFunctionTemplateWithError(iso, wrapper.reset))
+	prototypeTmpl.Set("checkValidity", v8.NewFunctionTemplateWithError(iso, wrapper.checkValidity))
+	prototypeTmpl.Set("reportValidity", v8.NewFunctionTemplateWithError(iso, wrapper.reportValidity))
+
+	prototypeTmpl.SetAccessorProperty("acceptCharset",
+		v8.NewFunctionTemplateWithError(iso, wrapper.acceptCharset),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setAcceptCharset),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("action",
+		v8.NewFunctionTemplateWithError(iso, wrapper.action),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setAction),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("autocomplete",
+		v8.NewFunctionTemplateWithError(iso, wrapper.autocomplete),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setAutocomplete),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("enctype",
+		v8.NewFunctionTemplateWithError(iso, wrapper.enctype),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setEnctype),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("encoding",
+		v8.NewFunctionTemplateWithError(iso, wrapper.encoding),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setEncoding),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("method",
+		v8.NewFunctionTemplateWithError(iso, wrapper.method),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setMethod),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("target",
+		v8.NewFunctionTemplateWithError(iso, wrapper.target),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setTarget),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("rel",
+		v8.NewFunctionTemplateWithError(iso, wrapper.rel),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setRel),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("relList",
+		v8.NewFunctionTemplateWithError(iso, wrapper.relList),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("elements",
+		v8.NewFunctionTemplateWithError(iso, wrapper.elements),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("length",
+		v8.NewFunctionTemplateWithError(iso, wrapper.length),
+		nil,
+		v8.None)
+
+	return constructor
+}
+
+func (e hTMLFormElementV8Wrapper) Constructor(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	return nil, v8.NewTypeError(e.scriptHost.iso, "Illegal Constructor")
+}
+
+func (e hTMLFormElementV8Wrapper) submit(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLFormElement.submit")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLFormElement)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "HTMLFormElement.submit: Illegal invocation")
+	}
+	callErr := instance.Submit()
+	if callErr != nil {
+		return nil, mapError(e.scriptHost, callErr)
+	}
+	return nil, nil
+}
+
+func (e hTMLFormElementV8Wrapper) requestSubmit(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLFormElement.requestSubmit")
+	args := newArgumentHelper(e.scriptHost, info)
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLFormElement)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "HTMLFormElement.requestSubmit: Illegal invocation")
+	}
+	submitter, err1 := tryParseArgWithDefault(args, 0, e.defaultHTMLElement, e.decodeHTMLElement)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		callErr := instance.RequestSubmit(submitter)
+		if callErr != nil {
+			return nil, mapError(e.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	return nil, errors.New("HTMLFormElement.requestSubmit: Missing arguments")
+}
+
+func (e hTMLFormElementV8Wrapper) reset(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLFormElement.reset")
+	return nil, notImplemented("HTMLFormElement", "reset")
+}
+
+func (e hTMLFormElementV8Wrapper) checkValidity(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLFormElement.checkValidity")
+	return nil, notImplemented("HTMLFormElement", "checkValidity")
+}
+
+func (e hTMLFormElementV8Wrapper) reportValidity(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLFormElement.reportValidity")
+	return nil, notImplemented("HTMLFormElement", "reportValidity")
+}
+
+func (e hTMLFormElementV8Wrapper) acceptCharset(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLFormElement.acceptCharset")
+	return nil, notImplemented("HTMLFormElement", "acceptCharset")
+}
+
+func (e hTMLFormElementV8Wrapper) setAcceptCharset(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLFormElement.setAcceptCharset")
+	return nil, notImplemented("HTMLFormElement", "setAcceptCharset")
+}
+
+func (e hTMLFormElementV8Wrapper) action(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: HTMLFormElement.action")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLFormElement)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "HTMLFormElement.action: Illegal invocation")
+	}
+	result := instance.Action()
+	return e.toUSVString(ctx, result)
+}
+
+func (e hTMLFormElementV8Wrapper) setAction(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLFormElement.setAction")
+	args := newArgumentHelper(e.scriptHost, info)
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLFormElement)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "HTMLFormElement.setAction: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, e.decodeUSVString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetAction(val)
+		return nil, nil
+	}
+	return nil, errors.New("HTMLFormElement.setAction: Missing arguments")
+}
+
+func (e hTMLFormElementV8Wrapper) autocomplete(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLFormElement.autocomplete")
+	return nil, notImplemented("HTMLFormElement", "autocomplete")
+}
+
+func (e hTMLFormElementV8Wrapper) setAutocomplete(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLFormElement.setAutocomplete")
+	return nil, notImplemented("HTMLFormElement", "setAutocomplete")
+}
+
+func (e hTMLFormElementV8Wrapper) enctype(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLFormElement.enctype")
+	return nil, notImplemented("HTMLFormElement", "enctype")
+}
+
+func (e hTMLFormElementV8Wrapper) setEnctype(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLFormElement.setEnctype")
+	return nil, notImplemented("HTMLFormElement", "setEnctype")
+}
+
+func (e hTMLFormElementV8Wrapper) encoding(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLFormElement.encoding")
+	return nil, notImplemented("HTMLFormElement", "encoding")
+}
+
+func (e hTMLFormElementV8Wrapper) setEncoding(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLFormElement.setEncoding")
+	return nil, notImplemented("HTMLFormElement", "setEncoding")
+}
+
+func (e hTMLFormElementV8Wrapper) method(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: HTMLFormElement.method")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLFormElement)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "HTMLFormElement.method: Illegal invocation")
+	}
+	result := instance.Method()
+	return e.toDOMString(ctx, result)
+}
+
+func (e hTMLFormElementV8Wrapper) setMethod(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLFormElement.setMethod")
+	args := newArgumentHelper(e.scriptHost, info)
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLFormElement)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "HTMLFormElement.setMethod: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, e.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetMethod(val)
+		return nil, nil
+	}
+	return nil, errors.New("HTMLFormElement.setMethod: Missing arguments")
+}
+
+func (e hTMLFormElementV8Wrapper) target(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLFormElement.target")
+	return nil, notImplemented("HTMLFormElement", "target")
+}
+
+func (e hTMLFormElementV8Wrapper) setTarget(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLFormElement.setTarget")
+	return nil, notImplemented("HTMLFormElement", "setTarget")
+}
+
+func (e hTMLFormElementV8Wrapper) rel(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLFormElement.rel")
+	return nil, notImplemented("HTMLFormElement", "rel")
+}
+
+func (e hTMLFormElementV8Wrapper) setRel(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLFormElement.setRel")
+	return nil, notImplemented("HTMLFormElement", "setRel")
+}
+
+func (e hTMLFormElementV8Wrapper) relList(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLFormElement.relList")
+	return nil, notImplemented("HTMLFormElement", "relList")
+}
+
+func (e hTMLFormElementV8Wrapper) elements(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: HTMLFormElement.elements")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLFormElement)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "HTMLFormElement.elements: Illegal invocation")
+	}
+	result := instance.Elements()
+	return e.toHTMLFormControlsCollection(ctx, result)
+}
+
+func (e hTMLFormElementV8Wrapper) length(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLFormElement.length")
+	return nil, notImplemented("HTMLFormElement", "length")
+}
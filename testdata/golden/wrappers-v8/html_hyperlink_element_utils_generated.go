@@ -0,0 +1,415 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	html "github.com/gost-dom/browser/html"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+type hTMLHyperlinkElementUtilsV8Wrapper struct {
+	nodeV8WrapperBase[html.HTMLHyperlinkElementUtils]
+	interfaceName string
+}
+
+func newHTMLHyperlinkElementUtilsV8Wrapper(scriptHost *V8ScriptHost, interfaceName string) *hTMLHyperlinkElementUtilsV8Wrapper {
+	return &hTMLHyperlinkElementUtilsV8Wrapper{newNodeV8WrapperBase[html.HTMLHyperlinkElementUtils](scriptHost), interfaceName}
+}
+
+// isIncluder returns whether the value implements the interface including
+// the mixin, named by interfaceName.
+func (u hTMLHyperlinkElementUtilsV8Wrapper) isIncluder(value any) bool {
+	switch u.interfaceName {
+	case "HTMLAnchorElement":
+		_, ok := value.(html.HTMLAnchorElement)
+		return ok
+	}
+	return false
+}
+
+func (u hTMLHyperlinkElementUtilsV8Wrapper) href(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: HTMLHyperlinkElementUtils.href")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLHyperlinkElementUtils)
+	if !ok || !u.isIncluder(instance) {
+		return nil, v8.NewTypeError(u.scriptHost.iso, u.interfaceName+".href: Illegal invocation")
+	}
+	result := instance.Href()
+	return u.toUSVString(ctx, result)
+}
+
+func (u hTMLHyperlinkElementUtilsV8Wrapper) setHref(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLHyperlinkElementUtils.setHref")
+	args := newArgumentHelper(u.scriptHost, info)
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLHyperlinkElementUtils)
+	if !ok || !u.isIncluder(instance) {
+		return nil, v8.NewTypeError(u.scriptHost.iso, u.interfaceName+".setHref: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, u.decodeUSVString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetHref(val)
+		return nil, nil
+	}
+	return nil, errors.New("HTMLHyperlinkElementUtils.setHref: Missing arguments")
+}
+
+func (u hTMLHyperlinkElementUtilsV8Wrapper) origin(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: HTMLHyperlinkElementUtils.origin")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLHyperlinkElementUtils)
+	if !ok || !u.isIncluder(instance) {
+		return nil, v8.NewTypeError(u.scriptHost.iso, u.interfaceName+".origin: Illegal invocation")
+	}
+	result := instance.Origin()
+	return u.toUSVString(ctx, result)
+}
+
+func (u hTMLHyperlinkElementUtilsV8Wrapper) protocol(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: HTMLHyperlinkElementUtils.protocol")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLHyperlinkElementUtils)
+	if !ok || !u.isIncluder(instance) {
+		return nil, v8.NewTypeError(u.scriptHost.iso, u.interfaceName+".protocol: Illegal invocation")
+	}
+	result := instance.Protocol()
+	return u.toUSVString(ctx, result)
+}
+
+func (u hTMLHyperlinkElementUtilsV8Wrapper) setProtocol(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLHyperlinkElementUtils.setProtocol")
+	args := newArgumentHelper(u.scriptHost, info)
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLHyperlinkElementUtils)
+	if !ok || !u.isIncluder(instance) {
+		return nil, v8.NewTypeError(u.scriptHost.iso, u.interfaceName+".setProtocol: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, u.decodeUSVString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetProtocol(val)
+		return nil, nil
+	}
+	return nil, errors.New("HTMLHyperlinkElementUtils.setProtocol: Missing arguments")
+}
+
+func (u hTMLHyperlinkElementUtilsV8Wrapper) username(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: HTMLHyperlinkElementUtils.username")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLHyperlinkElementUtils)
+	if !ok || !u.isIncluder(instance) {
+		return nil, v8.NewTypeError(u.scriptHost.iso, u.interfaceName+".username: Illegal invocation")
+	}
+	result := instance.Username()
+	return u.toUSVString(ctx, result)
+}
+
+func (u hTMLHyperlinkElementUtilsV8Wrapper) setUsername(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLHyperlinkElementUtils.setUsername")
+	args := newArgumentHelper(u.scriptHost, info)
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLHyperlinkElementUtils)
+	if !ok || !u.isIncluder(instance) {
+		return nil, v8.NewTypeError(u.scriptHost.iso, u.interfaceName+".setUsername: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, u.decodeUSVString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetUsername(val)
+		return nil, nil
+	}
+	return nil, errors.New("HTMLHyperlinkElementUtils.setUsername: Missing arguments")
+}
+
+func (u hTMLHyperlinkElementUtilsV8Wrapper) password(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: HTMLHyperlinkElementUtils.password")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLHyperlinkElementUtils)
+	if !ok || !u.isIncluder(instance) {
+		return nil, v8.NewTypeError(u.scriptHost.iso, u.interfaceName+".password: Illegal invocation")
+	}
+	result := instance.Password()
+	return u.toUSVString(ctx, result)
+}
+
+func (u hTMLHyperlinkElementUtilsV8Wrapper) setPassword(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLHyperlinkElementUtils.setPassword")
+	args := newArgumentHelper(u.scriptHost, info)
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLHyperlinkElementUtils)
+	if !ok || !u.isIncluder(instance) {
+		return nil, v8.NewTypeError(u.scriptHost.iso, u.interfaceName+".setPassword: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, u.decodeUSVString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetPassword(val)
+		return nil, nil
+	}
+	return nil, errors.New("HTMLHyperlinkElementUtils.setPassword: Missing arguments")
+}
+
+func (u hTMLHyperlinkElementUtilsV8Wrapper) host(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: HTMLHyperlinkElementUtils.host")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLHyperlinkElementUtils)
+	if !ok || !u.isIncluder(instance) {
+		return nil, v8.NewTypeError(u.scriptHost.iso, u.interfaceName+".host: Illegal invocation")
+	}
+	result := instance.Host()
+	return u.toUSVString(ctx, result)
+}
+
+func (u hTMLHyperlinkElementUtilsV8Wrapper) setHost(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLHyperlinkElementUtils.setHost")
+	args := newArgumentHelper(u.scriptHost, info)
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLHyperlinkElementUtils)
+	if !ok || !u.isIncluder(instance) {
+		return nil, v8.NewTypeError(u.scriptHost.iso, u.interfaceName+".setHost: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, u.decodeUSVString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetHost(val)
+		return nil, nil
+	}
+	return nil, errors.New("HTMLHyperlinkElementUtils.setHost: Missing arguments")
+}
+
+func (u hTMLHyperlinkElementUtilsV8Wrapper) hostname(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: HTMLHyperlinkElementUtils.hostname")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLHyperlinkElementUtils)
+	if !ok || !u.isIncluder(instance) {
+		return nil, v8.NewTypeError(u.scriptHost.iso, u.interfaceName+".hostname: Illegal invocation")
+	}
+	result := instance.Hostname()
+	return u.toUSVString(ctx, result)
+}
+
+func (u hTMLHyperlinkElementUtilsV8Wrapper) setHostname(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLHyperlinkElementUtils.setHostname")
+	args := newArgumentHelper(u.scriptHost, info)
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLHyperlinkElementUtils)
+	if !ok || !u.isIncluder(instance) {
+		return nil, v8.NewTypeError(u.scriptHost.iso, u.interfaceName+".setHostname: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, u.decodeUSVString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetHostname(val)
+		return nil, nil
+	}
+	return nil, errors.New("HTMLHyperlinkElementUtils.setHostname: Missing arguments")
+}
+
+func (u hTMLHyperlinkElementUtilsV8Wrapper) port(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: HTMLHyperlinkElementUtils.port")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLHyperlinkElementUtils)
+	if !ok || !u.isIncluder(instance) {
+		return nil, v8.NewTypeError(u.scriptHost.iso, u.interfaceName+".port: Illegal invocation")
+	}
+	result := instance.Port()
+	return u.toUSVString(ctx, result)
+}
+
+func (u hTMLHyperlinkElementUtilsV8Wrapper) setPort(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLHyperlinkElementUtils.setPort")
+	args := newArgumentHelper(u.scriptHost, info)
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLHyperlinkElementUtils)
+	if !ok || !u.isIncluder(instance) {
+		return nil, v8.NewTypeError(u.scriptHost.iso, u.interfaceName+".setPort: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, u.decodeUSVString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetPort(val)
+		return nil, nil
+	}
+	return nil, errors.New("HTMLHyperlinkElementUtils.setPort: Missing arguments")
+}
+
+func (u hTMLHyperlinkElementUtilsV8Wrapper) pathname(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: HTMLHyperlinkElementUtils.pathname")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLHyperlinkElementUtils)
+	if !ok || !u.isIncluder(instance) {
+		return nil, v8.NewTypeError(u.scriptHost.iso, u.interfaceName+".pathname: Illegal invocation")
+	}
+	result := instance.Pathname()
+	return u.toUSVString(ctx, result)
+}
+
+func (u hTMLHyperlinkElementUtilsV8Wrapper) setPathname(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLHyperlinkElementUtils.setPathname")
+	args := newArgumentHelper(u.scriptHost, info)
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLHyperlinkElementUtils)
+	if !ok || !u.isIncluder(instance) {
+		return nil, v8.NewTypeError(u.scriptHost.iso, u.interfaceName+".setPathname: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, u.decodeUSVString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetPathname(val)
+		return nil, nil
+	}
+	return nil, errors.New("HTMLHyperlinkElementUtils.setPathname: Missing arguments")
+}
+
+func (u hTMLHyperlinkElementUtilsV8Wrapper) search(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: HTMLHyperlinkElementUtils.search")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLHyperlinkElementUtils)
+	if !ok || !u.isIncluder(instance) {
+		return nil, v8.NewTypeError(u.scriptHost.iso, u.interfaceName+".search: Illegal invocation")
+	}
+	result := instance.Search()
+	return u.toUSVString(ctx, result)
+}
+
+func (u hTMLHyperlinkElementUtilsV8Wrapper) setSearch(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLHyperlinkElementUtils.setSearch")
+	args := newArgumentHelper(u.scriptHost, info)
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLHyperlinkElementUtils)
+	if !ok || !u.isIncluder(instance) {
+		return nil, v8.NewTypeError(u.scriptHost.iso, u.interfaceName+".setSearch: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, u.decodeUSVString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetSearch(val)
+		return nil, nil
+	}
+	return nil, errors.New("HTMLHyperlinkElementUtils.setSearch: Missing arguments")
+}
+
+func (u hTMLHyperlinkElementUtilsV8Wrapper) hash(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: HTMLHyperlinkElementUtils.hash")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLHyperlinkElementUtils)
+	if !ok || !u.isIncluder(instance) {
+		return nil, v8.NewTypeError(u.scriptHost.iso, u.interfaceName+".hash: Illegal invocation")
+	}
+	result := instance.Hash()
+	return u.toUSVString(ctx, result)
+}
+
+func (u hTMLHyperlinkElementUtilsV8Wrapper) setHash(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLHyperlinkElementUtils.setHash")
+	args := newArgumentHelper(u.scriptHost, info)
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLHyperlinkElementUtils)
+	if !ok || !u.isIncluder(instance) {
+		return nil, v8.NewTypeError(u.scriptHost.iso, u.interfaceName+".setHash: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, u.decodeUSVString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetHash(val)
+		return nil, nil
+	}
+	return nil, errors.New("HTMLHyperlinkElementUtils.setHash: Missing arguments")
+}
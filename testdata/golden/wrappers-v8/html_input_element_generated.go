@@ -0,0 +1,86 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	html "github.com/gost-dom/browser/html"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+type hTMLInputElementV8Wrapper struct {
+	nodeV8WrapperBase[html.HTMLInputElement]
+}
+
+func newHTMLInputElementV8Wrapper(scriptHost *V8ScriptHost) *hTMLInputElementV8Wrapper {
+	return &hTMLInputElementV8Wrapper{newNodeV8WrapperBase[html.HTMLInputElement](scriptHost)}
+}
+
+func createHTMLInputElementPrototype(scriptHost *V8ScriptHost) *v8.FunctionTemplate {
+	iso := scriptHost.iso
+	wrapper := newHTMLInputElementV8Wrapper(scriptHost)
+	popoverInvokerElementWrapper := newPopoverInvokerElementV8Wrapper(scriptHost, "HTMLInputElement")
+	constructor := v8.NewFunctionTemplateWithError(iso, wrapper.Constructor)
+
+	instanceTmpl := constructor.InstanceTemplate()
+	instanceTmpl.SetInternalFieldCount(1)
+
+	prototypeTmpl := constructor.PrototypeTemplate()
+
+	prototypeTmpl.SetAccessorProperty("type",
+		v8.NewFunctionTemplateWithError(iso, wrapper.type_),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setType),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("popoverTargetElement",
+		v8.NewFunctionTemplateWithError(iso, popoverInvokerElementWrapper.popoverTargetElement),
+		v8.NewFunctionTemplateWithError(iso, popoverInvokerElementWrapper.setPopoverTargetElement),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("popoverTargetAction",
+		v8.NewFunctionTemplateWithError(iso, popoverInvokerElementWrapper.popoverTargetAction),
+		v8.NewFunctionTemplateWithError(iso, popoverInvokerElementWrapper.setPopoverTargetAction),
+		v8.None)
+
+	return constructor
+}
+
+func (e hTMLInputElementV8Wrapper) Constructor(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	return nil, v8.NewTypeError(e.scriptHost.iso, "Illegal Constructor")
+}
+
+func (e hTMLInputElementV8Wrapper) type_(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: HTMLInputElement.type")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLInputElement)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "HTMLInputElement.type: Illegal invocation")
+	}
+	result := instance.Type()
+	return e.toDOMString(ctx, result)
+}
+
+func (e hTMLInputElementV8Wrapper) setType(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLInputElement.setType")
+	args := newArgumentHelper(e.scriptHost, info)
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLInputElement)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "HTMLInputElement.setType: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, e.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetType(val)
+		return nil, nil
+	}
+	return nil, errors.New("HTMLInputElement.setType: Missing arguments")
+}
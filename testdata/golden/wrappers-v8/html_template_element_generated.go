@@ -0,0 +1,102 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	html "github.com/gost-dom/browser/html"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+func createHtmlTemplateElementPrototype(scriptHost *V8ScriptHost) *v8.FunctionTemplate {
+	iso := scriptHost.iso
+	wrapper := newHtmlTemplateElementV8Wrapper(scriptHost)
+	constructor := v8.NewFunctionTemplateWithError(iso, wrapper.Constructor)
+
+	instanceTmpl := constructor.InstanceTemplate()
+	instanceTmpl.SetInternalFieldCount(1)
+
+	prototypeTmpl := constructor.PrototypeTemplate()
+
+	prototypeTmpl.SetAccessorProperty("content",
+		v8.NewFunctionTemplateWithError(iso, wrapper.content),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("shadowRootMode",
+		v8.NewFunctionTemplateWithError(iso, wrapper.shadowRootMode),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setShadowRootMode),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("shadowRootDelegatesFocus",
+		v8.NewFunctionTemplateWithError(iso, wrapper.shadowRootDelegatesFocus),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setShadowRootDelegatesFocus),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("shadowRootClonable",
+		v8.NewFunctionTemplateWithError(iso, wrapper.shadowRootClonable),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setShadowRootClonable),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("shadowRootSerializable",
+		v8.NewFunctionTemplateWithError(iso, wrapper.shadowRootSerializable),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setShadowRootSerializable),
+		v8.None)
+
+	return constructor
+}
+
+func (e htmlTemplateElementV8Wrapper) Constructor(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	return nil, v8.NewTypeError(e.scriptHost.iso, "Illegal Constructor")
+}
+
+func (e htmlTemplateElementV8Wrapper) content(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: HTMLTemplateElement.content")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.HTMLTemplateElement)
+	if !ok {
+		return nil, v8.NewTypeError(e.scriptHost.iso, "HTMLTemplateElement.content: Illegal invocation")
+	}
+	result := instance.Content()
+	return ctx.getInstanceForNode(result)
+}
+
+func (e htmlTemplateElementV8Wrapper) shadowRootMode(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLTemplateElement.shadowRootMode")
+	return nil, notImplemented("HTMLTemplateElement", "shadowRootMode")
+}
+
+func (e htmlTemplateElementV8Wrapper) setShadowRootMode(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLTemplateElement.setShadowRootMode")
+	return nil, notImplemented("HTMLTemplateElement", "setShadowRootMode")
+}
+
+func (e htmlTemplateElementV8Wrapper) shadowRootDelegatesFocus(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLTemplateElement.shadowRootDelegatesFocus")
+	return nil, notImplemented("HTMLTemplateElement", "shadowRootDelegatesFocus")
+}
+
+func (e htmlTemplateElementV8Wrapper) setShadowRootDelegatesFocus(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLTemplateElement.setShadowRootDelegatesFocus")
+	return nil, notImplemented("HTMLTemplateElement", "setShadowRootDelegatesFocus")
+}
+
+func (e htmlTemplateElementV8Wrapper) shadowRootClonable(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLTemplateElement.shadowRootClonable")
+	return nil, notImplemented("HTMLTemplateElement", "shadowRootClonable")
+}
+
+func (e htmlTemplateElementV8Wrapper) setShadowRootClonable(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLTemplateElement.setShadowRootClonable")
+	return nil, notImplemented("HTMLTemplateElement", "setShadowRootClonable")
+}
+
+func (e htmlTemplateElementV8Wrapper) shadowRootSerializable(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLTemplateElement.shadowRootSerializable")
+	return nil, notImplemented("HTMLTemplateElement", "shadowRootSerializable")
+}
+
+func (e htmlTemplateElementV8Wrapper) setShadowRootSerializable(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: HTMLTemplateElement.setShadowRootSerializable")
+	return nil, notImplemented("HTMLTemplateElement", "setShadowRootSerializable")
+}
@@ -0,0 +1,17 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+func init() {
+	registerJSClass("DOMTokenList", "", createDomTokenListPrototype)
+	registerJSClass("Node", "EventTarget", createNodePrototype)
+	registerJSClass("Element", "Node", createElementPrototype)
+	registerJSClass("Event", "", createEventPrototype)
+	registerJSClass("HTMLAnchorElement", "HTMLElement", createHTMLAnchorElementPrototype)
+	registerJSClass("HTMLFormElement", "HTMLElement", createHTMLFormElementPrototype)
+	registerJSClass("HTMLInputElement", "HTMLElement", createHTMLInputElementPrototype)
+	registerJSClass("HTMLTemplateElement", "HTMLElement", createHtmlTemplateElementPrototype)
+	registerJSClass("History", "", createHistoryPrototype)
+	registerJSClass("URL", "", createUrlPrototype)
+	registerJSClass("Window", "EventTarget", createWindowPrototype)
+}
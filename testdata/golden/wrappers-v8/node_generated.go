@@ -0,0 +1,381 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	dom "github.com/gost-dom/browser/dom"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+func createNodePrototype(scriptHost *V8ScriptHost) *v8.FunctionTemplate {
+	iso := scriptHost.iso
+	wrapper := newNodeV8Wrapper(scriptHost)
+	constructor := v8.NewFunctionTemplateWithError(iso, wrapper.Constructor)
+
+	instanceTmpl := constructor.InstanceTemplate()
+	instanceTmpl.SetInternalFieldCount(1)
+
+	prototypeTmpl := constructor.PrototypeTemplate()
+	prototypeTmpl.Set("getRootNode", v8.NewFunctionTemplateWithError(iso, wrapper.getRootNode))
+	prototypeTmpl.Set("cloneNode", v8.NewFunctionTemplateWithError(iso, wrapper.cloneNode))
+	prototypeTmpl.Set("isSameNode", v8.NewFunctionTemplateWithError(iso, wrapper.isSameNode))
+	prototypeTmpl.Set("contains", v8.NewFunctionTemplateWithError(iso, wrapper.contains))
+	prototypeTmpl.Set("insertBefore", v8.NewFunctionTemplateWithError(iso, wrapper.insertBefore))
+	prototypeTmpl.Set("appendChild", v8.NewFunctionTemplateWithError(iso, wrapper.appendChild))
+	prototypeTmpl.Set("removeChild", v8.NewFunctionTemplateWithError(iso, wrapper.removeChild))
+
+	prototypeTmpl.SetAccessorProperty("nodeType",
+		v8.NewFunctionTemplateWithError(iso, wrapper.nodeType),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("nodeName",
+		v8.NewFunctionTemplateWithError(iso, wrapper.nodeName),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("isConnected",
+		v8.NewFunctionTemplateWithError(iso, wrapper.isConnected),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("ownerDocument",
+		v8.NewFunctionTemplateWithError(iso, wrapper.ownerDocument),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("parentElement",
+		v8.NewFunctionTemplateWithError(iso, wrapper.parentElement),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("childNodes",
+		v8.NewFunctionTemplateWithError(iso, wrapper.childNodes),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("firstChild",
+		v8.NewFunctionTemplateWithError(iso, wrapper.firstChild),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("previousSibling",
+		v8.NewFunctionTemplateWithError(iso, wrapper.previousSibling),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("nextSibling",
+		v8.NewFunctionTemplateWithError(iso, wrapper.nextSibling),
+		nil,
+		v8.None)
+
+	return constructor
+}
+
+func (n nodeV8Wrapper) Constructor(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	return nil, v8.NewTypeError(n.scriptHost.iso, "Illegal Constructor")
+}
+
+func (n nodeV8Wrapper) getRootNode(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: Node.getRootNode")
+	args := newArgumentHelper(n.scriptHost, info)
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Node)
+	if !ok {
+		return nil, v8.NewTypeError(n.scriptHost.iso, "Node.getRootNode: Illegal invocation")
+	}
+	options, err1 := tryParseArgWithDefault(args, 0, n.defaultGetRootNodeOptions, n.decodeGetRootNodeOptions)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result := instance.GetRootNode(options)
+		return ctx.getInstanceForNode(result)
+	}
+	return nil, errors.New("Node.getRootNode: Missing arguments")
+}
+
+func (n nodeV8Wrapper) cloneNode(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: Node.cloneNode")
+	args := newArgumentHelper(n.scriptHost, info)
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Node)
+	if !ok {
+		return nil, v8.NewTypeError(n.scriptHost.iso, "Node.cloneNode: Illegal invocation")
+	}
+	subtree, err1 := tryParseArgWithDefault(args, 0, n.defaultboolean, n.decodeBoolean)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result := instance.CloneNode(subtree)
+		return ctx.getInstanceForNode(result)
+	}
+	return nil, errors.New("Node.cloneNode: Missing arguments")
+}
+
+func (n nodeV8Wrapper) isSameNode(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: Node.isSameNode")
+	args := newArgumentHelper(n.scriptHost, info)
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Node)
+	if !ok {
+		return nil, v8.NewTypeError(n.scriptHost.iso, "Node.isSameNode: Illegal invocation")
+	}
+	otherNode, err1 := tryParseNullableArg(args, 0, n.decodeNode)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result := instance.IsSameNode(otherNode)
+		return n.toBoolean(ctx, result)
+	}
+	return nil, errors.New("Node.isSameNode: Missing arguments")
+}
+
+func (n nodeV8Wrapper) contains(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: Node.contains")
+	args := newArgumentHelper(n.scriptHost, info)
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Node)
+	if !ok {
+		return nil, v8.NewTypeError(n.scriptHost.iso, "Node.contains: Illegal invocation")
+	}
+	other, err1 := tryParseNullableArg(args, 0, n.decodeNode)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result := instance.Contains(other)
+		return n.toBoolean(ctx, result)
+	}
+	return nil, errors.New("Node.contains: Missing arguments")
+}
+
+func (n nodeV8Wrapper) insertBefore(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: Node.insertBefore")
+	args := newArgumentHelper(n.scriptHost, info)
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Node)
+	if !ok {
+		return nil, v8.NewTypeError(n.scriptHost.iso, "Node.insertBefore: Illegal invocation")
+	}
+	node, err1 := tryParseArg(args, 0, n.decodeNode)
+	child, err2 := tryParseNullableArg(args, 1, n.decodeNode)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		result, callErr := instance.InsertBefore(node, child)
+		if callErr != nil {
+			return nil, mapError(n.scriptHost, callErr)
+		} else {
+			return ctx.getInstanceForNode(result)
+		}
+	}
+	return nil, errors.New("Node.insertBefore: Missing arguments")
+}
+
+func (n nodeV8Wrapper) appendChild(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: Node.appendChild")
+	args := newArgumentHelper(n.scriptHost, info)
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Node)
+	if !ok {
+		return nil, v8.NewTypeError(n.scriptHost.iso, "Node.appendChild: Illegal invocation")
+	}
+	node, err1 := tryParseArg(args, 0, n.decodeNode)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.AppendChild(node)
+		if callErr != nil {
+			return nil, mapError(n.scriptHost, callErr)
+		} else {
+			return ctx.getInstanceForNode(result)
+		}
+	}
+	return nil, errors.New("Node.appendChild: Missing arguments")
+}
+
+func (n nodeV8Wrapper) removeChild(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: Node.removeChild")
+	args := newArgumentHelper(n.scriptHost, info)
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Node)
+	if !ok {
+		return nil, v8.NewTypeError(n.scriptHost.iso, "Node.removeChild: Illegal invocation")
+	}
+	child, err1 := tryParseArg(args, 0, n.decodeNode)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.RemoveChild(child)
+		if callErr != nil {
+			return nil, mapError(n.scriptHost, callErr)
+		} else {
+			return ctx.getInstanceForNode(result)
+		}
+	}
+	return nil, errors.New("Node.removeChild: Missing arguments")
+}
+
+func (n nodeV8Wrapper) nodeName(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: Node.nodeName")
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Node)
+	if !ok {
+		return nil, v8.NewTypeError(n.scriptHost.iso, "Node.nodeName: Illegal invocation")
+	}
+	result := instance.NodeName()
+	return n.toDOMString(ctx, result)
+}
+
+func (n nodeV8Wrapper) isConnected(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: Node.isConnected")
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Node)
+	if !ok {
+		return nil, v8.NewTypeError(n.scriptHost.iso, "Node.isConnected: Illegal invocation")
+	}
+	result := instance.IsConnected()
+	return n.toBoolean(ctx, result)
+}
+
+func (n nodeV8Wrapper) ownerDocument(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: Node.ownerDocument")
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Node)
+	if !ok {
+		return nil, v8.NewTypeError(n.scriptHost.iso, "Node.ownerDocument: Illegal invocation")
+	}
+	result := instance.OwnerDocument()
+	if result == nil {
+		return v8.Null(n.scriptHost.iso), nil
+	}
+	return ctx.getInstanceForNode(result)
+}
+
+func (n nodeV8Wrapper) parentElement(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: Node.parentElement")
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Node)
+	if !ok {
+		return nil, v8.NewTypeError(n.scriptHost.iso, "Node.parentElement: Illegal invocation")
+	}
+	result := instance.ParentElement()
+	if result == nil {
+		return v8.Null(n.scriptHost.iso), nil
+	}
+	return ctx.getInstanceForNode(result)
+}
+
+func (n nodeV8Wrapper) childNodes(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: Node.childNodes")
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Node)
+	if !ok {
+		return nil, v8.NewTypeError(n.scriptHost.iso, "Node.childNodes: Illegal invocation")
+	}
+	result := instance.ChildNodes()
+	return n.toNodeList(ctx, result)
+}
+
+func (n nodeV8Wrapper) firstChild(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: Node.firstChild")
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Node)
+	if !ok {
+		return nil, v8.NewTypeError(n.scriptHost.iso, "Node.firstChild: Illegal invocation")
+	}
+	result := instance.FirstChild()
+	if result == nil {
+		return v8.Null(n.scriptHost.iso), nil
+	}
+	return ctx.getInstanceForNode(result)
+}
+
+func (n nodeV8Wrapper) previousSibling(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: Node.previousSibling")
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Node)
+	if !ok {
+		return nil, v8.NewTypeError(n.scriptHost.iso, "Node.previousSibling: Illegal invocation")
+	}
+	result := instance.PreviousSibling()
+	if result == nil {
+		return v8.Null(n.scriptHost.iso), nil
+	}
+	return ctx.getInstanceForNode(result)
+}
+
+func (n nodeV8Wrapper) nextSibling(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: Node.nextSibling")
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Node)
+	if !ok {
+		return nil, v8.NewTypeError(n.scriptHost.iso, "Node.nextSibling: Illegal invocation")
+	}
+	result := instance.NextSibling()
+	if result == nil {
+		return v8.Null(n.scriptHost.iso), nil
+	}
+	return ctx.getInstanceForNode(result)
+}
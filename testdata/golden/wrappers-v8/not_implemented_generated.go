@@ -0,0 +1,167 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import "fmt"
+
+// NotImplementedMember identifies a member of a wrapped interface that is
+// not implemented.
+type NotImplementedMember struct {
+	Interface string
+	Member    string
+}
+
+// NotImplementedMembers contains all members that are not implemented, and
+// throw an error when called from JavaScript.
+var NotImplementedMembers = []NotImplementedMember{
+	{"DOMTokenList", "supports"},
+	{"Element", "attachShadow"},
+	{"Element", "checkVisibility"},
+	{"Element", "className"},
+	{"Element", "clientHeight"},
+	{"Element", "clientLeft"},
+	{"Element", "clientTop"},
+	{"Element", "clientWidth"},
+	{"Element", "currentCSSZoom"},
+	{"Element", "getAttributeNS"},
+	{"Element", "getAttributeNames"},
+	{"Element", "getAttributeNode"},
+	{"Element", "getAttributeNodeNS"},
+	{"Element", "getBoundingClientRect"},
+	{"Element", "getClientRects"},
+	{"Element", "getElementsByClassName"},
+	{"Element", "getElementsByTagName"},
+	{"Element", "getElementsByTagNameNS"},
+	{"Element", "hasAttributeNS"},
+	{"Element", "hasAttributes"},
+	{"Element", "id"},
+	{"Element", "insertAdjacentElement"},
+	{"Element", "insertAdjacentText"},
+	{"Element", "localName"},
+	{"Element", "namespaceURI"},
+	{"Element", "prefix"},
+	{"Element", "removeAttribute"},
+	{"Element", "removeAttributeNS"},
+	{"Element", "removeAttributeNode"},
+	{"Element", "scroll"},
+	{"Element", "scrollBy"},
+	{"Element", "scrollHeight"},
+	{"Element", "scrollIntoView"},
+	{"Element", "scrollLeft"},
+	{"Element", "scrollTo"},
+	{"Element", "scrollTop"},
+	{"Element", "scrollWidth"},
+	{"Element", "setAttributeNS"},
+	{"Element", "setAttributeNode"},
+	{"Element", "setAttributeNodeNS"},
+	{"Element", "setClassName"},
+	{"Element", "setId"},
+	{"Element", "setScrollLeft"},
+	{"Element", "setScrollTop"},
+	{"Element", "setSlot"},
+	{"Element", "shadowRoot"},
+	{"Element", "slot"},
+	{"Element", "toggleAttribute"},
+	{"HTMLFormElement", "acceptCharset"},
+	{"HTMLFormElement", "autocomplete"},
+	{"HTMLFormElement", "checkValidity"},
+	{"HTMLFormElement", "encoding"},
+	{"HTMLFormElement", "enctype"},
+	{"HTMLFormElement", "length"},
+	{"HTMLFormElement", "rel"},
+	{"HTMLFormElement", "relList"},
+	{"HTMLFormElement", "reportValidity"},
+	{"HTMLFormElement", "reset"},
+	{"HTMLFormElement", "setAcceptCharset"},
+	{"HTMLFormElement", "setAutocomplete"},
+	{"HTMLFormElement", "setEncoding"},
+	{"HTMLFormElement", "setEnctype"},
+	{"HTMLFormElement", "setRel"},
+	{"HTMLFormElement", "setTarget"},
+	{"HTMLFormElement", "target"},
+	{"HTMLTemplateElement", "setShadowRootClonable"},
+	{"HTMLTemplateElement", "setShadowRootDelegatesFocus"},
+	{"HTMLTemplateElement", "setShadowRootMode"},
+	{"HTMLTemplateElement", "setShadowRootSerializable"},
+	{"HTMLTemplateElement", "shadowRootClonable"},
+	{"HTMLTemplateElement", "shadowRootDelegatesFocus"},
+	{"HTMLTemplateElement", "shadowRootMode"},
+	{"HTMLTemplateElement", "shadowRootSerializable"},
+	{"URL", "password"},
+	{"URL", "searchParams"},
+	{"URL", "setHash"},
+	{"URL", "setHost"},
+	{"URL", "setHostname"},
+	{"URL", "setHref"},
+	{"URL", "setPassword"},
+	{"URL", "setPathname"},
+	{"URL", "setPort"},
+	{"URL", "setProtocol"},
+	{"URL", "setSearch"},
+	{"URL", "setUsername"},
+	{"URL", "username"},
+	{"Window", "alert"},
+	{"Window", "blur"},
+	{"Window", "clientInformation"},
+	{"Window", "close"},
+	{"Window", "closed"},
+	{"Window", "confirm"},
+	{"Window", "customElements"},
+	{"Window", "focus"},
+	{"Window", "frameElement"},
+	{"Window", "frames"},
+	{"Window", "length"},
+	{"Window", "locationbar"},
+	{"Window", "menubar"},
+	{"Window", "name"},
+	{"Window", "navigation"},
+	{"Window", "navigator"},
+	{"Window", "open"},
+	{"Window", "opener"},
+	{"Window", "originAgentCluster"},
+	{"Window", "personalbar"},
+	{"Window", "postMessage"},
+	{"Window", "print"},
+	{"Window", "prompt"},
+	{"Window", "scrollbars"},
+	{"Window", "self"},
+	{"Window", "setName"},
+	{"Window", "setOpener"},
+	{"Window", "setStatus"},
+	{"Window", "status"},
+	{"Window", "statusbar"},
+	{"Window", "stop"},
+	{"Window", "toolbar"},
+	{"Window", "top"},
+	{"XMLHttpRequest", "readyState"},
+	{"XMLHttpRequest", "responseType"},
+	{"XMLHttpRequest", "responseXML"},
+	{"XMLHttpRequest", "setResponseType"},
+	{"console", "dir"},
+	{"console", "table"},
+}
+
+// NotImplementedError is the error returned when JavaScript calls a member
+// that is not implemented.
+type NotImplementedError struct {
+	NotImplementedMember
+}
+
+func (e NotImplementedError) Error() string {
+	return fmt.Sprintf("%s.%s: Not implemented. Create an issue: %s", e.Interface, e.Member, "https://github.com/gost-dom/browser/issues")
+}
+
+// OnNotImplemented is called when JavaScript calls a member that is not
+// implemented, e.g., to log or count which missing APIs scripts use. Set it
+// before running scripts.
+var OnNotImplemented func(NotImplementedMember)
+
+// notImplemented reports the call to a member that is not implemented to
+// OnNotImplemented, and returns the error to throw.
+func notImplemented(intf string, member string) error {
+	m := NotImplementedMember{intf, member}
+	if OnNotImplemented != nil {
+		OnNotImplemented(m)
+	}
+	return NotImplementedError{m}
+}
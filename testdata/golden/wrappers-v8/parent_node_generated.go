@@ -0,0 +1,240 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	dom "github.com/gost-dom/browser/dom"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+type parentNodeV8Wrapper struct {
+	nodeV8WrapperBase[dom.ParentNode]
+	interfaceName string
+}
+
+func newParentNodeV8Wrapper(scriptHost *V8ScriptHost, interfaceName string) *parentNodeV8Wrapper {
+	return &parentNodeV8Wrapper{newNodeV8WrapperBase[dom.ParentNode](scriptHost), interfaceName}
+}
+
+// isIncluder returns whether the value implements the interface including
+// the mixin, named by interfaceName.
+func (n parentNodeV8Wrapper) isIncluder(value any) bool {
+	switch n.interfaceName {
+	case "Element":
+		_, ok := value.(dom.Element)
+		return ok
+	}
+	return false
+}
+
+func (n parentNodeV8Wrapper) prepend(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: ParentNode.prepend")
+	args := newArgumentHelper(n.scriptHost, info)
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.ParentNode)
+	if !ok || !n.isIncluder(instance) {
+		return nil, v8.NewTypeError(n.scriptHost.iso, n.interfaceName+".prepend: Illegal invocation")
+	}
+	nodes, err1 := tryParseVariadicArg(args, 0, n.decodeNode, n.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		callErr := instance.Prepend(nodes...)
+		if callErr != nil {
+			return nil, mapError(n.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	callErr := instance.Prepend()
+	if callErr != nil {
+		return nil, mapError(n.scriptHost, callErr)
+	}
+	return nil, nil
+}
+
+func (n parentNodeV8Wrapper) append(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: ParentNode.append")
+	args := newArgumentHelper(n.scriptHost, info)
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.ParentNode)
+	if !ok || !n.isIncluder(instance) {
+		return nil, v8.NewTypeError(n.scriptHost.iso, n.interfaceName+".append: Illegal invocation")
+	}
+	nodes, err1 := tryParseVariadicArg(args, 0, n.decodeNode, n.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		callErr := instance.Append(nodes...)
+		if callErr != nil {
+			return nil, mapError(n.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	callErr := instance.Append()
+	if callErr != nil {
+		return nil, mapError(n.scriptHost, callErr)
+	}
+	return nil, nil
+}
+
+func (n parentNodeV8Wrapper) replaceChildren(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: ParentNode.replaceChildren")
+	args := newArgumentHelper(n.scriptHost, info)
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.ParentNode)
+	if !ok || !n.isIncluder(instance) {
+		return nil, v8.NewTypeError(n.scriptHost.iso, n.interfaceName+".replaceChildren: Illegal invocation")
+	}
+	nodes, err1 := tryParseVariadicArg(args, 0, n.decodeNode, n.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		callErr := instance.ReplaceChildren(nodes...)
+		if callErr != nil {
+			return nil, mapError(n.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	callErr := instance.ReplaceChildren()
+	if callErr != nil {
+		return nil, mapError(n.scriptHost, callErr)
+	}
+	return nil, nil
+}
+
+func (n parentNodeV8Wrapper) querySelector(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: ParentNode.querySelector")
+	args := newArgumentHelper(n.scriptHost, info)
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.ParentNode)
+	if !ok || !n.isIncluder(instance) {
+		return nil, v8.NewTypeError(n.scriptHost.iso, n.interfaceName+".querySelector: Illegal invocation")
+	}
+	selectors, err1 := tryParseArg(args, 0, n.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.QuerySelector(selectors)
+		if callErr != nil {
+			return nil, mapError(n.scriptHost, callErr)
+		} else {
+			if result == nil {
+				return v8.Null(n.scriptHost.iso), nil
+			}
+			return ctx.getInstanceForNode(result)
+		}
+	}
+	return nil, errors.New("ParentNode.querySelector: Missing arguments")
+}
+
+func (n parentNodeV8Wrapper) querySelectorAll(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: ParentNode.querySelectorAll")
+	args := newArgumentHelper(n.scriptHost, info)
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.ParentNode)
+	if !ok || !n.isIncluder(instance) {
+		return nil, v8.NewTypeError(n.scriptHost.iso, n.interfaceName+".querySelectorAll: Illegal invocation")
+	}
+	selectors, err1 := tryParseArg(args, 0, n.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.QuerySelectorAll(selectors)
+		if callErr != nil {
+			return nil, mapError(n.scriptHost, callErr)
+		} else {
+			return n.toNodeList(ctx, result)
+		}
+	}
+	return nil, errors.New("ParentNode.querySelectorAll: Missing arguments")
+}
+
+func (n parentNodeV8Wrapper) children(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: ParentNode.children")
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.ParentNode)
+	if !ok || !n.isIncluder(instance) {
+		return nil, v8.NewTypeError(n.scriptHost.iso, n.interfaceName+".children: Illegal invocation")
+	}
+	result := instance.Children()
+	return n.toHTMLCollection(ctx, result)
+}
+
+func (n parentNodeV8Wrapper) firstElementChild(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: ParentNode.firstElementChild")
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.ParentNode)
+	if !ok || !n.isIncluder(instance) {
+		return nil, v8.NewTypeError(n.scriptHost.iso, n.interfaceName+".firstElementChild: Illegal invocation")
+	}
+	result := instance.FirstElementChild()
+	if result == nil {
+		return v8.Null(n.scriptHost.iso), nil
+	}
+	return ctx.getInstanceForNode(result)
+}
+
+func (n parentNodeV8Wrapper) lastElementChild(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: ParentNode.lastElementChild")
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.ParentNode)
+	if !ok || !n.isIncluder(instance) {
+		return nil, v8.NewTypeError(n.scriptHost.iso, n.interfaceName+".lastElementChild: Illegal invocation")
+	}
+	result := instance.LastElementChild()
+	if result == nil {
+		return v8.Null(n.scriptHost.iso), nil
+	}
+	return ctx.getInstanceForNode(result)
+}
+
+func (n parentNodeV8Wrapper) childElementCount(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := n.mustGetContext(info)
+	log.Debug("V8 Function call: ParentNode.childElementCount")
+	wrapped, err := n.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.ParentNode)
+	if !ok || !n.isIncluder(instance) {
+		return nil, v8.NewTypeError(n.scriptHost.iso, n.interfaceName+".childElementCount: Illegal invocation")
+	}
+	result := instance.ChildElementCount()
+	return n.toUnsignedLong(ctx, result)
+}
@@ -0,0 +1,107 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	html "github.com/gost-dom/browser/html"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+type popoverInvokerElementV8Wrapper struct {
+	nodeV8WrapperBase[html.PopoverInvokerElement]
+	interfaceName string
+}
+
+func newPopoverInvokerElementV8Wrapper(scriptHost *V8ScriptHost, interfaceName string) *popoverInvokerElementV8Wrapper {
+	return &popoverInvokerElementV8Wrapper{newNodeV8WrapperBase[html.PopoverInvokerElement](scriptHost), interfaceName}
+}
+
+// isIncluder returns whether the value implements the interface including
+// the mixin, named by interfaceName.
+func (e popoverInvokerElementV8Wrapper) isIncluder(value any) bool {
+	switch e.interfaceName {
+	case "HTMLInputElement":
+		_, ok := value.(html.HTMLInputElement)
+		return ok
+	}
+	return false
+}
+
+func (e popoverInvokerElementV8Wrapper) popoverTargetElement(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: PopoverInvokerElement.popoverTargetElement")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.PopoverInvokerElement)
+	if !ok || !e.isIncluder(instance) {
+		return nil, v8.NewTypeError(e.scriptHost.iso, e.interfaceName+".popoverTargetElement: Illegal invocation")
+	}
+	result := instance.PopoverTargetElement()
+	if result == nil {
+		return v8.Null(e.scriptHost.iso), nil
+	}
+	return ctx.getInstanceForNode(result)
+}
+
+func (e popoverInvokerElementV8Wrapper) setPopoverTargetElement(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: PopoverInvokerElement.setPopoverTargetElement")
+	args := newArgumentHelper(e.scriptHost, info)
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.PopoverInvokerElement)
+	if !ok || !e.isIncluder(instance) {
+		return nil, v8.NewTypeError(e.scriptHost.iso, e.interfaceName+".setPopoverTargetElement: Illegal invocation")
+	}
+	val, err1 := tryParseNullableArg(args, 0, e.decodeElement)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetPopoverTargetElement(val)
+		return nil, nil
+	}
+	return nil, errors.New("PopoverInvokerElement.setPopoverTargetElement: Missing arguments")
+}
+
+func (e popoverInvokerElementV8Wrapper) popoverTargetAction(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := e.mustGetContext(info)
+	log.Debug("V8 Function call: PopoverInvokerElement.popoverTargetAction")
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.PopoverInvokerElement)
+	if !ok || !e.isIncluder(instance) {
+		return nil, v8.NewTypeError(e.scriptHost.iso, e.interfaceName+".popoverTargetAction: Illegal invocation")
+	}
+	result := instance.PopoverTargetAction()
+	return e.toDOMString(ctx, result)
+}
+
+func (e popoverInvokerElementV8Wrapper) setPopoverTargetAction(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: PopoverInvokerElement.setPopoverTargetAction")
+	args := newArgumentHelper(e.scriptHost, info)
+	wrapped, err := e.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.PopoverInvokerElement)
+	if !ok || !e.isIncluder(instance) {
+		return nil, v8.NewTypeError(e.scriptHost.iso, e.interfaceName+".setPopoverTargetAction: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, e.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetPopoverTargetAction(val)
+		return nil, nil
+	}
+	return nil, errors.New("PopoverInvokerElement.setPopoverTargetAction: Missing arguments")
+}
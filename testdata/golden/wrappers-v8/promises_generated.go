@@ -0,0 +1,70 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import v8 "github.com/tommie/v8go"
+
+// toPromise returns an encoder converting a function to a JS promise, settled
+// with the result of the function encoded using encode.
+func toPromise[T any](encode func(*V8ScriptContext, T) (*v8.Value, error)) func(*V8ScriptContext, func() (T, error)) (*v8.Value, error) {
+	return func(ctx *V8ScriptContext, f func() (T, error)) (*v8.Value, error) {
+		resolver, err := v8.NewPromiseResolver(ctx.v8ctx)
+		if err != nil {
+			return nil, err
+		}
+		go func() {
+			value, err := f()
+			ctx.queueTask(func() {
+				if err == nil {
+					var encoded *v8.Value
+					if encoded, err = encode(ctx, value); err == nil {
+						resolver.Resolve(encoded)
+						return
+					}
+				}
+				rejectPromise(ctx, resolver, err)
+			})
+		}()
+		return resolver.GetPromise().Value, nil
+	}
+}
+
+// toVoidPromise converts a function without a result to a JS promise, resolved
+// with undefined.
+func toVoidPromise(ctx *V8ScriptContext, f func() error) (*v8.Value, error) {
+	encode := func(ctx *V8ScriptContext, _ struct{}) (*v8.Value, error) {
+		return v8.Undefined(ctx.host.iso), nil
+	}
+	return toPromise(encode)(ctx, func() (struct{}, error) {
+		return struct{}{}, f()
+	})
+}
+
+// rejectPromise rejects the promise with an Error with the message of reason. If
+// the Error can't be created, the promise is rejected with the message.
+func rejectPromise(ctx *V8ScriptContext, resolver *v8.PromiseResolver, reason error) {
+	// Converting a string doesn't fail
+	message, _ := v8.NewValue(ctx.host.iso, reason.Error())
+	if errorValue, err := newError(ctx, message); err == nil {
+		resolver.Reject(errorValue)
+		return
+	}
+	resolver.Reject(message)
+}
+
+// newError creates a JS Error with the message.
+func newError(ctx *V8ScriptContext, message *v8.Value) (*v8.Value, error) {
+	errorCtor, err := ctx.v8ctx.Global().Get("Error")
+	if err != nil {
+		return nil, err
+	}
+	constructor, err := errorCtor.AsFunction()
+	if err != nil {
+		return nil, err
+	}
+	errorValue, err := constructor.NewInstance(message)
+	if err != nil {
+		return nil, err
+	}
+	return errorValue.Value, nil
+}
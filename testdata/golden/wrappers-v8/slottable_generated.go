@@ -0,0 +1,47 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	dom "github.com/gost-dom/browser/dom"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+type slottableV8Wrapper struct {
+	nodeV8WrapperBase[dom.Slottable]
+	interfaceName string
+}
+
+func newSlottableV8Wrapper(scriptHost *V8ScriptHost, interfaceName string) *slottableV8Wrapper {
+	return &slottableV8Wrapper{newNodeV8WrapperBase[dom.Slottable](scriptHost), interfaceName}
+}
+
+// isIncluder returns whether the value implements the interface including
+// the mixin, named by interfaceName.
+func (s slottableV8Wrapper) isIncluder(value any) bool {
+	switch s.interfaceName {
+	case "Element":
+		_, ok := value.(dom.Element)
+		return ok
+	}
+	return false
+}
+
+func (s slottableV8Wrapper) assignedSlot(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := s.mustGetContext(info)
+	log.Debug("V8 Function call: Slottable.assignedSlot")
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(dom.Slottable)
+	if !ok || !s.isIncluder(instance) {
+		return nil, v8.NewTypeError(s.scriptHost.iso, s.interfaceName+".assignedSlot: Illegal invocation")
+	}
+	result := instance.AssignedSlot()
+	if result == nil {
+		return v8.Null(s.scriptHost.iso), nil
+	}
+	return ctx.getInstanceForNode(result)
+}
@@ -0,0 +1,314 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	html "github.com/gost-dom/browser/html"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+func createUrlPrototype(scriptHost *V8ScriptHost) *v8.FunctionTemplate {
+	iso := scriptHost.iso
+	wrapper := newUrlV8Wrapper(scriptHost)
+	constructor := v8.NewFunctionTemplateWithError(iso, wrapper.Constructor)
+
+	instanceTmpl := constructor.InstanceTemplate()
+	instanceTmpl.SetInternalFieldCount(1)
+
+	prototypeTmpl := constructor.PrototypeTemplate()
+	prototypeTmpl.Set("toJSON", v8.NewFunctionTemplateWithError(iso, wrapper.toJSON))
+	prototypeTmpl.Set("toString", v8.NewFunctionTemplateWithError(iso, wrapper.href))
+
+	prototypeTmpl.SetAccessorProperty("href",
+		v8.NewFunctionTemplateWithError(iso, wrapper.href),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setHref),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("origin",
+		v8.NewFunctionTemplateWithError(iso, wrapper.origin),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("protocol",
+		v8.NewFunctionTemplateWithError(iso, wrapper.protocol),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setProtocol),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("username",
+		v8.NewFunctionTemplateWithError(iso, wrapper.username),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setUsername),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("password",
+		v8.NewFunctionTemplateWithError(iso, wrapper.password),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setPassword),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("host",
+		v8.NewFunctionTemplateWithError(iso, wrapper.host),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setHost),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("hostname",
+		v8.NewFunctionTemplateWithError(iso, wrapper.hostname),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setHostname),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("port",
+		v8.NewFunctionTemplateWithError(iso, wrapper.port),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setPort),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("pathname",
+		v8.NewFunctionTemplateWithError(iso, wrapper.pathname),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setPathname),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("search",
+		v8.NewFunctionTemplateWithError(iso, wrapper.search),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setSearch),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("searchParams",
+		v8.NewFunctionTemplateWithError(iso, wrapper.searchParams),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("hash",
+		v8.NewFunctionTemplateWithError(iso, wrapper.hash),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setHash),
+		v8.None)
+
+	return constructor
+}
+
+func (u urlV8Wrapper) Constructor(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	args := newArgumentHelper(u.scriptHost, info)
+	url, err1 := tryParseArg(args, 0, u.decodeUSVString)
+	base, err2 := tryParseArg(args, 1, u.decodeUSVString)
+	ctx := u.mustGetContext(info)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		return u.CreateInstanceBase(ctx, info.This(), url, base)
+	}
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		return u.CreateInstance(ctx, info.This(), url)
+	}
+	return nil, errors.New("URL.constructor: Missing arguments")
+}
+
+func (u urlV8Wrapper) toJSON(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: URL.toJSON")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.URL)
+	if !ok {
+		return nil, v8.NewTypeError(u.scriptHost.iso, "URL.toJSON: Illegal invocation")
+	}
+	result, callErr := instance.ToJSON()
+	if callErr != nil {
+		return nil, mapError(u.scriptHost, callErr)
+	} else {
+		return u.toUSVString(ctx, result)
+	}
+}
+
+func (u urlV8Wrapper) href(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: URL.href")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.URL)
+	if !ok {
+		return nil, v8.NewTypeError(u.scriptHost.iso, "URL.href: Illegal invocation")
+	}
+	result := instance.Href()
+	return u.toUSVString(ctx, result)
+}
+
+func (u urlV8Wrapper) setHref(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: URL.setHref")
+	return nil, notImplemented("URL", "setHref")
+}
+
+func (u urlV8Wrapper) origin(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: URL.origin")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.URL)
+	if !ok {
+		return nil, v8.NewTypeError(u.scriptHost.iso, "URL.origin: Illegal invocation")
+	}
+	result := instance.Origin()
+	return u.toUSVString(ctx, result)
+}
+
+func (u urlV8Wrapper) protocol(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: URL.protocol")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.URL)
+	if !ok {
+		return nil, v8.NewTypeError(u.scriptHost.iso, "URL.protocol: Illegal invocation")
+	}
+	result := instance.Protocol()
+	return u.toUSVString(ctx, result)
+}
+
+func (u urlV8Wrapper) setProtocol(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: URL.setProtocol")
+	return nil, notImplemented("URL", "setProtocol")
+}
+
+func (u urlV8Wrapper) username(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: URL.username")
+	return nil, notImplemented("URL", "username")
+}
+
+func (u urlV8Wrapper) setUsername(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: URL.setUsername")
+	return nil, notImplemented("URL", "setUsername")
+}
+
+func (u urlV8Wrapper) password(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: URL.password")
+	return nil, notImplemented("URL", "password")
+}
+
+func (u urlV8Wrapper) setPassword(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: URL.setPassword")
+	return nil, notImplemented("URL", "setPassword")
+}
+
+func (u urlV8Wrapper) host(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: URL.host")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.URL)
+	if !ok {
+		return nil, v8.NewTypeError(u.scriptHost.iso, "URL.host: Illegal invocation")
+	}
+	result := instance.Host()
+	return u.toUSVString(ctx, result)
+}
+
+func (u urlV8Wrapper) setHost(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: URL.setHost")
+	return nil, notImplemented("URL", "setHost")
+}
+
+func (u urlV8Wrapper) hostname(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: URL.hostname")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.URL)
+	if !ok {
+		return nil, v8.NewTypeError(u.scriptHost.iso, "URL.hostname: Illegal invocation")
+	}
+	result := instance.Hostname()
+	return u.toUSVString(ctx, result)
+}
+
+func (u urlV8Wrapper) setHostname(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: URL.setHostname")
+	return nil, notImplemented("URL", "setHostname")
+}
+
+func (u urlV8Wrapper) port(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: URL.port")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.URL)
+	if !ok {
+		return nil, v8.NewTypeError(u.scriptHost.iso, "URL.port: Illegal invocation")
+	}
+	result := instance.Port()
+	return u.toUSVString(ctx, result)
+}
+
+func (u urlV8Wrapper) setPort(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: URL.setPort")
+	return nil, notImplemented("URL", "setPort")
+}
+
+func (u urlV8Wrapper) pathname(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: URL.pathname")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.URL)
+	if !ok {
+		return nil, v8.NewTypeError(u.scriptHost.iso, "URL.pathname: Illegal invocation")
+	}
+	result := instance.Pathname()
+	return u.toUSVString(ctx, result)
+}
+
+func (u urlV8Wrapper) setPathname(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: URL.setPathname")
+	return nil, notImplemented("URL", "setPathname")
+}
+
+func (u urlV8Wrapper) search(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: URL.search")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.URL)
+	if !ok {
+		return nil, v8.NewTypeError(u.scriptHost.iso, "URL.search: Illegal invocation")
+	}
+	result := instance.Search()
+	return u.toUSVString(ctx, result)
+}
+
+func (u urlV8Wrapper) setSearch(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: URL.setSearch")
+	return nil, notImplemented("URL", "setSearch")
+}
+
+func (u urlV8Wrapper) searchParams(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: URL.searchParams")
+	return nil, notImplemented("URL", "searchParams")
+}
+
+func (u urlV8Wrapper) hash(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := u.mustGetContext(info)
+	log.Debug("V8 Function call: URL.hash")
+	wrapped, err := u.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.URL)
+	if !ok {
+		return nil, v8.NewTypeError(u.scriptHost.iso, "URL.hash: Illegal invocation")
+	}
+	result := instance.Hash()
+	return u.toUSVString(ctx, result)
+}
+
+func (u urlV8Wrapper) setHash(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: URL.setHash")
+	return nil, notImplemented("URL", "setHash")
+}
@@ -0,0 +1,67 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	html "github.com/gost-dom/browser/html"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+type windowEventHandlersV8Wrapper struct {
+	handleReffedObject[html.WindowEventHandlers]
+	interfaceName string
+}
+
+func newWindowEventHandlersV8Wrapper(scriptHost *V8ScriptHost, interfaceName string) *windowEventHandlersV8Wrapper {
+	return &windowEventHandlersV8Wrapper{newHandleReffedObject[html.WindowEventHandlers](scriptHost), interfaceName}
+}
+
+// isIncluder returns whether the value implements the interface including
+// the mixin, named by interfaceName.
+func (h windowEventHandlersV8Wrapper) isIncluder(value any) bool {
+	switch h.interfaceName {
+	case "Window":
+		_, ok := value.(html.Window)
+		return ok
+	}
+	return false
+}
+
+func (h windowEventHandlersV8Wrapper) onbeforeunload(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := h.mustGetContext(info)
+	log.Debug("V8 Function call: WindowEventHandlers.onbeforeunload")
+	wrapped, err := h.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.WindowEventHandlers)
+	if !ok || !h.isIncluder(instance) {
+		return nil, v8.NewTypeError(h.scriptHost.iso, h.interfaceName+".onbeforeunload: Illegal invocation")
+	}
+	result := instance.Onbeforeunload()
+	return h.toOnBeforeUnloadEventHandler(ctx, result)
+}
+
+func (h windowEventHandlersV8Wrapper) setOnbeforeunload(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: WindowEventHandlers.setOnbeforeunload")
+	args := newArgumentHelper(h.scriptHost, info)
+	wrapped, err := h.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.WindowEventHandlers)
+	if !ok || !h.isIncluder(instance) {
+		return nil, v8.NewTypeError(h.scriptHost.iso, h.interfaceName+".setOnbeforeunload: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, h.decodeOnBeforeUnloadEventHandler)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetOnbeforeunload(val)
+		return nil, nil
+	}
+	return nil, errors.New("WindowEventHandlers.setOnbeforeunload: Missing arguments")
+}
@@ -0,0 +1,363 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	html "github.com/gost-dom/browser/html"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+type windowV8Wrapper struct {
+	nodeV8WrapperBase[html.Window]
+}
+
+func newWindowV8Wrapper(scriptHost *V8ScriptHost) *windowV8Wrapper {
+	return &windowV8Wrapper{newNodeV8WrapperBase[html.Window](scriptHost)}
+}
+
+func createWindowPrototype(scriptHost *V8ScriptHost) *v8.FunctionTemplate {
+	iso := scriptHost.iso
+	wrapper := newWindowV8Wrapper(scriptHost)
+	globalEventHandlersWrapper := newGlobalEventHandlersV8Wrapper(scriptHost, "Window")
+	windowEventHandlersWrapper := newWindowEventHandlersV8Wrapper(scriptHost, "Window")
+	windowOrWorkerGlobalScopeWrapper := newWindowOrWorkerGlobalScopeV8Wrapper(scriptHost, "Window")
+	animationFrameProviderWrapper := newAnimationFrameProviderV8Wrapper(scriptHost, "Window")
+	windowSessionStorageWrapper := newWindowSessionStorageV8Wrapper(scriptHost, "Window")
+	windowLocalStorageWrapper := newWindowLocalStorageV8Wrapper(scriptHost, "Window")
+	constructor := v8.NewFunctionTemplateWithError(iso, wrapper.Constructor)
+
+	instanceTmpl := constructor.InstanceTemplate()
+	instanceTmpl.SetInternalFieldCount(1)
+
+	prototypeTmpl := constructor.PrototypeTemplate()
+	prototypeTmpl.Set("close", v8.NewFunctionTemplateWithError(iso, wrapper.close))
+	prototypeTmpl.Set("stop", v8.NewFunctionTemplateWithError(iso, wrapper.stop))
+	prototypeTmpl.Set("focus", v8.NewFunctionTemplateWithError(iso, wrapper.focus))
+	prototypeTmpl.Set("blur", v8.NewFunctionTemplateWithError(iso, wrapper.blur))
+	prototypeTmpl.Set("open", v8.NewFunctionTemplateWithError(iso, wrapper.open))
+	prototypeTmpl.Set("alert", v8.NewFunctionTemplateWithError(iso, wrapper.alert))
+	prototypeTmpl.Set("confirm", v8.NewFunctionTemplateWithError(iso, wrapper.confirm))
+	prototypeTmpl.Set("prompt", v8.NewFunctionTemplateWithError(iso, wrapper.prompt))
+	prototypeTmpl.Set("print", v8.NewFunctionTemplateWithError(iso, wrapper.print))
+	prototypeTmpl.Set("postMessage", v8.NewFunctionTemplateWithError(iso, wrapper.postMessage))
+	prototypeTmpl.Set("reportError", v8.NewFunctionTemplateWithError(iso, windowOrWorkerGlobalScopeWrapper.reportError))
+	prototypeTmpl.Set("btoa", v8.NewFunctionTemplateWithError(iso, windowOrWorkerGlobalScopeWrapper.btoa))
+	prototypeTmpl.Set("atob", v8.NewFunctionTemplateWithError(iso, windowOrWorkerGlobalScopeWrapper.atob))
+	prototypeTmpl.Set("setTimeout", v8.NewFunctionTemplateWithError(iso, windowOrWorkerGlobalScopeWrapper.setTimeout))
+	prototypeTmpl.Set("clearTimeout", v8.NewFunctionTemplateWithError(iso, windowOrWorkerGlobalScopeWrapper.clearTimeout))
+	prototypeTmpl.Set("setInterval", v8.NewFunctionTemplateWithError(iso, windowOrWorkerGlobalScopeWrapper.setInterval))
+	prototypeTmpl.Set("clearInterval", v8.NewFunctionTemplateWithError(iso, windowOrWorkerGlobalScopeWrapper.clearInterval))
+	prototypeTmpl.Set("queueMicrotask", v8.NewFunctionTemplateWithError(iso, windowOrWorkerGlobalScopeWrapper.queueMicrotask))
+	prototypeTmpl.Set("createImageBitmap", v8.NewFunctionTemplateWithError(iso, windowOrWorkerGlobalScopeWrapper.createImageBitmap))
+	prototypeTmpl.Set("structuredClone", v8.NewFunctionTemplateWithError(iso, windowOrWorkerGlobalScopeWrapper.structuredClone))
+	prototypeTmpl.Set("requestAnimationFrame", v8.NewFunctionTemplateWithError(iso, animationFrameProviderWrapper.requestAnimationFrame))
+	prototypeTmpl.Set("cancelAnimationFrame", v8.NewFunctionTemplateWithError(iso, animationFrameProviderWrapper.cancelAnimationFrame))
+
+	prototypeTmpl.SetAccessorProperty("window",
+		v8.NewFunctionTemplateWithError(iso, wrapper.window),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("self",
+		v8.NewFunctionTemplateWithError(iso, wrapper.self),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("document",
+		v8.NewFunctionTemplateWithError(iso, wrapper.document),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("name",
+		v8.NewFunctionTemplateWithError(iso, wrapper.name),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setName),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("history",
+		v8.NewFunctionTemplateWithError(iso, wrapper.history),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("navigation",
+		v8.NewFunctionTemplateWithError(iso, wrapper.navigation),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("customElements",
+		v8.NewFunctionTemplateWithError(iso, wrapper.customElements),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("locationbar",
+		v8.NewFunctionTemplateWithError(iso, wrapper.locationbar),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("menubar",
+		v8.NewFunctionTemplateWithError(iso, wrapper.menubar),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("personalbar",
+		v8.NewFunctionTemplateWithError(iso, wrapper.personalbar),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("scrollbars",
+		v8.NewFunctionTemplateWithError(iso, wrapper.scrollbars),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("statusbar",
+		v8.NewFunctionTemplateWithError(iso, wrapper.statusbar),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("toolbar",
+		v8.NewFunctionTemplateWithError(iso, wrapper.toolbar),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("status",
+		v8.NewFunctionTemplateWithError(iso, wrapper.status),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setStatus),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("closed",
+		v8.NewFunctionTemplateWithError(iso, wrapper.closed),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("frames",
+		v8.NewFunctionTemplateWithError(iso, wrapper.frames),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("length",
+		v8.NewFunctionTemplateWithError(iso, wrapper.length),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("top",
+		v8.NewFunctionTemplateWithError(iso, wrapper.top),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("opener",
+		v8.NewFunctionTemplateWithError(iso, wrapper.opener),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setOpener),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("frameElement",
+		v8.NewFunctionTemplateWithError(iso, wrapper.frameElement),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("navigator",
+		v8.NewFunctionTemplateWithError(iso, wrapper.navigator),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("clientInformation",
+		v8.NewFunctionTemplateWithError(iso, wrapper.clientInformation),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("originAgentCluster",
+		v8.NewFunctionTemplateWithError(iso, wrapper.originAgentCluster),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("onerror",
+		v8.NewFunctionTemplateWithError(iso, globalEventHandlersWrapper.onerror),
+		v8.NewFunctionTemplateWithError(iso, globalEventHandlersWrapper.setOnerror),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("onbeforeunload",
+		v8.NewFunctionTemplateWithError(iso, windowEventHandlersWrapper.onbeforeunload),
+		v8.NewFunctionTemplateWithError(iso, windowEventHandlersWrapper.setOnbeforeunload),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("origin",
+		v8.NewFunctionTemplateWithError(iso, windowOrWorkerGlobalScopeWrapper.origin),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("isSecureContext",
+		v8.NewFunctionTemplateWithError(iso, windowOrWorkerGlobalScopeWrapper.isSecureContext),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("crossOriginIsolated",
+		v8.NewFunctionTemplateWithError(iso, windowOrWorkerGlobalScopeWrapper.crossOriginIsolated),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("sessionStorage",
+		v8.NewFunctionTemplateWithError(iso, windowSessionStorageWrapper.sessionStorage),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("localStorage",
+		v8.NewFunctionTemplateWithError(iso, windowLocalStorageWrapper.localStorage),
+		nil,
+		v8.None)
+
+	return constructor
+}
+
+func (w windowV8Wrapper) Constructor(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	return nil, v8.NewTypeError(w.scriptHost.iso, "Illegal Constructor")
+}
+
+func (w windowV8Wrapper) close(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.close")
+	return nil, notImplemented("Window", "close")
+}
+
+func (w windowV8Wrapper) stop(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.stop")
+	return nil, notImplemented("Window", "stop")
+}
+
+func (w windowV8Wrapper) focus(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.focus")
+	return nil, notImplemented("Window", "focus")
+}
+
+func (w windowV8Wrapper) blur(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.blur")
+	return nil, notImplemented("Window", "blur")
+}
+
+func (w windowV8Wrapper) open(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.open")
+	return nil, notImplemented("Window", "open")
+}
+
+func (w windowV8Wrapper) alert(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.alert")
+	return nil, notImplemented("Window", "alert")
+}
+
+func (w windowV8Wrapper) confirm(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.confirm")
+	return nil, notImplemented("Window", "confirm")
+}
+
+func (w windowV8Wrapper) prompt(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.prompt")
+	return nil, notImplemented("Window", "prompt")
+}
+
+func (w windowV8Wrapper) print(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.print")
+	return nil, notImplemented("Window", "print")
+}
+
+func (w windowV8Wrapper) postMessage(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.postMessage")
+	return nil, notImplemented("Window", "postMessage")
+}
+
+func (w windowV8Wrapper) self(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.self")
+	return nil, notImplemented("Window", "self")
+}
+
+func (w windowV8Wrapper) document(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := w.mustGetContext(info)
+	log.Debug("V8 Function call: Window.document")
+	wrapped, err := w.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.Window)
+	if !ok {
+		return nil, v8.NewTypeError(w.scriptHost.iso, "Window.document: Illegal invocation")
+	}
+	result := instance.Document()
+	return ctx.getInstanceForNode(result)
+}
+
+func (w windowV8Wrapper) name(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.name")
+	return nil, notImplemented("Window", "name")
+}
+
+func (w windowV8Wrapper) setName(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.setName")
+	return nil, notImplemented("Window", "setName")
+}
+
+func (w windowV8Wrapper) navigation(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.navigation")
+	return nil, notImplemented("Window", "navigation")
+}
+
+func (w windowV8Wrapper) customElements(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.customElements")
+	return nil, notImplemented("Window", "customElements")
+}
+
+func (w windowV8Wrapper) locationbar(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.locationbar")
+	return nil, notImplemented("Window", "locationbar")
+}
+
+func (w windowV8Wrapper) menubar(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.menubar")
+	return nil, notImplemented("Window", "menubar")
+}
+
+func (w windowV8Wrapper) personalbar(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.personalbar")
+	return nil, notImplemented("Window", "personalbar")
+}
+
+func (w windowV8Wrapper) scrollbars(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.scrollbars")
+	return nil, notImplemented("Window", "scrollbars")
+}
+
+func (w windowV8Wrapper) statusbar(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.statusbar")
+	return nil, notImplemented("Window", "statusbar")
+}
+
+func (w windowV8Wrapper) toolbar(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.toolbar")
+	return nil, notImplemented("Window", "toolbar")
+}
+
+func (w windowV8Wrapper) status(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.status")
+	return nil, notImplemented("Window", "status")
+}
+
+func (w windowV8Wrapper) setStatus(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.setStatus")
+	return nil, notImplemented("Window", "setStatus")
+}
+
+func (w windowV8Wrapper) closed(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.closed")
+	return nil, notImplemented("Window", "closed")
+}
+
+func (w windowV8Wrapper) frames(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.frames")
+	return nil, notImplemented("Window", "frames")
+}
+
+func (w windowV8Wrapper) length(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.length")
+	return nil, notImplemented("Window", "length")
+}
+
+func (w windowV8Wrapper) top(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.top")
+	return nil, notImplemented("Window", "top")
+}
+
+func (w windowV8Wrapper) opener(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.opener")
+	return nil, notImplemented("Window", "opener")
+}
+
+func (w windowV8Wrapper) setOpener(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.setOpener")
+	return nil, notImplemented("Window", "setOpener")
+}
+
+func (w windowV8Wrapper) frameElement(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.frameElement")
+	return nil, notImplemented("Window", "frameElement")
+}
+
+func (w windowV8Wrapper) navigator(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.navigator")
+	return nil, notImplemented("Window", "navigator")
+}
+
+func (w windowV8Wrapper) clientInformation(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.clientInformation")
+	return nil, notImplemented("Window", "clientInformation")
+}
+
+func (w windowV8Wrapper) originAgentCluster(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: Window.originAgentCluster")
+	return nil, notImplemented("Window", "originAgentCluster")
+}
@@ -0,0 +1,44 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	html "github.com/gost-dom/browser/html"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+type windowLocalStorageV8Wrapper struct {
+	handleReffedObject[html.WindowLocalStorage]
+	interfaceName string
+}
+
+func newWindowLocalStorageV8Wrapper(scriptHost *V8ScriptHost, interfaceName string) *windowLocalStorageV8Wrapper {
+	return &windowLocalStorageV8Wrapper{newHandleReffedObject[html.WindowLocalStorage](scriptHost), interfaceName}
+}
+
+// isIncluder returns whether the value implements the interface including
+// the mixin, named by interfaceName.
+func (s windowLocalStorageV8Wrapper) isIncluder(value any) bool {
+	switch s.interfaceName {
+	case "Window":
+		_, ok := value.(html.Window)
+		return ok
+	}
+	return false
+}
+
+func (s windowLocalStorageV8Wrapper) localStorage(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := s.mustGetContext(info)
+	log.Debug("V8 Function call: WindowLocalStorage.localStorage")
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.WindowLocalStorage)
+	if !ok || !s.isIncluder(instance) {
+		return nil, v8.NewTypeError(s.scriptHost.iso, s.interfaceName+".localStorage: Illegal invocation")
+	}
+	result := instance.LocalStorage()
+	return s.toStorage(ctx, result)
+}
@@ -0,0 +1,423 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	html "github.com/gost-dom/browser/html"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+type windowOrWorkerGlobalScopeV8Wrapper struct {
+	handleReffedObject[html.WindowOrWorkerGlobalScope]
+	interfaceName string
+}
+
+func newWindowOrWorkerGlobalScopeV8Wrapper(scriptHost *V8ScriptHost, interfaceName string) *windowOrWorkerGlobalScopeV8Wrapper {
+	return &windowOrWorkerGlobalScopeV8Wrapper{newHandleReffedObject[html.WindowOrWorkerGlobalScope](scriptHost), interfaceName}
+}
+
+// isIncluder returns whether the value implements the interface including
+// the mixin, named by interfaceName.
+func (s windowOrWorkerGlobalScopeV8Wrapper) isIncluder(value any) bool {
+	switch s.interfaceName {
+	case "Window":
+		_, ok := value.(html.Window)
+		return ok
+	}
+	return false
+}
+
+func (s windowOrWorkerGlobalScopeV8Wrapper) reportError(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: WindowOrWorkerGlobalScope.reportError")
+	args := newArgumentHelper(s.scriptHost, info)
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.WindowOrWorkerGlobalScope)
+	if !ok || !s.isIncluder(instance) {
+		return nil, v8.NewTypeError(s.scriptHost.iso, s.interfaceName+".reportError: Illegal invocation")
+	}
+	e, err1 := tryParseArg(args, 0, s.decodeAny)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		callErr := instance.ReportError(e)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	return nil, errors.New("WindowOrWorkerGlobalScope.reportError: Missing arguments")
+}
+
+func (s windowOrWorkerGlobalScopeV8Wrapper) btoa(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := s.mustGetContext(info)
+	log.Debug("V8 Function call: WindowOrWorkerGlobalScope.btoa")
+	args := newArgumentHelper(s.scriptHost, info)
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.WindowOrWorkerGlobalScope)
+	if !ok || !s.isIncluder(instance) {
+		return nil, v8.NewTypeError(s.scriptHost.iso, s.interfaceName+".btoa: Illegal invocation")
+	}
+	data, err1 := tryParseArg(args, 0, s.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.Btoa(data)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		} else {
+			return s.toDOMString(ctx, result)
+		}
+	}
+	return nil, errors.New("WindowOrWorkerGlobalScope.btoa: Missing arguments")
+}
+
+func (s windowOrWorkerGlobalScopeV8Wrapper) atob(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := s.mustGetContext(info)
+	log.Debug("V8 Function call: WindowOrWorkerGlobalScope.atob")
+	args := newArgumentHelper(s.scriptHost, info)
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.WindowOrWorkerGlobalScope)
+	if !ok || !s.isIncluder(instance) {
+		return nil, v8.NewTypeError(s.scriptHost.iso, s.interfaceName+".atob: Illegal invocation")
+	}
+	data, err1 := tryParseArg(args, 0, s.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.Atob(data)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		} else {
+			return s.toByteString(ctx, result)
+		}
+	}
+	return nil, errors.New("WindowOrWorkerGlobalScope.atob: Missing arguments")
+}
+
+func (s windowOrWorkerGlobalScopeV8Wrapper) setTimeout(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := s.mustGetContext(info)
+	log.Debug("V8 Function call: WindowOrWorkerGlobalScope.setTimeout")
+	args := newArgumentHelper(s.scriptHost, info)
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.WindowOrWorkerGlobalScope)
+	if !ok || !s.isIncluder(instance) {
+		return nil, v8.NewTypeError(s.scriptHost.iso, s.interfaceName+".setTimeout: Illegal invocation")
+	}
+	handler, err1 := tryParseArg(args, 0, s.decodeTimerHandler)
+	timeout, err2 := tryParseArg(args, 1, decodeIDLLong)
+	arguments, err3 := tryParseVariadicArg(args, 2, s.decodeAny)
+	if args.noOfReadArguments >= 3 {
+		err := errors.Join(err1, err2, err3)
+		if err != nil {
+			return nil, err
+		}
+		result, callErr := instance.SetTimeoutTimeout(handler, timeout, arguments...)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		} else {
+			return s.toLong(ctx, result)
+		}
+	}
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		result, callErr := instance.SetTimeoutTimeout(handler, timeout)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		} else {
+			return s.toLong(ctx, result)
+		}
+	}
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.SetTimeout(handler)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		} else {
+			return s.toLong(ctx, result)
+		}
+	}
+	return nil, errors.New("WindowOrWorkerGlobalScope.setTimeout: Missing arguments")
+}
+
+func (s windowOrWorkerGlobalScopeV8Wrapper) clearTimeout(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: WindowOrWorkerGlobalScope.clearTimeout")
+	args := newArgumentHelper(s.scriptHost, info)
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.WindowOrWorkerGlobalScope)
+	if !ok || !s.isIncluder(instance) {
+		return nil, v8.NewTypeError(s.scriptHost.iso, s.interfaceName+".clearTimeout: Illegal invocation")
+	}
+	id, err1 := tryParseArg(args, 0, decodeIDLLong)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		callErr := instance.ClearTimeoutId(id)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	callErr := instance.ClearTimeout()
+	if callErr != nil {
+		return nil, mapError(s.scriptHost, callErr)
+	}
+	return nil, nil
+}
+
+func (s windowOrWorkerGlobalScopeV8Wrapper) setInterval(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := s.mustGetContext(info)
+	log.Debug("V8 Function call: WindowOrWorkerGlobalScope.setInterval")
+	args := newArgumentHelper(s.scriptHost, info)
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.WindowOrWorkerGlobalScope)
+	if !ok || !s.isIncluder(instance) {
+		return nil, v8.NewTypeError(s.scriptHost.iso, s.interfaceName+".setInterval: Illegal invocation")
+	}
+	handler, err1 := tryParseArg(args, 0, s.decodeTimerHandler)
+	timeout, err2 := tryParseArg(args, 1, decodeIDLLong)
+	arguments, err3 := tryParseVariadicArg(args, 2, s.decodeAny)
+	if args.noOfReadArguments >= 3 {
+		err := errors.Join(err1, err2, err3)
+		if err != nil {
+			return nil, err
+		}
+		result, callErr := instance.SetIntervalTimeout(handler, timeout, arguments...)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		} else {
+			return s.toLong(ctx, result)
+		}
+	}
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		result, callErr := instance.SetIntervalTimeout(handler, timeout)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		} else {
+			return s.toLong(ctx, result)
+		}
+	}
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.SetInterval(handler)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		} else {
+			return s.toLong(ctx, result)
+		}
+	}
+	return nil, errors.New("WindowOrWorkerGlobalScope.setInterval: Missing arguments")
+}
+
+func (s windowOrWorkerGlobalScopeV8Wrapper) clearInterval(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: WindowOrWorkerGlobalScope.clearInterval")
+	args := newArgumentHelper(s.scriptHost, info)
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.WindowOrWorkerGlobalScope)
+	if !ok || !s.isIncluder(instance) {
+		return nil, v8.NewTypeError(s.scriptHost.iso, s.interfaceName+".clearInterval: Illegal invocation")
+	}
+	id, err1 := tryParseArg(args, 0, decodeIDLLong)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		callErr := instance.ClearIntervalId(id)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	callErr := instance.ClearInterval()
+	if callErr != nil {
+		return nil, mapError(s.scriptHost, callErr)
+	}
+	return nil, nil
+}
+
+func (s windowOrWorkerGlobalScopeV8Wrapper) queueMicrotask(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: WindowOrWorkerGlobalScope.queueMicrotask")
+	args := newArgumentHelper(s.scriptHost, info)
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.WindowOrWorkerGlobalScope)
+	if !ok || !s.isIncluder(instance) {
+		return nil, v8.NewTypeError(s.scriptHost.iso, s.interfaceName+".queueMicrotask: Illegal invocation")
+	}
+	callback, err1 := tryParseArg(args, 0, s.decodeVoidFunction)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		callErr := instance.QueueMicrotask(callback)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	return nil, errors.New("WindowOrWorkerGlobalScope.queueMicrotask: Missing arguments")
+}
+
+func (s windowOrWorkerGlobalScopeV8Wrapper) createImageBitmap(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := s.mustGetContext(info)
+	log.Debug("V8 Function call: WindowOrWorkerGlobalScope.createImageBitmap")
+	args := newArgumentHelper(s.scriptHost, info)
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.WindowOrWorkerGlobalScope)
+	if !ok || !s.isIncluder(instance) {
+		return nil, v8.NewTypeError(s.scriptHost.iso, s.interfaceName+".createImageBitmap: Illegal invocation")
+	}
+	image, err1 := tryParseArg(args, 0, s.decodeImageBitmapSource)
+	options, err2 := tryParseArg(args, 1, s.decodeImageBitmapOptions)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		result, callErr := instance.CreateImageBitmapOptions(image, options)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		} else {
+			return toPromise(s.toImageBitmap)(ctx, result)
+		}
+	}
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.CreateImageBitmap(image)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		} else {
+			return toPromise(s.toImageBitmap)(ctx, result)
+		}
+	}
+	return nil, errors.New("WindowOrWorkerGlobalScope.createImageBitmap: Missing arguments")
+}
+
+func (s windowOrWorkerGlobalScopeV8Wrapper) structuredClone(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := s.mustGetContext(info)
+	log.Debug("V8 Function call: WindowOrWorkerGlobalScope.structuredClone")
+	args := newArgumentHelper(s.scriptHost, info)
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.WindowOrWorkerGlobalScope)
+	if !ok || !s.isIncluder(instance) {
+		return nil, v8.NewTypeError(s.scriptHost.iso, s.interfaceName+".structuredClone: Illegal invocation")
+	}
+	value, err1 := tryParseArg(args, 0, s.decodeAny)
+	options, err2 := tryParseArg(args, 1, s.decodeStructuredSerializeOptions)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		result, callErr := instance.StructuredCloneOptions(value, options)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		} else {
+			return s.toAny(ctx, result)
+		}
+	}
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result, callErr := instance.StructuredClone(value)
+		if callErr != nil {
+			return nil, mapError(s.scriptHost, callErr)
+		} else {
+			return s.toAny(ctx, result)
+		}
+	}
+	return nil, errors.New("WindowOrWorkerGlobalScope.structuredClone: Missing arguments")
+}
+
+func (s windowOrWorkerGlobalScopeV8Wrapper) origin(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := s.mustGetContext(info)
+	log.Debug("V8 Function call: WindowOrWorkerGlobalScope.origin")
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.WindowOrWorkerGlobalScope)
+	if !ok || !s.isIncluder(instance) {
+		return nil, v8.NewTypeError(s.scriptHost.iso, s.interfaceName+".origin: Illegal invocation")
+	}
+	result := instance.Origin()
+	return s.toUSVString(ctx, result)
+}
+
+func (s windowOrWorkerGlobalScopeV8Wrapper) isSecureContext(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := s.mustGetContext(info)
+	log.Debug("V8 Function call: WindowOrWorkerGlobalScope.isSecureContext")
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.WindowOrWorkerGlobalScope)
+	if !ok || !s.isIncluder(instance) {
+		return nil, v8.NewTypeError(s.scriptHost.iso, s.interfaceName+".isSecureContext: Illegal invocation")
+	}
+	result := instance.IsSecureContext()
+	return s.toBoolean(ctx, result)
+}
+
+func (s windowOrWorkerGlobalScopeV8Wrapper) crossOriginIsolated(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := s.mustGetContext(info)
+	log.Debug("V8 Function call: WindowOrWorkerGlobalScope.crossOriginIsolated")
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.WindowOrWorkerGlobalScope)
+	if !ok || !s.isIncluder(instance) {
+		return nil, v8.NewTypeError(s.scriptHost.iso, s.interfaceName+".crossOriginIsolated: Illegal invocation")
+	}
+	result := instance.CrossOriginIsolated()
+	return s.toBoolean(ctx, result)
+}
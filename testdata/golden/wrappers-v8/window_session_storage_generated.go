@@ -0,0 +1,44 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	html "github.com/gost-dom/browser/html"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+type windowSessionStorageV8Wrapper struct {
+	handleReffedObject[html.WindowSessionStorage]
+	interfaceName string
+}
+
+func newWindowSessionStorageV8Wrapper(scriptHost *V8ScriptHost, interfaceName string) *windowSessionStorageV8Wrapper {
+	return &windowSessionStorageV8Wrapper{newHandleReffedObject[html.WindowSessionStorage](scriptHost), interfaceName}
+}
+
+// isIncluder returns whether the value implements the interface including
+// the mixin, named by interfaceName.
+func (s windowSessionStorageV8Wrapper) isIncluder(value any) bool {
+	switch s.interfaceName {
+	case "Window":
+		_, ok := value.(html.Window)
+		return ok
+	}
+	return false
+}
+
+func (s windowSessionStorageV8Wrapper) sessionStorage(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := s.mustGetContext(info)
+	log.Debug("V8 Function call: WindowSessionStorage.sessionStorage")
+	wrapped, err := s.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.WindowSessionStorage)
+	if !ok || !s.isIncluder(instance) {
+		return nil, v8.NewTypeError(s.scriptHost.iso, s.interfaceName+".sessionStorage: Illegal invocation")
+	}
+	result := instance.SessionStorage()
+	return s.toStorage(ctx, result)
+}
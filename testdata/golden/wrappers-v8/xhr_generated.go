@@ -0,0 +1,386 @@
+// This file is generated. Do not edit.
+
+package v8host
+
+import (
+	"errors"
+	html "github.com/gost-dom/browser/html"
+	log "github.com/gost-dom/browser/internal/log"
+	v8 "github.com/tommie/v8go"
+)
+
+func createXmlHttpRequestPrototype(scriptHost *V8ScriptHost) *v8.FunctionTemplate {
+	iso := scriptHost.iso
+	wrapper := newXmlHttpRequestV8Wrapper(scriptHost)
+	constructor := v8.NewFunctionTemplateWithError(iso, wrapper.Constructor)
+
+	instanceTmpl := constructor.InstanceTemplate()
+	instanceTmpl.SetInternalFieldCount(1)
+
+	prototypeTmpl := constructor.PrototypeTemplate()
+	prototypeTmpl.Set("open", v8.NewFunctionTemplateWithError(iso, wrapper.open))
+	prototypeTmpl.Set("setRequestHeader", v8.NewFunctionTemplateWithError(iso, wrapper.setRequestHeader))
+	prototypeTmpl.Set("send", v8.NewFunctionTemplateWithError(iso, wrapper.send))
+	prototypeTmpl.Set("abort", v8.NewFunctionTemplateWithError(iso, wrapper.abort))
+	prototypeTmpl.Set("getResponseHeader", v8.NewFunctionTemplateWithError(iso, wrapper.getResponseHeader))
+	prototypeTmpl.Set("getAllResponseHeaders", v8.NewFunctionTemplateWithError(iso, wrapper.getAllResponseHeaders))
+	prototypeTmpl.Set("overrideMimeType", v8.NewFunctionTemplateWithError(iso, wrapper.overrideMimeType))
+
+	prototypeTmpl.SetAccessorProperty("readyState",
+		v8.NewFunctionTemplateWithError(iso, wrapper.readyState),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("timeout",
+		v8.NewFunctionTemplateWithError(iso, wrapper.timeout),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setTimeout),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("withCredentials",
+		v8.NewFunctionTemplateWithError(iso, wrapper.withCredentials),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setWithCredentials),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("upload",
+		v8.NewFunctionTemplateWithError(iso, wrapper.upload),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("responseURL",
+		v8.NewFunctionTemplateWithError(iso, wrapper.responseURL),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("status",
+		v8.NewFunctionTemplateWithError(iso, wrapper.status),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("statusText",
+		v8.NewFunctionTemplateWithError(iso, wrapper.statusText),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("responseType",
+		v8.NewFunctionTemplateWithError(iso, wrapper.responseType),
+		v8.NewFunctionTemplateWithError(iso, wrapper.setResponseType),
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("response",
+		v8.NewFunctionTemplateWithError(iso, wrapper.response),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("responseText",
+		v8.NewFunctionTemplateWithError(iso, wrapper.responseText),
+		nil,
+		v8.None)
+	prototypeTmpl.SetAccessorProperty("responseXML",
+		v8.NewFunctionTemplateWithError(iso, wrapper.responseXML),
+		nil,
+		v8.None)
+
+	return constructor
+}
+
+func (xhr xmlHttpRequestV8Wrapper) Constructor(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := xhr.mustGetContext(info)
+	return xhr.CreateInstance(ctx, info.This())
+}
+
+func (xhr xmlHttpRequestV8Wrapper) setRequestHeader(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: XMLHttpRequest.setRequestHeader")
+	args := newArgumentHelper(xhr.scriptHost, info)
+	wrapped, err := xhr.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.XMLHttpRequest)
+	if !ok {
+		return nil, v8.NewTypeError(xhr.scriptHost.iso, "XMLHttpRequest.setRequestHeader: Illegal invocation")
+	}
+	name, err1 := tryParseArg(args, 0, xhr.decodeByteString)
+	value, err2 := tryParseArg(args, 1, xhr.decodeByteString)
+	if args.noOfReadArguments >= 2 {
+		err := errors.Join(err1, err2)
+		if err != nil {
+			return nil, err
+		}
+		instance.SetRequestHeader(name, value)
+		return nil, nil
+	}
+	return nil, errors.New("XMLHttpRequest.setRequestHeader: Missing arguments")
+}
+
+func (xhr xmlHttpRequestV8Wrapper) send(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: XMLHttpRequest.send")
+	args := newArgumentHelper(xhr.scriptHost, info)
+	wrapped, err := xhr.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.XMLHttpRequest)
+	if !ok {
+		return nil, v8.NewTypeError(xhr.scriptHost.iso, "XMLHttpRequest.send: Illegal invocation")
+	}
+	body, err1 := tryParseNullableArg(args, 0, xhr.decodeDocument, xhr.decodeXMLHttpRequestBodyInit)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		callErr := instance.SendBody(body)
+		if callErr != nil {
+			return nil, mapError(xhr.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	callErr := instance.Send()
+	if callErr != nil {
+		return nil, mapError(xhr.scriptHost, callErr)
+	}
+	return nil, nil
+}
+
+func (xhr xmlHttpRequestV8Wrapper) abort(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: XMLHttpRequest.abort")
+	wrapped, err := xhr.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.XMLHttpRequest)
+	if !ok {
+		return nil, v8.NewTypeError(xhr.scriptHost.iso, "XMLHttpRequest.abort: Illegal invocation")
+	}
+	callErr := instance.Abort()
+	if callErr != nil {
+		return nil, mapError(xhr.scriptHost, callErr)
+	}
+	return nil, nil
+}
+
+func (xhr xmlHttpRequestV8Wrapper) getResponseHeader(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := xhr.mustGetContext(info)
+	log.Debug("V8 Function call: XMLHttpRequest.getResponseHeader")
+	args := newArgumentHelper(xhr.scriptHost, info)
+	wrapped, err := xhr.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.XMLHttpRequest)
+	if !ok {
+		return nil, v8.NewTypeError(xhr.scriptHost.iso, "XMLHttpRequest.getResponseHeader: Illegal invocation")
+	}
+	name, err1 := tryParseArg(args, 0, xhr.decodeByteString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		result := instance.GetResponseHeader(name)
+		return xhr.toNullableByteString(ctx, result)
+	}
+	return nil, errors.New("XMLHttpRequest.getResponseHeader: Missing arguments")
+}
+
+func (xhr xmlHttpRequestV8Wrapper) getAllResponseHeaders(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := xhr.mustGetContext(info)
+	log.Debug("V8 Function call: XMLHttpRequest.getAllResponseHeaders")
+	wrapped, err := xhr.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.XMLHttpRequest)
+	if !ok {
+		return nil, v8.NewTypeError(xhr.scriptHost.iso, "XMLHttpRequest.getAllResponseHeaders: Illegal invocation")
+	}
+	result, callErr := instance.GetAllResponseHeaders()
+	if callErr != nil {
+		return nil, mapError(xhr.scriptHost, callErr)
+	} else {
+		return xhr.toByteString(ctx, result)
+	}
+}
+
+func (xhr xmlHttpRequestV8Wrapper) overrideMimeType(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: XMLHttpRequest.overrideMimeType")
+	args := newArgumentHelper(xhr.scriptHost, info)
+	wrapped, err := xhr.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.XMLHttpRequest)
+	if !ok {
+		return nil, v8.NewTypeError(xhr.scriptHost.iso, "XMLHttpRequest.overrideMimeType: Illegal invocation")
+	}
+	mime, err1 := tryParseArg(args, 0, xhr.decodeDOMString)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		callErr := instance.OverrideMimeType(mime)
+		if callErr != nil {
+			return nil, mapError(xhr.scriptHost, callErr)
+		}
+		return nil, nil
+	}
+	return nil, errors.New("XMLHttpRequest.overrideMimeType: Missing arguments")
+}
+
+func (xhr xmlHttpRequestV8Wrapper) readyState(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: XMLHttpRequest.readyState")
+	return nil, notImplemented("XMLHttpRequest", "readyState")
+}
+
+func (xhr xmlHttpRequestV8Wrapper) timeout(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := xhr.mustGetContext(info)
+	log.Debug("V8 Function call: XMLHttpRequest.timeout")
+	wrapped, err := xhr.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.XMLHttpRequest)
+	if !ok {
+		return nil, v8.NewTypeError(xhr.scriptHost.iso, "XMLHttpRequest.timeout: Illegal invocation")
+	}
+	result := instance.Timeout()
+	return xhr.toUnsignedLong(ctx, result)
+}
+
+func (xhr xmlHttpRequestV8Wrapper) setTimeout(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: XMLHttpRequest.setTimeout")
+	args := newArgumentHelper(xhr.scriptHost, info)
+	wrapped, err := xhr.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.XMLHttpRequest)
+	if !ok {
+		return nil, v8.NewTypeError(xhr.scriptHost.iso, "XMLHttpRequest.setTimeout: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, decodeIDLUnsignedLong)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetTimeout(val)
+		return nil, nil
+	}
+	return nil, errors.New("XMLHttpRequest.setTimeout: Missing arguments")
+}
+
+func (xhr xmlHttpRequestV8Wrapper) withCredentials(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := xhr.mustGetContext(info)
+	log.Debug("V8 Function call: XMLHttpRequest.withCredentials")
+	wrapped, err := xhr.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.XMLHttpRequest)
+	if !ok {
+		return nil, v8.NewTypeError(xhr.scriptHost.iso, "XMLHttpRequest.withCredentials: Illegal invocation")
+	}
+	result := instance.WithCredentials()
+	return xhr.toBoolean(ctx, result)
+}
+
+func (xhr xmlHttpRequestV8Wrapper) setWithCredentials(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: XMLHttpRequest.setWithCredentials")
+	args := newArgumentHelper(xhr.scriptHost, info)
+	wrapped, err := xhr.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.XMLHttpRequest)
+	if !ok {
+		return nil, v8.NewTypeError(xhr.scriptHost.iso, "XMLHttpRequest.setWithCredentials: Illegal invocation")
+	}
+	val, err1 := tryParseArg(args, 0, xhr.decodeBoolean)
+	if args.noOfReadArguments >= 1 {
+		if err1 != nil {
+			return nil, err1
+		}
+		instance.SetWithCredentials(val)
+		return nil, nil
+	}
+	return nil, errors.New("XMLHttpRequest.setWithCredentials: Missing arguments")
+}
+
+func (xhr xmlHttpRequestV8Wrapper) responseURL(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := xhr.mustGetContext(info)
+	log.Debug("V8 Function call: XMLHttpRequest.responseURL")
+	wrapped, err := xhr.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.XMLHttpRequest)
+	if !ok {
+		return nil, v8.NewTypeError(xhr.scriptHost.iso, "XMLHttpRequest.responseURL: Illegal invocation")
+	}
+	result := instance.ResponseURL()
+	return xhr.toUSVString(ctx, result)
+}
+
+func (xhr xmlHttpRequestV8Wrapper) status(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := xhr.mustGetContext(info)
+	log.Debug("V8 Function call: XMLHttpRequest.status")
+	wrapped, err := xhr.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.XMLHttpRequest)
+	if !ok {
+		return nil, v8.NewTypeError(xhr.scriptHost.iso, "XMLHttpRequest.status: Illegal invocation")
+	}
+	result := instance.Status()
+	return xhr.toUnsignedShort(ctx, result)
+}
+
+func (xhr xmlHttpRequestV8Wrapper) statusText(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := xhr.mustGetContext(info)
+	log.Debug("V8 Function call: XMLHttpRequest.statusText")
+	wrapped, err := xhr.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.XMLHttpRequest)
+	if !ok {
+		return nil, v8.NewTypeError(xhr.scriptHost.iso, "XMLHttpRequest.statusText: Illegal invocation")
+	}
+	result := instance.StatusText()
+	return xhr.toByteString(ctx, result)
+}
+
+func (xhr xmlHttpRequestV8Wrapper) responseType(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: XMLHttpRequest.responseType")
+	return nil, notImplemented("XMLHttpRequest", "responseType")
+}
+
+func (xhr xmlHttpRequestV8Wrapper) setResponseType(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: XMLHttpRequest.setResponseType")
+	return nil, notImplemented("XMLHttpRequest", "setResponseType")
+}
+
+func (xhr xmlHttpRequestV8Wrapper) response(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := xhr.mustGetContext(info)
+	log.Debug("V8 Function call: XMLHttpRequest.response")
+	wrapped, err := xhr.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.XMLHttpRequest)
+	if !ok {
+		return nil, v8.NewTypeError(xhr.scriptHost.iso, "XMLHttpRequest.response: Illegal invocation")
+	}
+	result := instance.Response()
+	return xhr.toAny(ctx, result)
+}
+
+func (xhr xmlHttpRequestV8Wrapper) responseText(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	ctx := xhr.mustGetContext(info)
+	log.Debug("V8 Function call: XMLHttpRequest.responseText")
+	wrapped, err := xhr.getWrapped(info)
+	if err != nil {
+		return nil, err
+	}
+	instance, ok := wrapped.(html.XMLHttpRequest)
+	if !ok {
+		return nil, v8.NewTypeError(xhr.scriptHost.iso, "XMLHttpRequest.responseText: Illegal invocation")
+	}
+	result := instance.ResponseText()
+	return xhr.toUSVString(ctx, result)
+}
+
+func (xhr xmlHttpRequestV8Wrapper) responseXML(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	log.Debug("V8 Function call: XMLHttpRequest.responseXML")
+	return nil, notImplemented("XMLHttpRequest", "responseXML")
+}
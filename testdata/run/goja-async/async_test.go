@@ -0,0 +1,191 @@
+package gojahost
+
+// Runs the generated promise and async iterator encoders in goja. The
+// generated files are copied next to this file by the async encoder test in
+// the root package.
+
+import (
+	"errors"
+	"iter"
+	"testing"
+	"time"
+
+	g "github.com/dop251/goja"
+)
+
+// GojaContext is the part of the script context of the host that the
+// encoders use. Tasks are run by [GojaContext.run].
+type GojaContext struct {
+	vm    *g.Runtime
+	tasks chan func()
+}
+
+func (c *GojaContext) queueTask(task func()) { c.tasks <- task }
+
+func newContext() *GojaContext {
+	return &GojaContext{vm: g.New(), tasks: make(chan func(), 10)}
+}
+
+// run runs script, and then the queued tasks, until the script sets the global
+// "done".
+func (c *GojaContext) run(t *testing.T, script string) {
+	t.Helper()
+	c.vm.Set("done", false)
+	if _, err := c.vm.RunString(script); err != nil {
+		t.Fatal(err)
+	}
+	for !c.vm.Get("done").ToBoolean() {
+		select {
+		case task := <-c.tasks:
+			task()
+			// Goja runs the promise reactions when a script returns
+			if _, err := c.vm.RunString(""); err != nil {
+				t.Fatal(err)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for a task")
+		}
+	}
+}
+
+func (c *GojaContext) get(name string) string { return c.vm.Get(name).String() }
+
+func (c *GojaContext) encodeInt(v int) g.Value { return c.vm.ToValue(v) }
+
+func TestPromiseIsSettledAfterTheFunctionReturns(t *testing.T) {
+	ctx := newContext()
+	release := make(chan struct{})
+	ctx.vm.Set("promise", toPromise(ctx, ctx.encodeInt)(func() (int, error) {
+		<-release
+		return 42, nil
+	}))
+	close(release)
+	ctx.run(t, "promise.then(v => { result = v; done = true })")
+	if got := ctx.get("result"); got != "42" {
+		t.Errorf("got %s", got)
+	}
+}
+
+func TestPromiseIsRejectedWithTheError(t *testing.T) {
+	ctx := newContext()
+	ctx.vm.Set("promise", toPromise(ctx, ctx.encodeInt)(func() (int, error) {
+		return 0, errors.New("failed")
+	}))
+	ctx.run(t, "promise.catch(e => { result = e.message; done = true })")
+	if got := ctx.get("result"); got != "failed" {
+		t.Errorf("got %s", got)
+	}
+}
+
+func TestPromiseIsRejectedWithTheExceptionFromTheEncoder(t *testing.T) {
+	ctx := newContext()
+	encode := func(int) g.Value { panic(ctx.vm.NewTypeError("bad value")) }
+	ctx.vm.Set("promise", toPromise(ctx, encode)(func() (int, error) { return 0, nil }))
+	ctx.run(t, "promise.catch(e => { result = e instanceof TypeError; done = true })")
+	if got := ctx.get("result"); got != "true" {
+		t.Errorf("got %s", got)
+	}
+}
+
+func TestVoidPromiseIsResolvedWithUndefined(t *testing.T) {
+	ctx := newContext()
+	ctx.vm.Set("promise", toVoidPromise(ctx)(func() error { return nil }))
+	ctx.run(t, "promise.then(v => { result = v === undefined; done = true })")
+	if got := ctx.get("result"); got != "true" {
+		t.Errorf("got %s", got)
+	}
+}
+
+func values(vs ...int) iter.Seq2[int, error] {
+	return func(yield func(int, error) bool) {
+		for _, v := range vs {
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+func TestAsyncIteratorIteratesTheSequence(t *testing.T) {
+	ctx := newContext()
+	// Goja doesn't define Symbol.asyncIterator
+	if _, err := ctx.vm.RunString(`Symbol.asyncIterator = Symbol("Symbol.asyncIterator")`); err != nil {
+		t.Fatal(err)
+	}
+	ctx.vm.Set("it", toAsyncIterator(ctx, ctx.encodeInt)(values(1, 2, 3)))
+	ctx.run(t, `
+		const values = [];
+		const step = () => it.next().then(r => {
+			if (r.done) {
+				result = values.join();
+				done = true;
+			} else {
+				values.push(r.value);
+				step();
+			}
+		});
+		isIterable = it[Symbol.asyncIterator]() === it;
+		step();
+	`)
+	if got := ctx.get("result"); got != "1,2,3" {
+		t.Errorf("got %s", got)
+	}
+	if got := ctx.get("isIterable"); got != "true" {
+		t.Errorf("Symbol.asyncIterator doesn't return the iterator")
+	}
+}
+
+func TestAsyncIteratorSettlesConcurrentCallsInOrder(t *testing.T) {
+	ctx := newContext()
+	ctx.vm.Set("it", toAsyncIterator(ctx, ctx.encodeInt)(values(1, 2)))
+	ctx.run(t, `
+		Promise.all([it.next(), it.next(), it.next()]).then(rs => {
+			result = rs.map(r => r.done ? "done" : r.value).join();
+			done = true;
+		});
+	`)
+	if got := ctx.get("result"); got != "1,2,done" {
+		t.Errorf("got %s", got)
+	}
+}
+
+func TestAsyncIteratorIsRejectedWithTheErrorOfTheSequence(t *testing.T) {
+	ctx := newContext()
+	seq := func(yield func(int, error) bool) {
+		if yield(1, nil) {
+			yield(0, errors.New("failed"))
+		}
+	}
+	ctx.vm.Set("it", toAsyncIterator(ctx, ctx.encodeInt)(seq))
+	ctx.run(t, `
+		it.next().then(() => it.next()).catch(e => { result = e.message; done = true });
+	`)
+	if got := ctx.get("result"); got != "failed" {
+		t.Errorf("got %s", got)
+	}
+}
+
+func TestAsyncIteratorReturnStopsTheSequence(t *testing.T) {
+	ctx := newContext()
+	stopped := make(chan struct{})
+	seq := func(yield func(int, error) bool) {
+		defer close(stopped)
+		for i := 0; yield(i, nil); i++ {
+		}
+	}
+	ctx.vm.Set("it", toAsyncIterator(ctx, ctx.encodeInt)(seq))
+	ctx.run(t, `
+		it.next().then(() => it.return(42)).then(r => {
+			result = r.done && r.value;
+			done = true;
+		});
+	`)
+	if got := ctx.get("result"); got != "42" {
+		t.Errorf("got %s", got)
+	}
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Error("the sequence wasn't stopped")
+	}
+}
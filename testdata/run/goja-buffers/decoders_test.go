@@ -0,0 +1,103 @@
+package gojahost
+
+// Runs the generated buffer source converters in goja. The generated file is
+// copied next to this file by the buffer source test in the root package.
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+
+	g "github.com/dop251/goja"
+)
+
+// decode runs script, and decodes the value with the decoder. A panic with a
+// JS error is returned as the name of the error.
+func decode(
+	t *testing.T,
+	script string,
+	decoder func(*g.Runtime) func(g.Value) []byte,
+) (result []byte, errName string) {
+	t.Helper()
+	vm := g.New()
+	v, err := vm.RunString(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			obj, ok := r.(*g.Object)
+			if !ok {
+				t.Fatalf("panic with a Go value: %v", r)
+			}
+			errName = obj.Get("name").String()
+		}
+	}()
+	return decoder(vm)(v), ""
+}
+
+func TestDecodeBufferSource(t *testing.T) {
+	float := binary.LittleEndian.AppendUint32(nil, math.Float32bits(1.5))
+	cases := []struct {
+		name   string
+		script string
+		want   []byte
+	}{
+		{"ArrayBuffer", "new Uint8Array([1, 2, 3]).buffer", []byte{1, 2, 3}},
+		{"Uint8Array", "new Uint8Array([1, 2, 3])", []byte{1, 2, 3}},
+		{"subarray", "new Uint8Array([1, 2, 3, 4]).subarray(1, 3)", []byte{2, 3}},
+		{"Uint16Array", "new Uint16Array([0x0102, 0x0304])", []byte{2, 1, 4, 3}},
+		{"Float32Array", "new Float32Array([1.5])", float},
+		{"DataView", "new DataView(new Uint8Array([1, 2, 3, 4]).buffer, 1, 2)", []byte{2, 3}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, errName := decode(t, c.script, decodeIDLBufferSource)
+			if errName != "" {
+				t.Fatalf("threw %s", errName)
+			}
+			if !bytes.Equal(got, c.want) {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestDecodeBufferSourceThrowsTypeError(t *testing.T) {
+	for _, script := range []string{`"abc"`, "[1, 2, 3]", "({})"} {
+		if _, errName := decode(t, script, decodeIDLBufferSource); errName != "TypeError" {
+			t.Errorf("%s: got %q, want TypeError", script, errName)
+		}
+	}
+	if _, errName := decode(t, "new Uint16Array(2)", decodeIDLUint8Array); errName != "TypeError" {
+		t.Errorf("Uint16Array as Uint8Array: got %q, want TypeError", errName)
+	}
+}
+
+func TestDecodeCopiesBytes(t *testing.T) {
+	vm := g.New()
+	v, err := vm.RunString("globalThis.array = new Uint8Array([1, 2]); array")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := decodeIDLUint8Array(vm)(v)
+	if _, err := vm.RunString("array[0] = 42"); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, []byte{1, 2}) {
+		t.Errorf("got %v after changing the array in JS", got)
+	}
+}
+
+func TestEncodeUint8Array(t *testing.T) {
+	vm := g.New()
+	vm.Set("array", toIDLUint8Array(vm)([]byte{1, 2, 3}))
+	v, err := vm.RunString("array instanceof Uint8Array && array.join()")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := v.String(); got != "1,2,3" {
+		t.Errorf("got %q", got)
+	}
+}
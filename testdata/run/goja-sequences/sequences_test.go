@@ -0,0 +1,172 @@
+package gojahost
+
+// Runs the generated sequence and record converters in goja. The generated
+// file is copied next to this file by the sequence converter test in the root
+// package.
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	g "github.com/dop251/goja"
+)
+
+// run runs script, and converts the value with convert. A panic with a JS
+// error is returned as the name of the error.
+func run[T any](
+	t *testing.T,
+	vm *g.Runtime,
+	script string,
+	convert func(g.Value) T,
+) (result T, errName string) {
+	t.Helper()
+	v, err := vm.RunString(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			obj, ok := r.(*g.Object)
+			if !ok {
+				t.Fatalf("panic with a Go value: %v", r)
+			}
+			errName = obj.Get("name").String()
+		}
+	}()
+	return convert(v), ""
+}
+
+// decodeInt decodes a number, and panics with a TypeError for other values,
+// like the converters of the script host.
+func decodeInt(vm *g.Runtime) func(g.Value) int {
+	return func(v g.Value) int {
+		if _, ok := v.Export().(int64); !ok {
+			panic(vm.NewTypeError("Not an integer"))
+		}
+		return int(v.ToInteger())
+	}
+}
+
+func decodeString(v g.Value) string { return v.String() }
+
+func TestDecodeSequence(t *testing.T) {
+	cases := []struct {
+		name   string
+		script string
+		want   []int
+	}{
+		{"array", "[1, 2, 3]", []int{1, 2, 3}},
+		{"Set", "new Set([3, 2, 3])", []int{3, 2}},
+		{"generator", "(function*() { yield 1; yield 2 })()", []int{1, 2}},
+		{"empty", "[]", []int{}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			vm := g.New()
+			got, errName := run(t, vm, c.script, decodeSequence(vm, decodeInt(vm)))
+			if errName != "" {
+				t.Fatalf("threw %s", errName)
+			}
+			if !slices.Equal(got, c.want) {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestDecodeSequenceErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		script string
+	}{
+		{"number", "42"},
+		{"string", "'abc'"},
+		{"object", "({ length: 1, 0: 1 })"},
+		{"element", "[1, 'two']"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			vm := g.New()
+			_, errName := run(t, vm, c.script, decodeSequence(vm, decodeInt(vm)))
+			if errName != "TypeError" {
+				t.Errorf("threw %q, want TypeError", errName)
+			}
+		})
+	}
+}
+
+func TestDecodeRecord(t *testing.T) {
+	vm := g.New()
+	script := `
+		const proto = { inherited: 1 }
+		const obj = Object.create(proto)
+		obj.a = 2
+		obj.b = 3
+		Object.defineProperty(obj, "hidden", { value: 4, enumerable: false })
+		obj
+	`
+	got, errName := run(t, vm, script, decodeRecord(vm, decodeString, decodeInt(vm)))
+	if errName != "" {
+		t.Fatalf("threw %s", errName)
+	}
+	want := map[string]int{"a": 2, "b": 3}
+	if !maps.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	_, errName = run(t, vm, "({ a: 'x' })", decodeRecord(vm, decodeString, decodeInt(vm)))
+	if errName != "TypeError" {
+		t.Errorf("value of the wrong type threw %q, want TypeError", errName)
+	}
+	_, errName = run(t, vm, "42", decodeRecord(vm, decodeString, decodeInt(vm)))
+	if errName != "TypeError" {
+		t.Errorf("number threw %q, want TypeError", errName)
+	}
+}
+
+func encodeDouble(vm *g.Runtime) func(int) g.Value {
+	return func(v int) g.Value { return vm.ToValue(v * 2) }
+}
+
+// check runs script with the global "value", and returns the result.
+func check(t *testing.T, vm *g.Runtime, value g.Value, script string) string {
+	t.Helper()
+	vm.Set("value", value)
+	result, err := vm.RunString(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return result.String()
+}
+
+func TestToSequence(t *testing.T) {
+	vm := g.New()
+	value := toSequence(vm, encodeDouble(vm))([]int{1, 2, 3})
+	if got := check(t, vm, value, "Array.isArray(value) && value.join()"); got != "2,4,6" {
+		t.Errorf("got %s, want 2,4,6", got)
+	}
+	if got := check(t, vm, value, "Object.isFrozen(value)"); got != "false" {
+		t.Errorf("sequence is frozen")
+	}
+}
+
+func TestToFrozenArray(t *testing.T) {
+	vm := g.New()
+	value := toFrozenArray(vm, encodeDouble(vm))([]int{1, 2})
+	if got := check(t, vm, value, "Array.isArray(value) && value.join()"); got != "2,4" {
+		t.Errorf("got %s, want 2,4", got)
+	}
+	if got := check(t, vm, value, "Object.isFrozen(value)"); got != "true" {
+		t.Errorf("array isn't frozen")
+	}
+}
+
+func TestToRecord(t *testing.T) {
+	vm := g.New()
+	value := toRecord(vm, encodeDouble(vm))(map[string]int{"b": 2, "a": 1})
+	got := check(t, vm, value, "JSON.stringify(value)")
+	if want := `{"a":2,"b":4}`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,119 @@
+// Stubs of the hand-written code of the gojahost package in gost-dom/browser
+// that the generated wrappers depend on.
+package gojahost
+
+import (
+	g "github.com/dop251/goja"
+	"github.com/gost-dom/browser/dom"
+)
+
+type GojaContext struct {
+	vm *g.Runtime
+}
+
+func (c *GojaContext) queueTask(task func()) { panic("stub") }
+
+type wrapper interface {
+	initializePrototype(prototype *g.Object, vm *g.Runtime)
+}
+
+type namespaceWrapper interface {
+	initializeNamespace(namespace *g.Object, vm *g.Runtime)
+}
+
+type createWrapper func(instance *GojaContext) wrapper
+
+type createNamespaceWrapper func(instance *GojaContext) namespaceWrapper
+
+func installClass(name string, superClassName string, wrapper createWrapper) { panic("stub") }
+
+func installNamespace(name string, wrapper createNamespaceWrapper) { panic("stub") }
+
+func newDOMException(ctx *GojaContext, message string, name string, code int) *g.Object {
+	panic("stub")
+}
+
+func decodeNullable[T any](value g.Value, decoder func(g.Value) T) T { panic("stub") }
+
+func decodeArgWithDefault[T any](
+	args []g.Value,
+	index int,
+	defaultValue func() T,
+	decoder func(g.Value) T,
+) T {
+	panic("stub")
+}
+
+func decodeVariadicArgs[T any](args []g.Value, index int, decoder func(g.Value) T) []T {
+	panic("stub")
+}
+
+type baseInstanceWrapper[T any] struct {
+	converters
+	ctx *GojaContext
+}
+
+func newBaseInstanceWrapper[T any](instance *GojaContext) baseInstanceWrapper[T] {
+	panic("stub")
+}
+
+type baseNamespaceWrapper struct {
+	converters
+	ctx *GojaContext
+}
+
+func newBaseNamespaceWrapper(instance *GojaContext) baseNamespaceWrapper { panic("stub") }
+
+// converters decode JS values passed to the wrappers, and encode the values
+// returned to JS.
+type converters struct {
+	ctx *GojaContext
+}
+
+func (c converters) decodeany(v g.Value) g.Value { panic("stub") }
+
+func (c converters) decodeboolean(v g.Value) bool { panic("stub") }
+
+func (c converters) decodeDOMString(v g.Value) string { panic("stub") }
+
+func (c converters) decodeUSVString(v g.Value) string { panic("stub") }
+
+func (c converters) decodeByteString(v g.Value) string { panic("stub") }
+
+func (c converters) decodeNode(v g.Value) dom.Node { panic("stub") }
+
+func (c converters) decodeGetRootNodeOptions(v g.Value) dom.GetRootNodeOptions { panic("stub") }
+
+func (c converters) defaultboolean() bool { panic("stub") }
+
+func (c converters) defaultCondition() bool { panic("stub") }
+
+func (c converters) defaultLabel() string { panic("stub") }
+
+func (c converters) defaultGetRootNodeOptions() dom.GetRootNodeOptions { panic("stub") }
+
+func (c converters) toBoolean(v bool) g.Value { panic("stub") }
+
+func (c converters) toDOMString(v string) g.Value { panic("stub") }
+
+func (c converters) toUSVString(v string) g.Value { panic("stub") }
+
+func (c converters) toNullableUSVString(v *string) g.Value { panic("stub") }
+
+func (c converters) toByteString(v string) g.Value { panic("stub") }
+
+func (c converters) toNullableByteString(v *string) g.Value { panic("stub") }
+
+func (c converters) toUnsignedLong(v int) g.Value { panic("stub") }
+
+func (c converters) toUnsignedShort(v int) g.Value { panic("stub") }
+
+func (c converters) toDOMHighResTimeStamp(v float64) g.Value { panic("stub") }
+
+func (c converters) toEventTarget(v dom.EventTarget) g.Value { panic("stub") }
+
+func (c converters) toNode(v dom.Node) g.Value { panic("stub") }
+
+func (c converters) toElement(v dom.Element) g.Value { panic("stub") }
+
+func (c converters) toDocument(v dom.Document) g.Value { panic("stub") }
@@ -0,0 +1,99 @@
+// Stubs of the hand-written code of the sobekhost package in gost-dom/browser
+// that the generated wrappers depend on.
+package sobekhost
+
+import (
+	"github.com/gost-dom/browser/dom"
+	"github.com/grafana/sobek"
+)
+
+type SobekContext struct {
+	vm *sobek.Runtime
+}
+
+func (c *SobekContext) queueTask(task func()) { panic("stub") }
+
+type wrapper interface {
+	initializePrototype(prototype *sobek.Object, vm *sobek.Runtime)
+}
+
+type namespaceWrapper interface {
+	initializeNamespace(namespace *sobek.Object, vm *sobek.Runtime)
+}
+
+type createWrapper func(instance *SobekContext) wrapper
+
+type createNamespaceWrapper func(instance *SobekContext) namespaceWrapper
+
+func installClass(name string, superClassName string, wrapper createWrapper) { panic("stub") }
+
+func installNamespace(name string, wrapper createNamespaceWrapper) { panic("stub") }
+
+func newDOMException(ctx *SobekContext, message string, name string, code int) *sobek.Object {
+	panic("stub")
+}
+
+func decodeNullable[T any](value sobek.Value, decoder func(sobek.Value) T) T { panic("stub") }
+
+func decodeArgWithDefault[T any](
+	args []sobek.Value,
+	index int,
+	defaultValue func() T,
+	decoder func(sobek.Value) T,
+) T {
+	panic("stub")
+}
+
+func decodeVariadicArgs[T any](args []sobek.Value, index int, decoder func(sobek.Value) T) []T {
+	panic("stub")
+}
+
+type baseInstanceWrapper[T any] struct {
+	converters
+	ctx *SobekContext
+}
+
+func newBaseInstanceWrapper[T any](instance *SobekContext) baseInstanceWrapper[T] {
+	panic("stub")
+}
+
+type baseNamespaceWrapper struct {
+	converters
+	ctx *SobekContext
+}
+
+func newBaseNamespaceWrapper(instance *SobekContext) baseNamespaceWrapper { panic("stub") }
+
+// converters decode JS values passed to the wrappers, and encode the values
+// returned to JS.
+type converters struct {
+	ctx *SobekContext
+}
+
+func (c converters) decodeany(v sobek.Value) sobek.Value { panic("stub") }
+
+func (c converters) decodeboolean(v sobek.Value) bool { panic("stub") }
+
+func (c converters) decodeDOMString(v sobek.Value) string { panic("stub") }
+
+func (c converters) decodeNode(v sobek.Value) dom.Node { panic("stub") }
+
+func (c converters) decodeGetRootNodeOptions(v sobek.Value) dom.GetRootNodeOptions { panic("stub") }
+
+func (c converters) defaultboolean() bool { panic("stub") }
+
+func (c converters) defaultCondition() bool { panic("stub") }
+
+func (c converters) defaultLabel() string { panic("stub") }
+
+func (c converters) defaultGetRootNodeOptions() dom.GetRootNodeOptions { panic("stub") }
+
+func (c converters) toBoolean(v bool) sobek.Value { panic("stub") }
+
+func (c converters) toDOMString(v string) sobek.Value { panic("stub") }
+
+func (c converters) toNode(v dom.Node) sobek.Value { panic("stub") }
+
+func (c converters) toElement(v dom.Element) sobek.Value { panic("stub") }
+
+func (c converters) toDocument(v dom.Document) sobek.Value { panic("stub") }
@@ -0,0 +1,165 @@
+package v8host
+
+import (
+	"github.com/gost-dom/browser/dom"
+	"github.com/gost-dom/browser/html"
+	v8 "github.com/tommie/v8go"
+)
+
+// converters decode JS values passed to the wrappers, and encode the values
+// returned to JS.
+type converters struct{}
+
+func (c converters) decodeAny(ctx *V8ScriptContext, v *v8.Value) (*v8.Value, error) { panic("stub") }
+
+func (c converters) decodeBoolean(ctx *V8ScriptContext, v *v8.Value) (bool, error) { panic("stub") }
+
+func (c converters) decodeDOMString(ctx *V8ScriptContext, v *v8.Value) (string, error) { panic("stub") }
+
+func (c converters) decodeUSVString(ctx *V8ScriptContext, v *v8.Value) (string, error) { panic("stub") }
+
+func (c converters) decodeByteString(ctx *V8ScriptContext, v *v8.Value) (string, error) {
+	panic("stub")
+}
+
+func (c converters) decodeNode(ctx *V8ScriptContext, v *v8.Value) (dom.Node, error) { panic("stub") }
+
+func (c converters) decodeElement(ctx *V8ScriptContext, v *v8.Value) (dom.Element, error) {
+	panic("stub")
+}
+
+func (c converters) decodeDocument(ctx *V8ScriptContext, v *v8.Value) (dom.Document, error) {
+	panic("stub")
+}
+
+func (c converters) decodeXMLHttpRequestBodyInit(ctx *V8ScriptContext, v *v8.Value) (dom.Document, error) {
+	panic("stub")
+}
+
+func (c converters) decodeHTMLElement(ctx *V8ScriptContext, v *v8.Value) (html.HTMLElement, error) {
+	panic("stub")
+}
+
+func (c converters) decodeEventInit(ctx *V8ScriptContext, v *v8.Value) (dom.EventInit, error) {
+	panic("stub")
+}
+
+func (c converters) decodeGetRootNodeOptions(ctx *V8ScriptContext, v *v8.Value) (dom.GetRootNodeOptions, error) {
+	panic("stub")
+}
+
+func (c converters) decodeTimerHandler(ctx *V8ScriptContext, v *v8.Value) (html.TimerHandler, error) {
+	panic("stub")
+}
+
+func (c converters) decodeVoidFunction(ctx *V8ScriptContext, v *v8.Value) (html.VoidFunction, error) {
+	panic("stub")
+}
+
+func (c converters) decodeStructuredSerializeOptions(ctx *V8ScriptContext, v *v8.Value) (html.StructuredSerializeOptions, error) {
+	panic("stub")
+}
+
+func (c converters) decodeImageBitmapSource(ctx *V8ScriptContext, v *v8.Value) (html.ImageBitmapSource, error) {
+	panic("stub")
+}
+
+func (c converters) decodeImageBitmapOptions(ctx *V8ScriptContext, v *v8.Value) (html.ImageBitmapOptions, error) {
+	panic("stub")
+}
+
+func (c converters) decodeFrameRequestCallback(ctx *V8ScriptContext, v *v8.Value) (html.FrameRequestCallback, error) {
+	panic("stub")
+}
+
+func (c converters) decodeOnErrorEventHandler(ctx *V8ScriptContext, v *v8.Value) (dom.EventHandler, error) {
+	panic("stub")
+}
+
+func (c converters) decodeOnBeforeUnloadEventHandler(ctx *V8ScriptContext, v *v8.Value) (dom.EventHandler, error) {
+	panic("stub")
+}
+
+func (c converters) toAny(ctx *V8ScriptContext, v *v8.Value) (*v8.Value, error) { panic("stub") }
+
+func (c converters) toBoolean(ctx *V8ScriptContext, v bool) (*v8.Value, error) { panic("stub") }
+
+func (c converters) toDOMString(ctx *V8ScriptContext, v string) (*v8.Value, error) { panic("stub") }
+
+func (c converters) toNullableDOMString(ctx *V8ScriptContext, v *string) (*v8.Value, error) {
+	panic("stub")
+}
+
+func (c converters) toUSVString(ctx *V8ScriptContext, v string) (*v8.Value, error) { panic("stub") }
+
+func (c converters) toNullableUSVString(ctx *V8ScriptContext, v *string) (*v8.Value, error) {
+	panic("stub")
+}
+
+func (c converters) toByteString(ctx *V8ScriptContext, v string) (*v8.Value, error) { panic("stub") }
+
+func (c converters) toNullableByteString(ctx *V8ScriptContext, v *string) (*v8.Value, error) {
+	panic("stub")
+}
+
+func (c converters) toLong(ctx *V8ScriptContext, v int) (*v8.Value, error) { panic("stub") }
+
+func (c converters) toUnsignedLong(ctx *V8ScriptContext, v int) (*v8.Value, error) { panic("stub") }
+
+func (c converters) toUnsignedShort(ctx *V8ScriptContext, v int) (*v8.Value, error) { panic("stub") }
+
+func (c converters) toDOMHighResTimeStamp(ctx *V8ScriptContext, v float64) (*v8.Value, error) {
+	panic("stub")
+}
+
+func (c converters) toEventTarget(ctx *V8ScriptContext, v dom.EventTarget) (*v8.Value, error) {
+	panic("stub")
+}
+
+func (c converters) toNodeList(ctx *V8ScriptContext, v dom.NodeList) (*v8.Value, error) {
+	panic("stub")
+}
+
+func (c converters) toHTMLCollection(ctx *V8ScriptContext, v dom.HTMLCollection) (*v8.Value, error) {
+	panic("stub")
+}
+
+func (c converters) toHTMLFormControlsCollection(ctx *V8ScriptContext, v html.HTMLFormControlsCollection) (*v8.Value, error) {
+	panic("stub")
+}
+
+func (c converters) toNamedNodeMap(ctx *V8ScriptContext, v dom.NamedNodeMap) (*v8.Value, error) {
+	panic("stub")
+}
+
+func (c converters) toStorage(ctx *V8ScriptContext, v html.Storage) (*v8.Value, error) { panic("stub") }
+
+func (c converters) toImageBitmap(ctx *V8ScriptContext, v html.ImageBitmap) (*v8.Value, error) {
+	panic("stub")
+}
+
+func (c converters) toOnErrorEventHandler(ctx *V8ScriptContext, v dom.EventHandler) (*v8.Value, error) {
+	panic("stub")
+}
+
+func (c converters) toOnBeforeUnloadEventHandler(ctx *V8ScriptContext, v dom.EventHandler) (*v8.Value, error) {
+	panic("stub")
+}
+
+func (c converters) toJSON(ctx *V8ScriptContext, v any) (*v8.Value, error) { panic("stub") }
+
+func (c converters) defaultEventInit() dom.EventInit { panic("stub") }
+
+func (c converters) defaultHTMLElement() html.HTMLElement { panic("stub") }
+
+func (c converters) defaultGetRootNodeOptions() dom.GetRootNodeOptions { panic("stub") }
+
+func (c converters) defaultboolean() bool { panic("stub") }
+
+func (c converters) defaultCondition() bool { panic("stub") }
+
+func (c converters) defaultLabel() string { panic("stub") }
+
+func (c converters) defaultDelta() int { panic("stub") }
+
+func (c converters) defaultUrl() string { panic("stub") }
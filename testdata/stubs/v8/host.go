@@ -0,0 +1,108 @@
+// Stubs of the hand-written code of the v8host package in gost-dom/browser
+// that the generated wrappers depend on.
+package v8host
+
+import (
+	"github.com/gost-dom/browser/dom"
+	v8 "github.com/tommie/v8go"
+)
+
+type V8ScriptHost struct {
+	iso                   *v8.Isolate
+	asyncIteratorTemplate *v8.ObjectTemplate
+}
+
+func (h *V8ScriptHost) mustGetContext(v8ctx *v8.Context) *V8ScriptContext { panic("stub") }
+
+type V8ScriptContext struct {
+	host           *V8ScriptHost
+	v8ctx          *v8.Context
+	asyncIterators asyncIterators
+}
+
+type disposable interface {
+	dispose()
+}
+
+func (c *V8ScriptContext) addDisposer(disposer disposable) { panic("stub") }
+
+func (c *V8ScriptContext) queueTask(task func()) { panic("stub") }
+
+func (c *V8ScriptContext) getInstanceForNode(node dom.Node) (*v8.Value, error) { panic("stub") }
+
+type argumentHelper struct {
+	noOfReadArguments int
+}
+
+func newArgumentHelper(host *V8ScriptHost, info *v8.FunctionCallbackInfo) *argumentHelper {
+	panic("stub")
+}
+
+type parseArg[T any] func(*V8ScriptContext, *v8.Value) (T, error)
+
+func tryParseArg[T any](args *argumentHelper, index int, parsers ...parseArg[T]) (T, error) {
+	panic("stub")
+}
+
+func tryParseArgWithDefault[T any](
+	args *argumentHelper,
+	index int,
+	defaultValue func() T,
+	parsers ...parseArg[T],
+) (T, error) {
+	panic("stub")
+}
+
+func tryParseNullableArg[T any](args *argumentHelper, index int, parsers ...parseArg[T]) (*T, error) {
+	panic("stub")
+}
+
+func tryParseVariadicArg[T any](args *argumentHelper, index int, parsers ...parseArg[T]) ([]T, error) {
+	panic("stub")
+}
+
+func registerJSClass(
+	className string,
+	superClassName string,
+	createPrototype func(*V8ScriptHost) *v8.FunctionTemplate,
+) {
+	panic("stub")
+}
+
+func registerJSNamespace(name string, createNamespace func(*V8ScriptHost) *v8.ObjectTemplate) {
+	panic("stub")
+}
+
+func newDOMException(host *V8ScriptHost, message string, name string, code int) error { panic("stub") }
+
+type handleReffedObject[T any] struct {
+	scriptHost *V8ScriptHost
+	converters
+}
+
+func newHandleReffedObject[T any](host *V8ScriptHost) handleReffedObject[T] { panic("stub") }
+
+func (o handleReffedObject[T]) mustGetContext(info *v8.FunctionCallbackInfo) *V8ScriptContext {
+	panic("stub")
+}
+
+func (o handleReffedObject[T]) getInstance(info *v8.FunctionCallbackInfo) (T, error) { panic("stub") }
+
+// getWrapped returns the Go value wrapped by the "this" object of the call, or
+// nil if it doesn't wrap a Go value. The error is only returned if the value
+// can't be looked up, e.g., without a script context.
+func (o handleReffedObject[T]) getWrapped(info *v8.FunctionCallbackInfo) (any, error) {
+	panic("stub")
+}
+
+type nodeV8WrapperBase[T any] struct {
+	handleReffedObject[T]
+}
+
+func newNodeV8WrapperBase[T any](host *V8ScriptHost) nodeV8WrapperBase[T] { panic("stub") }
+
+type namespaceV8WrapperBase struct {
+	handleReffedObject[any]
+}
+
+func newNamespaceV8WrapperBase(host *V8ScriptHost) namespaceV8WrapperBase { panic("stub") }
@@ -0,0 +1,125 @@
+package v8host
+
+import (
+	"github.com/gost-dom/browser/dom"
+	"github.com/gost-dom/browser/html"
+	v8 "github.com/tommie/v8go"
+)
+
+// The wrappers below are hand-written, with generated prototype initializers
+// and wrapper methods.
+
+type nodeV8Wrapper struct {
+	nodeV8WrapperBase[dom.Node]
+}
+
+func newNodeV8Wrapper(host *V8ScriptHost) *nodeV8Wrapper { panic("stub") }
+
+func (n nodeV8Wrapper) nodeType(info *v8.FunctionCallbackInfo) (*v8.Value, error) { panic("stub") }
+
+type elementV8Wrapper struct {
+	nodeV8WrapperBase[dom.Element]
+}
+
+func newElementV8Wrapper(host *V8ScriptHost) *elementV8Wrapper { panic("stub") }
+
+func (e elementV8Wrapper) CustomInitialiser(constructor *v8.FunctionTemplate) { panic("stub") }
+
+func (e elementV8Wrapper) getAttribute(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	panic("stub")
+}
+
+func (e elementV8Wrapper) classList(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	panic("stub")
+}
+
+type htmlTemplateElementV8Wrapper struct {
+	nodeV8WrapperBase[html.HTMLTemplateElement]
+}
+
+func newHtmlTemplateElementV8Wrapper(host *V8ScriptHost) *htmlTemplateElementV8Wrapper {
+	panic("stub")
+}
+
+type domTokenListV8Wrapper struct {
+	handleReffedObject[dom.DOMTokenList]
+}
+
+func newDomTokenListV8Wrapper(host *V8ScriptHost) *domTokenListV8Wrapper { panic("stub") }
+
+func (u domTokenListV8Wrapper) CustomInitialiser(constructor *v8.FunctionTemplate) {
+	panic("stub")
+}
+
+func (u domTokenListV8Wrapper) toggle(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	panic("stub")
+}
+
+type historyV8Wrapper struct {
+	handleReffedObject[html.History]
+}
+
+func newHistoryV8Wrapper(host *V8ScriptHost) *historyV8Wrapper { panic("stub") }
+
+type urlV8Wrapper struct {
+	handleReffedObject[html.URL]
+}
+
+func newUrlV8Wrapper(host *V8ScriptHost) *urlV8Wrapper { panic("stub") }
+
+func (u urlV8Wrapper) CreateInstance(
+	ctx *V8ScriptContext,
+	this *v8.Object,
+	url string,
+) (*v8.Value, error) {
+	panic("stub")
+}
+
+func (u urlV8Wrapper) CreateInstanceBase(
+	ctx *V8ScriptContext,
+	this *v8.Object,
+	url string,
+	base string,
+) (*v8.Value, error) {
+	panic("stub")
+}
+
+type xmlHttpRequestV8Wrapper struct {
+	handleReffedObject[html.XmlHttpRequest]
+}
+
+func newXmlHttpRequestV8Wrapper(host *V8ScriptHost) *xmlHttpRequestV8Wrapper { panic("stub") }
+
+func (xhr xmlHttpRequestV8Wrapper) CreateInstance(
+	ctx *V8ScriptContext,
+	this *v8.Object,
+) (*v8.Value, error) {
+	panic("stub")
+}
+
+func (xhr xmlHttpRequestV8Wrapper) open(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	panic("stub")
+}
+
+func (xhr xmlHttpRequestV8Wrapper) upload(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	panic("stub")
+}
+
+// Hand-written methods of generated wrappers
+
+func (e eventV8Wrapper) CreateInstance(
+	ctx *V8ScriptContext,
+	this *v8.Object,
+	type_ string,
+	eventInitDict dom.EventInit,
+) (*v8.Value, error) {
+	panic("stub")
+}
+
+func (w windowV8Wrapper) window(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	panic("stub")
+}
+
+func (w windowV8Wrapper) history(info *v8.FunctionCallbackInfo) (*v8.Value, error) {
+	panic("stub")
+}
@@ -0,0 +1,311 @@
+// Package typecheck type-checks generated code in memory, against stubs of the
+// hand-written code in the package the code is generated into, e.g., the
+// helper functions and converters of a script host.
+//
+// Imported packages are type-checked from source when they can be found from
+// the current directory, e.g., the standard library, and goja, which is a
+// dependency of this module. Other packages, e.g., v8go, and the dom and html
+// packages of the browser repository, are replaced by placeholders declaring
+// the identifiers used from the package: types as empty interfaces, functions
+// as variadic functions returning an empty interface, and other identifiers as
+// values of an invalid type. Errors involving the placeholders are not
+// reported, so uses of these packages are not checked. Identifiers of the
+// generated package are, so the check finds references to host code that
+// doesn't exist, e.g., a missing decodeX or toX converter.
+package typecheck
+
+import (
+	"errors"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"maps"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Check type-checks the generated Go files together with the Go files in the
+// root of stubs, and returns an error listing all type errors, or nil if the
+// code type-checks. Files are the generated files by name, e.g., as written
+// to an [output.Memory]; files without the .go extension are ignored.
+func Check(files map[string][]byte, stubs fs.FS) error {
+	fset := token.NewFileSet()
+	var astFiles []*ast.File
+	var errs []error
+	parse := func(name string, src []byte) {
+		f, err := parser.ParseFile(fset, name, src, parser.SkipObjectResolution)
+		if err != nil {
+			errs = append(errs, err)
+			return
+		}
+		astFiles = append(astFiles, f)
+	}
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		if path.Ext(name) == ".go" {
+			parse(name, files[name])
+		}
+	}
+	stubFiles, err := fs.Glob(stubs, "*.go")
+	if err != nil {
+		return err
+	}
+	for _, name := range stubFiles {
+		src, err := fs.ReadFile(stubs, name)
+		if err != nil {
+			return err
+		}
+		parse(path.Join("stubs", name), src)
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	if len(astFiles) == 0 {
+		return nil
+	}
+	imp := newImporter(fset, astFiles)
+	conf := types.Config{
+		Importer: imp,
+		Error: func(err error) {
+			if !imp.mentionsPlaceholder(err.Error()) {
+				errs = append(errs, err)
+			}
+		},
+	}
+	// Errors are reported to conf.Error
+	_, _ = conf.Check(astFiles[0].Name.Name, fset, astFiles, nil)
+	return errors.Join(errs...)
+}
+
+// placeholderImporter imports packages from source, and creates placeholders
+// for packages that can't be found.
+type placeholderImporter struct {
+	source types.Importer
+	uses   map[string]*packageUses
+	// imported caches the placeholder packages, as all files importing a
+	// package must see the same types.
+	imported map[string]*types.Package
+}
+
+// packageUses are the identifiers used from an imported package, and how each
+// is used.
+type packageUses struct {
+	name string
+	// localNames are the names the package is imported as.
+	localNames map[string]bool
+	ids        map[string]identifierUse
+}
+
+type identifierUse int
+
+const (
+	valueUse identifierUse = iota
+	callUse
+	typeUse
+)
+
+func newImporter(fset *token.FileSet, files []*ast.File) placeholderImporter {
+	uses := make(map[string]*packageUses)
+	for _, f := range files {
+		collectUses(f, uses)
+	}
+	return placeholderImporter{
+		source:   importer.ForCompiler(fset, "source", nil),
+		uses:     uses,
+		imported: make(map[string]*types.Package),
+	}
+}
+
+func (i placeholderImporter) Import(path string) (*types.Package, error) {
+	if pkg, ok := i.imported[path]; ok {
+		return pkg, nil
+	}
+	pkg, err := i.source.Import(path)
+	u, ok := i.uses[path]
+	if err == nil || !ok {
+		return pkg, err
+	}
+	pkg = types.NewPackage(path, u.name)
+	i.imported[path] = pkg
+	// The result of calling a function. Being a named type of the package,
+	// errors involving it are not reported.
+	result := types.NewTypeName(token.NoPos, pkg, "placeholder", nil)
+	types.NewNamed(result, types.NewInterfaceType(nil, nil), nil)
+	for _, name := range slices.Sorted(maps.Keys(u.ids)) {
+		switch u.ids[name] {
+		case typeUse:
+			obj := types.NewTypeName(token.NoPos, pkg, name, nil)
+			types.NewNamed(obj, types.NewInterfaceType(nil, nil), nil)
+			pkg.Scope().Insert(obj)
+		case callUse:
+			// A function, rather than a value of an invalid type, makes the
+			// checker visit the arguments, so variables passed are used.
+			params := types.NewTuple(types.NewParam(
+				token.NoPos, pkg, "args", types.NewSlice(types.Universe.Lookup("any").Type())))
+			results := types.NewTuple(types.NewParam(token.NoPos, pkg, "", result.Type()))
+			sig := types.NewSignatureType(nil, nil, nil, params, results, true)
+			pkg.Scope().Insert(types.NewFunc(token.NoPos, pkg, name, sig))
+		default:
+			pkg.Scope().Insert(types.NewVar(token.NoPos, pkg, name, types.Typ[types.Invalid]))
+		}
+	}
+	pkg.MarkComplete()
+	return pkg, nil
+}
+
+// mentionsPlaceholder returns whether the error message refers to a type of
+// a placeholder package, e.g., "dom.Node".
+func (i placeholderImporter) mentionsPlaceholder(msg string) bool {
+	var names []string
+	for path := range i.imported {
+		// Types are qualified by the package name, and expressions by the
+		// name the package is imported as.
+		u := i.uses[path]
+		names = append(names, regexp.QuoteMeta(u.name))
+		for name := range u.localNames {
+			names = append(names, regexp.QuoteMeta(name))
+		}
+	}
+	if len(names) == 0 {
+		return false
+	}
+	slices.Sort(names)
+	placeholders := regexp.MustCompile(`\b(` + strings.Join(slices.Compact(names), "|") + `)\.\w`)
+	return placeholders.MatchString(msg)
+}
+
+// isStandardLibrary returns whether the import path is a package of the
+// standard library, which don't have a dot in the first path element.
+func isStandardLibrary(path string) bool {
+	first, _, _ := strings.Cut(path, "/")
+	return !strings.Contains(first, ".")
+}
+
+// collectUses adds the identifiers used from each imported package outside
+// the standard library to uses, by import path.
+func collectUses(f *ast.File, uses map[string]*packageUses) {
+	imports := make(map[string]*packageUses)
+	for _, spec := range f.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || isStandardLibrary(importPath) {
+			continue
+		}
+		u, ok := uses[importPath]
+		if !ok {
+			u = &packageUses{
+				name:       path.Base(importPath),
+				localNames: make(map[string]bool),
+				ids:        make(map[string]identifierUse),
+			}
+			uses[importPath] = u
+		}
+		localName := u.name
+		if spec.Name != nil {
+			localName = spec.Name.Name
+		}
+		imports[localName] = u
+		u.localNames[localName] = true
+	}
+	types := typeExpressions(f)
+	calls := make(map[ast.Expr]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok {
+			calls[call.Fun] = true
+		}
+		return true
+	})
+	ast.Inspect(f, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if x, ok := sel.X.(*ast.Ident); ok {
+			if u, ok := imports[x.Name]; ok {
+				use := valueUse
+				if types[sel] {
+					use = typeUse
+				} else if calls[sel] {
+					use = callUse
+				}
+				u.ids[sel.Sel.Name] = max(u.ids[sel.Sel.Name], use)
+			}
+		}
+		return true
+	})
+}
+
+// typeExpressions returns the expressions of the file in a type position,
+// e.g., the type of a parameter, or the type argument of a generic function.
+func typeExpressions(f *ast.File) map[ast.Expr]bool {
+	result := make(map[ast.Expr]bool)
+	var addType func(e ast.Expr)
+	addType = func(e ast.Expr) {
+		if e == nil {
+			return
+		}
+		result[e] = true
+		switch t := e.(type) {
+		case *ast.StarExpr:
+			addType(t.X)
+		case *ast.ArrayType:
+			addType(t.Elt)
+		case *ast.MapType:
+			addType(t.Key)
+			addType(t.Value)
+		case *ast.ChanType:
+			addType(t.Value)
+		case *ast.Ellipsis:
+			addType(t.Elt)
+		case *ast.IndexExpr:
+			addType(t.X)
+			addType(t.Index)
+		case *ast.IndexListExpr:
+			addType(t.X)
+			for _, index := range t.Indices {
+				addType(index)
+			}
+		}
+	}
+	addFields := func(fields *ast.FieldList) {
+		if fields != nil {
+			for _, field := range fields.List {
+				addType(field.Type)
+			}
+		}
+	}
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Field:
+			addType(n.Type)
+		case *ast.FuncType:
+			addFields(n.TypeParams)
+			addFields(n.Params)
+			addFields(n.Results)
+		case *ast.ValueSpec:
+			addType(n.Type)
+		case *ast.TypeSpec:
+			addType(n.Type)
+		case *ast.CompositeLit:
+			addType(n.Type)
+		case *ast.TypeAssertExpr:
+			addType(n.Type)
+		case *ast.CallExpr:
+			// Type arguments of a generic function, e.g., f[dom.Node](x)
+			switch fun := n.Fun.(type) {
+			case *ast.IndexExpr:
+				addType(fun.Index)
+			case *ast.IndexListExpr:
+				for _, index := range fun.Indices {
+					addType(index)
+				}
+			}
+		}
+		return true
+	})
+	return result
+}
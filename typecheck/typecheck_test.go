@@ -0,0 +1,47 @@
+package typecheck_test
+
+import (
+	"testing/fstest"
+
+	"github.com/gost-dom/code-gen/typecheck"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Check", func() {
+	stubs := fstest.MapFS{"host.go": {Data: []byte(`package host
+
+import "github.com/gost-dom/browser/dom"
+
+func decodeNode(v any) dom.Node { panic("stub") }
+`)}}
+
+	check := func(src string) error {
+		return typecheck.Check(map[string][]byte{"generated.go": []byte(src)}, stubs)
+	}
+
+	It("reports references to code missing in the stubs", func() {
+		Expect(check(`package host
+
+func decode(v any) any { return decodeElement(v) }
+`)).To(MatchError(ContainSubstring("undefined: decodeElement")))
+	})
+
+	It("doesn't report uses of packages that can't be found", func() {
+		Expect(check(`package host
+
+import "github.com/gost-dom/browser/dom"
+
+func decode(v any) dom.Element { return decodeNode(v).(dom.Element).Unknown() }
+`)).To(Succeed())
+	})
+
+	It("checks uses of packages found from the current module", func() {
+		Expect(check(`package host
+
+import "github.com/dop251/goja"
+
+func value(vm *goja.Runtime) goja.Value { return vm.NewObject().Unknown() }
+`)).To(MatchError(ContainSubstring("Unknown")))
+	})
+})
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing/fstest"
+
+	"github.com/gost-dom/code-gen/output"
+	wrappers "github.com/gost-dom/code-gen/script-wrappers"
+	"github.com/gost-dom/code-gen/typecheck"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// The stubs in testdata/stubs/<target> declare the hand-written code of the
+// script host that the generated wrappers use, e.g., the converters, with the
+// names and signatures the generated code has always used, see README.md.
+var _ = Describe("Generated wrappers", func() {
+	for _, t := range wrapperTargets {
+		It("type-checks against the stubs of the "+t.name+" script host", func() {
+			files := output.Memory{}
+			Expect(t.create().GenerateScriptWrappers(files)).To(Succeed())
+			stubs := os.DirFS(filepath.Join("testdata", "stubs", t.name))
+			Expect(typecheck.Check(files, stubs)).To(Succeed())
+		})
+
+		It("calls only converters declared in the stubs of the "+t.name+" script host", func() {
+			manifest, err := t.create().CollectConverters()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(manifest).ToNot(BeEmpty())
+			declared, err := wrappers.ReadDeclarations(filepath.Join("testdata", "stubs", t.name))
+			Expect(err).ToNot(HaveOccurred())
+			manifest.FindMissing(declared)
+			for _, w := range manifest {
+				Expect(w.Missing).To(BeEmpty(), w.Wrapper)
+				Expect(w.Malformed).To(BeEmpty(), w.Wrapper)
+			}
+		})
+	}
+
+	It("reports a converter missing in the stubs of the script host", func() {
+		dir := GinkgoT().TempDir()
+		stubs := filepath.Join("testdata", "stubs", "v8")
+		entries, err := os.ReadDir(stubs)
+		Expect(err).ToNot(HaveOccurred())
+		for _, e := range entries {
+			data, err := os.ReadFile(filepath.Join(stubs, e.Name()))
+			Expect(err).ToNot(HaveOccurred())
+			data = bytes.ReplaceAll(data, []byte("func (c converters) toBoolean("), []byte("func (c converters) removed("))
+			Expect(os.WriteFile(filepath.Join(dir, e.Name()), data, 0666)).To(Succeed())
+		}
+		manifest, err := wrappers.NewScriptWrapperModulesGenerator().CollectConverters()
+		Expect(err).ToNot(HaveOccurred())
+		declared, err := wrappers.ReadDeclarations(dir)
+		Expect(err).ToNot(HaveOccurred())
+		manifest.FindMissing(declared)
+		var missing []string
+		for _, w := range manifest {
+			missing = append(missing, w.Missing...)
+		}
+		Expect(missing).ToNot(BeEmpty())
+		Expect(missing).To(HaveEach("toBoolean"))
+	})
+
+	It("type-checks the wrappers of the generic types against the stubs of the v8 script host", func() {
+		files := output.Memory{}
+		gen := genericGenerator(wrappers.NewScriptWrapperModulesGenerator())
+		Expect(gen.GenerateScriptWrappers(files)).To(Succeed())
+		Expect(typecheck.Check(files, converterStubs("v8"))).To(Succeed())
+	})
+
+	It("type-checks the wrappers of the generic types against the stubs of the goja script host", func() {
+		files := output.Memory{}
+		gen := genericGenerator(wrappers.NewGojaWrapperModuleGenerator())
+		Expect(gen.GenerateScriptWrappers(files)).To(Succeed())
+		Expect(typecheck.Check(files, converterStubs("goja"))).To(Succeed())
+	})
+})
+
+// converterStubs returns the stubs of the script host of the target, without
+// wrappers.go, the hand-written methods of wrappers that only the script host
+// generates.
+func converterStubs(target string) fs.FS {
+	stubs := fstest.MapFS{}
+	dir := filepath.Join("testdata", "stubs", target)
+	entries, err := os.ReadDir(dir)
+	Expect(err).ToNot(HaveOccurred())
+	for _, e := range entries {
+		if e.Name() == "wrappers.go" {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		Expect(err).ToNot(HaveOccurred())
+		stubs[e.Name()] = &fstest.MapFile{Data: data}
+	}
+	return stubs
+}